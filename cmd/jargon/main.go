@@ -3,16 +3,20 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
 
 	"github.com/clipperhouse/flag"
 	"github.com/clipperhouse/jargon"
-	"github.com/clipperhouse/jargon/filters/ascii"
-	"github.com/clipperhouse/jargon/filters/contractions"
-	"github.com/clipperhouse/jargon/filters/stackoverflow"
-	"github.com/clipperhouse/jargon/filters/stemmer"
+	"github.com/clipperhouse/jargon/collocation"
+	"github.com/clipperhouse/jargon/corpus"
+	"github.com/clipperhouse/jargon/dedupe"
+	"github.com/clipperhouse/jargon/highlight"
+	"github.com/clipperhouse/jargon/kwic"
+	"github.com/clipperhouse/jargon/pipeline"
+	"github.com/clipperhouse/jargon/vector"
 	"github.com/spf13/afero"
 )
 
@@ -31,20 +35,37 @@ func main() {
 	//
 	// Flags.
 	//
-	// We don't actually consume some of these flags, see setFilters below; declared here for Usage and errors
-	flag.Bool("stack", false, "a filter to recognize tech terms as Stack Overflow tags, e.g. Ruby on Rails → ruby-on-rails")
-	flag.Bool("contractions", false, "a filter to expand contractions, e.g. Would've → Would have")
-	flag.Bool("ascii", false, "a filter to replace diacritics with ascii equivalents, e.g. café → cafe")
-	flag.Bool("stem", false, "a filter to stem words using snowball stemmer, e.g. management|manager → manag")
-	lang := flag.String("lang", "english", "language of input, relevant when used with -stem. options:\n"+strings.Join(langs, ", "))
+	// We don't actually consume the filter flags, see setFilters below; declared here for Usage and errors
+	for _, r := range jargon.Registered() {
+		if flag.Lookup(r.Name) != nil || required(r) {
+			continue
+		}
+		flag.Bool(r.Name, false, "a filter to "+r.Description)
+	}
+	flag.String("filter", "", "a filter by name, with optional params, e.g. stem:french or stopwords:words=a,an,the; may be repeated, see Filters below")
+	lang := flag.String("lang", "english", "language of input, relevant when used with -stem. options:\n"+strings.Join(langs(), ", "))
 
 	html := flag.Bool("html", false, "parse input as html (keep tags whole)")
+	markdown := flag.Bool("markdown", false, "parse input as markdown (keep markup and code whole)")
 	filein := flag.String("file", "", "input file path (if none, stdin is used as input)")
 	fileout := flag.String("out", "", "output file path (if none, stdout is used as input)")
-	flag.Bool("lemmas", false, "only return tokens that have been changed by a filter (lemmatized)")
 	count := flag.Bool("count", false, "count the tokens")
 	lines := flag.Bool("lines", false, "add a line break between tokens")
-	flag.Bool("distinct", false, "only return unique tokens")
+	hl := flag.Bool("highlight", false, "write the input text, highlighting spans which were changed by filters; uses color on a terminal, unless NO_COLOR is set")
+	canonical := flag.Bool("canonical", false, "with -highlight, show canonical terms inline, e.g. Ruby on Rails⟨ruby-on-rails⟩; always the case when not using color")
+	explain := flag.Bool("explain", false, "for each token changed by a filter, show which filter(s) changed it, e.g. Café → Cafe (ascii) → cafe (stem)")
+	kw := flag.String("kwic", "", "show each occurrence of a term, typically a canonical such as reactjs, in context; input files may also be given as args, e.g. jargon -stack -kwic reactjs *.txt")
+	context := flag.Int("context", 5, "with -kwic, the number of tokens of context on either side")
+	colloc := flag.String("collocations", "", "find frequent phrases which may be new terms, scored by a measure, pmi or llr (log-likelihood); output is in synonyms dictionary format. input files may also be given as args")
+	mincount := flag.Int("mincount", 2, "with -collocations, the minimum number of occurrences of a phrase")
+	keywords := flag.Int("keywords", 0, "show the top n keywords of each input, weighted by TF-IDF over all inputs; input files may also be given as args")
+	vectors := flag.String("vectors", "", "write a sparse feature vector for each input, in a format, libsvm or jsonl; input files may also be given as args")
+	dims := flag.Int("dims", vector.DefaultDimensions, "with -vectors, the number of dimensions for feature hashing")
+	signed := flag.Bool("signed", false, "with -vectors, use signed feature hashing")
+	ngrams := flag.Int("ngrams", 1, "with -vectors, the maximum number of words in a feature, e.g. 2 for bigrams")
+	tfidf := flag.Bool("tfidf", false, "with -vectors, weight features by TF-IDF over all inputs, rather than counts")
+	dupes := flag.Float64("dupes", 0, "find groups of near-duplicate inputs, whose similarity (0 to 1) is at least the given threshold, e.g. 0.8; input files may also be given as args")
+	configfile := flag.String("config", "", "pipeline configuration file (json), specifying tokenizer, filters and output, in lieu of the flags above")
 	v := flag.Bool("version", false, "display the version")
 
 	flag.Parse()
@@ -57,10 +78,36 @@ func main() {
 	}
 
 	c := config{
-		Fs:    afero.NewOsFs(),
-		HTML:  *html,
-		Count: *count,
-		Lines: *lines,
+		Fs:       afero.NewOsFs(),
+		HTML:     *html,
+		Markdown: *markdown,
+		Count:    *count,
+		Lines:    *lines,
+
+		Highlight: *hl,
+		Canonical: *canonical,
+		Explain:   *explain,
+
+		KWIC:    *kw,
+		Context: *context,
+
+		Collocations: *colloc,
+		MinCount:     *mincount,
+
+		Keywords: *keywords,
+
+		Vectors: *vectors,
+		Vectorizer: vector.Vectorizer{
+			Dimensions: *dims,
+			Signed:     *signed,
+			NGrams:     *ngrams,
+		},
+		TFIDF: *tfidf,
+
+		Dupes: *dupes,
+	}
+	if c.KWIC != "" || c.Collocations != "" || c.Keywords > 0 || c.Vectors != "" || c.Dupes > 0 {
+		c.Files = flag.Args()
 	}
 
 	//
@@ -83,7 +130,11 @@ func main() {
 	//
 	// Filters
 	//
-	err = setFilters(&c, os.Args[1:], *lang)
+	if *configfile != "" {
+		err = setConfig(&c, *configfile, os.Args[1:])
+	} else {
+		err = setFilters(&c, os.Args[1:], *lang)
+	}
 	check(err)
 
 	//
@@ -94,6 +145,7 @@ func main() {
 	if c.Fileout != nil {
 		defer c.Fileout.Close()
 	}
+	c.Color = c.Pipedout && highlight.IsColorTerminal(os.Stdout)
 
 	//
 	// Reader
@@ -117,10 +169,37 @@ func main() {
 type config struct {
 	Fs afero.Fs
 
-	HTML    bool
-	Count   bool
-	Lines   bool
-	Filters []jargon.Filter
+	HTML     bool
+	Markdown bool
+	Count    bool
+	Lines    bool
+	Filters  []jargon.Filter
+	// Names are the names of Filters, for -explain
+	Names    []string
+	Pipeline *pipeline.Pipeline
+
+	Highlight, Canonical, Color bool
+	Explain                     bool
+
+	// KWIC is a term for a concordance, over input and Files
+	KWIC    string
+	Context int
+	Files   []string
+
+	// Collocations is a measure for finding phrases, over input and Files
+	Collocations string
+	MinCount     int
+
+	// Keywords is the number of keywords to show for each of input and Files
+	Keywords int
+
+	// Vectors is a format for writing vectors of input and Files
+	Vectors    string
+	Vectorizer vector.Vectorizer
+	TFIDF      bool
+
+	// Dupes is a similarity threshold for grouping near-duplicates among input and Files
+	Dupes float64
 
 	Filein, Fileout   afero.File
 	Pipedin, Pipedout bool
@@ -131,6 +210,7 @@ type config struct {
 
 var errNoInput = fmt.Errorf("no input")
 var errTwoInput = fmt.Errorf("choose *either* an input -file argument *or* piped input")
+var errConfigFilters = fmt.Errorf("choose *either* a -config file *or* filter arguments")
 
 func printUsage() {
 	// Display usage
@@ -138,6 +218,23 @@ func printUsage() {
 	os.Stderr.WriteString("Example:\n\n  curl -s https://en.wikipedia.org/wiki/Computer_programming | jargon -html -stack -lemmas -lines\n\n")
 	os.Stderr.WriteString("Flags:\n\n")
 	flag.PrintDefaults()
+	os.Stderr.WriteString("\nFilters, for use with -filter name[:param...]:\n\n")
+	for _, r := range jargon.Registered() {
+		os.Stderr.WriteString("  " + r.Name + "\n    \t" + r.Description + "\n")
+		for _, param := range r.Params {
+			usage := param.Description
+			if param.Required {
+				usage += " (required)"
+			}
+			if param.Default != "" {
+				usage += " (default " + param.Default + ")"
+			}
+			if len(param.Options) > 0 {
+				usage += "; options: " + strings.Join(param.Options, ", ")
+			}
+			os.Stderr.WriteString("    " + param.Name + "\t" + usage + "\n")
+		}
+	}
 }
 
 func setInput(c *config, mode os.FileMode, filein string) error {
@@ -154,7 +251,7 @@ func setInput(c *config, mode os.FileMode, filein string) error {
 	c.Pipedin = (mode & os.ModeCharDevice) == 0 // https://filters/stackoverflow.com/a/43947435/70613
 
 	// If no input, display usage
-	input := c.Pipedin || c.Filein != nil
+	input := c.Pipedin || c.Filein != nil || len(c.Files) > 0
 	if !input {
 		return errNoInput
 	}
@@ -167,42 +264,110 @@ func setInput(c *config, mode os.FileMode, filein string) error {
 	return nil
 }
 
-var filterMap = map[string]jargon.Filter{
-	"-ascii":        ascii.Fold,
-	"-contractions": contractions.Expand,
-	"-lemmas":       (*jargon.TokenStream).Lemmas,
-	"-distinct":     (*jargon.TokenStream).Distinct,
-	"-stack":        stackoverflow.Tags,
-	"-stem":         stemmer.English,
+// required determines whether a registered filter has required params, and therefore can't be a simple flag
+func required(r jargon.Registration) bool {
+	for _, param := range r.Params {
+		if param.Required {
+			return true
+		}
+	}
+	return false
+}
+
+// langs are the options for the -lang flag, from the stem filter
+func langs() []string {
+	r, found := jargon.LookupFilter("stem")
+	if !found {
+		return nil
+	}
+	for _, param := range r.Params {
+		if param.Name == "lang" {
+			return param.Options
+		}
+	}
+	return nil
 }
 
-var langs = []string{"english", "french", "norwegian", "russian", "spanish", "swedish"}
-var stemmerMap = map[string]jargon.Filter{
-	"english":   stemmer.English,
-	"french":    stemmer.French,
-	"norwegian": stemmer.Norwegian,
-	"russian":   stemmer.Russian,
-	"spanish":   stemmer.Spanish,
-	"swedish":   stemmer.Swedish,
+// filterSpecs finds filter args, in order, as specs for jargon.ParseFilter. Filters are either flags named for a
+// registered filter, such as -stack, or a -filter spec.
+func filterSpecs(args []string, lang string) []string {
+	var specs []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name := strings.TrimLeft(arg, "-")
+
+		if name == "filter" {
+			if i+1 < len(args) {
+				i++
+				specs = append(specs, args[i])
+			}
+			continue
+		}
+		if strings.HasPrefix(name, "filter=") {
+			specs = append(specs, strings.TrimPrefix(name, "filter="))
+			continue
+		}
+
+		if _, found := jargon.LookupFilter(name); !found {
+			continue
+		}
+		if name == "stem" && lang != "" {
+			name += ":lang=" + lang
+		}
+		specs = append(specs, name)
+	}
+	return specs
 }
 
 func setFilters(c *config, args []string, lang string) error {
 	// Loop through filters; order matters, so can't use flag package
-	for _, arg := range args {
-		filter, found := filterMap[arg]
-		if found {
-			if arg == "-stem" && lang != "" {
-				// Look for a language specification
-				stem, found := stemmerMap[lang]
-				if found {
-					filter = stem
-				} else {
-					err := fmt.Errorf("lang %q is not known by %s; options are %s", lang, flag.CommandLine.Name(), strings.Join(langs, ", "))
-					return err
-				}
-			}
-			c.Filters = append(c.Filters, filter)
+	for _, spec := range filterSpecs(args, lang) {
+		filter, err := jargon.ParseFilter(spec)
+		if err != nil {
+			return err
 		}
+		c.Filters = append(c.Filters, filter)
+		c.Names = append(c.Names, spec)
+	}
+
+	return nil
+}
+
+// setConfig reads a pipeline configuration file, which determines the tokenizer, filters and output format
+func setConfig(c *config, path string, args []string) error {
+	if len(filterSpecs(args, "")) > 0 {
+		return errConfigFilters
+	}
+
+	file, err := c.Fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	pc, err := pipeline.Load(file)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	p, err := pc.Build()
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+
+	c.Pipeline = p
+	c.Filters = p.Filters
+	for _, fc := range pc.Filters {
+		c.Names = append(c.Names, fc.Name)
+	}
+	switch p.Output {
+	case "lines":
+		c.Lines = true
+	case "count":
+		c.Count = true
 	}
 
 	return nil
@@ -223,6 +388,11 @@ func setOutput(c *config, fileout string) error {
 }
 
 func setReader(c *config) error {
+	if !c.Pipedin && c.Filein == nil {
+		// Input is only c.Files, e.g. -kwic with file args
+		return nil
+	}
+
 	if c.Pipedin || c.Pipedout {
 		// We're limited by the OS pipe buffer, typically 64K with back pressure
 		// Using anything larger doesn't buy us anything
@@ -258,12 +428,15 @@ func setReader(c *config) error {
 }
 
 func setWriter(c *config) error {
-	if c.Reader == nil {
+	if c.Reader == nil && len(c.Files) == 0 {
 		return fmt.Errorf("reader is required")
 	}
 
 	// Match the input buffer size; mismatch doesn't buy us anything
-	size := c.Reader.Size()
+	size := 4 * 1024
+	if c.Reader != nil {
+		size = c.Reader.Size()
+	}
 	if c.Pipedout {
 		c.Writer = bufio.NewWriterSize(os.Stdout, size)
 	} else {
@@ -274,6 +447,22 @@ func setWriter(c *config) error {
 }
 
 func execute(c *config) error {
+	if c.KWIC != "" {
+		return concordance(c)
+	}
+	if c.Collocations != "" {
+		return collocations(c)
+	}
+	if c.Keywords > 0 {
+		return keywords(c)
+	}
+	if c.Vectors != "" {
+		return vectors(c)
+	}
+	if c.Dupes > 0 {
+		return duplicates(c)
+	}
+
 	if c.Reader == nil {
 		return fmt.Errorf("reader is required")
 	}
@@ -281,17 +470,39 @@ func execute(c *config) error {
 		return fmt.Errorf("writer is required")
 	}
 
-	var tokens *jargon.TokenStream
-	if c.HTML {
-		tokens = jargon.TokenizeHTML(c.Reader)
-	} else {
-		tokens = jargon.Tokenize(c.Reader)
+	tokens := tokenize(c, c.Reader)
+
+	filters := c.Filters
+	var tracer *jargon.Tracer
+	if c.Explain {
+		tracer = jargon.NewTracer()
+		filters = tracer.Filters(c.Names, filters)
 	}
 
-	for _, f := range c.Filters {
+	for _, f := range filters {
 		tokens = f(tokens)
 	}
 
+	if c.Explain {
+		for tokens.Scan() {
+			token := tokens.Token()
+			if token.IsSpace() {
+				continue
+			}
+			explanation := tracer.Explanation(token)
+			if explanation == "" {
+				continue
+			}
+			if _, err := c.Writer.WriteString(explanation + "\n"); err != nil {
+				return err
+			}
+		}
+		if err := tokens.Err(); err != nil {
+			return err
+		}
+		return c.Writer.Flush()
+	}
+
 	if c.Count {
 		count, err := tokens.Count()
 		if err != nil {
@@ -305,10 +516,21 @@ func execute(c *config) error {
 		return nil
 	}
 
+	if c.Highlight {
+		options := highlight.Options{
+			Color:     c.Color,
+			Canonical: c.Canonical || !c.Color,
+		}
+		if err := highlight.Write(c.Writer, tokens, options); err != nil {
+			return err
+		}
+		return c.Writer.Flush()
+	}
+
 	// Write all
 	for tokens.Scan() {
 		token := tokens.Token()
-		_, err := c.Writer.WriteString(token.String())
+		_, err := c.Writer.WriteString(token.Source())
 		if err != nil {
 			return err
 		}
@@ -330,3 +552,209 @@ func execute(c *config) error {
 
 	return nil
 }
+
+func tokenize(c *config, r io.Reader) *jargon.TokenStream {
+	switch {
+	case c.Pipeline != nil:
+		return c.Pipeline.Tokenize(r)
+	case c.HTML:
+		return jargon.TokenizeHTML(r)
+	case c.Markdown:
+		return jargon.TokenizeMarkdown(r)
+	default:
+		return jargon.Tokenize(r)
+	}
+}
+
+// eachInput tokenizes and filters the input, and each of c.Files, calling add for each
+func eachInput(c *config, add func(name string, tokens *jargon.TokenStream) error) error {
+	if c.Reader != nil {
+		name := "-"
+		if c.Filein != nil {
+			name = c.Filein.Name()
+		}
+		if err := add(name, tokenize(c, c.Reader).Filter(c.Filters...)); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range c.Files {
+		err := func() error {
+			file, err := c.Fs.Open(name)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			return add(name, tokenize(c, file).Filter(c.Filters...))
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// concordance writes occurrences of c.KWIC in context, over the input and c.Files
+func concordance(c *config) error {
+	if c.Writer == nil {
+		return fmt.Errorf("writer is required")
+	}
+
+	k := kwic.New(c.KWIC, c.Context)
+	if err := eachInput(c, k.Add); err != nil {
+		return err
+	}
+
+	if _, err := k.WriteTo(c.Writer); err != nil {
+		return err
+	}
+	return c.Writer.Flush()
+}
+
+var measures = map[string]collocation.Measure{
+	"pmi": collocation.PMI,
+	"llr": collocation.LogLikelihood,
+}
+
+// collocations writes candidate phrases over the input and c.Files, in synonyms dictionary format
+func collocations(c *config) error {
+	if c.Writer == nil {
+		return fmt.Errorf("writer is required")
+	}
+
+	measure, found := measures[c.Collocations]
+	if !found {
+		return fmt.Errorf("measure %q is not known; options are pmi, llr", c.Collocations)
+	}
+
+	counter := collocation.New(collocation.Options{
+		Measure:    measure,
+		MinCount:   c.MinCount,
+		IgnoreCase: true,
+	})
+	err := eachInput(c, func(name string, tokens *jargon.TokenStream) error {
+		return counter.Add(tokens)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := collocation.WriteDictionary(c.Writer, counter.Candidates()); err != nil {
+		return err
+	}
+	return c.Writer.Flush()
+}
+
+// keywords writes the top keywords of the input and each of c.Files, one line per input
+func keywords(c *config) error {
+	if c.Writer == nil {
+		return fmt.Errorf("writer is required")
+	}
+
+	corp := corpus.New()
+	var names []string
+	err := eachInput(c, func(name string, tokens *jargon.TokenStream) error {
+		names = append(names, name)
+		return corp.Add(name, tokens)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		weights, err := corp.Keywords(name, c.Keywords, corpus.TFIDF)
+		if err != nil {
+			return err
+		}
+
+		terms := make([]string, len(weights))
+		for i, w := range weights {
+			terms[i] = fmt.Sprintf("%s (%.3f)", w.Term, w.Weight)
+		}
+		if _, err := c.Writer.WriteString(name + ": " + strings.Join(terms, ", ") + "\n"); err != nil {
+			return err
+		}
+	}
+
+	return c.Writer.Flush()
+}
+
+// vectors writes a vector for the input and each of c.Files, one line per input
+func vectors(c *config) error {
+	if c.Writer == nil {
+		return fmt.Errorf("writer is required")
+	}
+
+	var write func(vec vector.Vector, name string) error
+	switch c.Vectors {
+	case "libsvm":
+		write = func(vec vector.Vector, name string) error {
+			// No labels, so 0
+			return vec.WriteLibSVM(c.Writer, "0", name)
+		}
+	case "jsonl":
+		write = func(vec vector.Vector, name string) error {
+			return vec.WriteJSONL(c.Writer, name)
+		}
+	default:
+		return fmt.Errorf("vector format %q is not known; options are libsvm, jsonl", c.Vectors)
+	}
+
+	v := c.Vectorizer
+	corp := corpus.New()
+	err := eachInput(c, func(name string, tokens *jargon.TokenStream) error {
+		doc, err := v.Features(name, tokens)
+		if err != nil {
+			return err
+		}
+		corp.AddDocument(doc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if c.TFIDF {
+		v.Corpus = corp
+	}
+
+	for _, doc := range corp.Docs {
+		if err := write(v.Vector(doc), doc.ID); err != nil {
+			return err
+		}
+	}
+
+	return c.Writer.Flush()
+}
+
+// duplicates writes groups of near-duplicates among the input and c.Files, one group per line
+func duplicates(c *config) error {
+	if c.Writer == nil {
+		return fmt.Errorf("writer is required")
+	}
+
+	// 128 hashes in 32 bands of 4 rows finds candidates at similarities above about 0.4
+	const bands, rows = 32, 4
+	lsh := dedupe.NewLSH(bands, rows)
+
+	err := eachInput(c, func(name string, tokens *jargon.TokenStream) error {
+		shingles, err := dedupe.Shingles(tokens, 3)
+		if err != nil {
+			return err
+		}
+		return lsh.Add(name, dedupe.NewMinHash(shingles, bands*rows))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, group := range dedupe.Groups(lsh.Pairs(c.Dupes)) {
+		if _, err := c.Writer.WriteString(strings.Join(group, " ") + "\n"); err != nil {
+			return err
+		}
+	}
+
+	return c.Writer.Flush()
+}
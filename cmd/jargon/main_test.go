@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"errors"
 	"os"
 	"reflect"
@@ -152,6 +154,24 @@ func TestFilters(t *testing.T) {
 				stemmer.Spanish,
 			},
 		},
+		{
+			args: []string{"-filter", "stem:french", "-ascii", "-filter=stack"},
+			lang: "",
+
+			err: false,
+			filters: []jargon.Filter{
+				stemmer.French,
+				ascii.Fold,
+				stackoverflow.Tags,
+			},
+		},
+		{
+			args: []string{"-filter", "foo"},
+			lang: "",
+
+			err:     true,
+			filters: nil,
+		},
 		{
 			args: []string{"-stem"},
 			lang: "foo",
@@ -187,6 +207,100 @@ func TestFilters(t *testing.T) {
 	}
 }
 
+func TestConfig(t *testing.T) {
+	type test struct {
+		// input
+		config string
+		args   []string
+
+		// expected
+		err      bool
+		filters  []jargon.Filter
+		pipeline bool
+		lines    bool
+		count    bool
+	}
+
+	tests := []test{
+		{
+			config: `{"tokenizer": "html", "filters": [{"name": "stack"}, {"name": "stem", "params": {"lang": "french"}}], "output": "lines"}`,
+			args:   []string{"-config", "/tmp/pipeline.json"},
+
+			err: false,
+			filters: []jargon.Filter{
+				stackoverflow.Tags,
+				stemmer.French,
+			},
+			pipeline: true,
+			lines:    true,
+		},
+		{
+			config: `{"output": "count"}`,
+			args:   []string{"-config", "/tmp/pipeline.json"},
+
+			err:      false,
+			pipeline: true,
+			count:    true,
+		},
+		{
+			// Filters in both config and args
+			config: `{"filters": [{"name": "stack"}]}`,
+			args:   []string{"-config", "/tmp/pipeline.json", "-ascii"},
+
+			err: true,
+		},
+		{
+			config: `{"filters": [{"name": "foo"}]}`,
+			args:   []string{"-config", "/tmp/pipeline.json"},
+
+			err: true,
+		},
+		{
+			config: `not json`,
+			args:   []string{"-config", "/tmp/pipeline.json"},
+
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		c, err := testConfig()
+		if err != nil {
+			t.Error(err)
+		}
+
+		err = afero.WriteFile(c.Fs, "/tmp/pipeline.json", []byte(test.config), 0644)
+		if err != nil {
+			t.Error(err)
+		}
+
+		err = setConfig(&c, "/tmp/pipeline.json", test.args)
+		if (err != nil) != test.err {
+			t.Errorf("expected err %v, got %v", test.err, err)
+		}
+		if (c.Pipeline != nil) != test.pipeline {
+			t.Errorf("expected pipeline to be %t", test.pipeline)
+		}
+		if c.Lines != test.lines {
+			t.Errorf("expected lines to be %t", test.lines)
+		}
+		if c.Count != test.count {
+			t.Errorf("expected count to be %t", test.count)
+		}
+		if len(c.Filters) != len(test.filters) {
+			t.Errorf("expected %d filters, got %d", len(test.filters), len(c.Filters))
+			continue
+		}
+		for i := range test.filters {
+			expected := reflect.ValueOf(test.filters[i]).Pointer()
+			got := reflect.ValueOf(c.Filters[i]).Pointer()
+			if expected != got {
+				t.Errorf("expected filters to match, config: %s", test.config)
+			}
+		}
+	}
+}
+
 func TestOutput(t *testing.T) {
 	type test struct {
 		// input
@@ -243,3 +357,76 @@ func TestOutput(t *testing.T) {
 		}
 	}
 }
+
+func TestConcordance(t *testing.T) {
+	c, err := testConfig()
+	if err != nil {
+		t.Error(err)
+	}
+
+	files := map[string]string{
+		"/tmp/a.txt": "We use React.js\nand reactjs",
+		"/tmp/b.txt": "Nothing here",
+	}
+	for name, text := range files {
+		err := afero.WriteFile(c.Fs, name, []byte(text), 0644)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	c.KWIC = "reactjs"
+	c.Context = 2
+	c.Files = []string{"/tmp/a.txt", "/tmp/b.txt"}
+	c.Filters = []jargon.Filter{stackoverflow.Tags}
+
+	var b bytes.Buffer
+	c.Writer = bufio.NewWriter(&b)
+
+	err = execute(&c)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "/tmp/a.txt:1:       We use [React.js] and reactjs\n" +
+		"/tmp/a.txt:2: React.js and [reactjs] \n"
+	if b.String() != expected {
+		t.Errorf("expected %q, got %q", expected, b.String())
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	c, err := testConfig()
+	if err != nil {
+		t.Error(err)
+	}
+
+	files := map[string]string{
+		"/tmp/a.txt": "We use Ruby on Rails, and Rails",
+		"/tmp/b.txt": "We use Go",
+	}
+	for name, text := range files {
+		err := afero.WriteFile(c.Fs, name, []byte(text), 0644)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	c.Keywords = 1
+	c.Files = []string{"/tmp/a.txt", "/tmp/b.txt"}
+	c.Filters = []jargon.Filter{stackoverflow.Tags}
+
+	var b bytes.Buffer
+	c.Writer = bufio.NewWriter(&b)
+
+	err = execute(&c)
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := "/tmp/a.txt: ruby-on-rails (0.562)\n" +
+		"/tmp/b.txt: go (0.468)\n"
+	if b.String() != expected {
+		t.Errorf("expected %q, got %q", expected, b.String())
+	}
+}
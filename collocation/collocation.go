@@ -0,0 +1,225 @@
+// Package collocation finds frequent multi-word phrases (n-grams) in token streams, and scores them as candidates for
+// new terms. It is intended to run after filters, so that known terms are treated as single words, and its output
+// can be reviewed and added to a synonyms dictionary; see synonyms.ReadDictionary.
+package collocation
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Measure is a method of scoring collocations
+type Measure int
+
+const (
+	// PMI is pointwise mutual information, log2(p(phrase) / (p(word1) * p(word2)...)). It favors rare, exclusive
+	// phrases, so is best used with a MinCount.
+	PMI Measure = iota
+	// LogLikelihood is Dunning's log-likelihood ratio (G²), comparing the phrase's leading words with its last word.
+	// It favors frequent phrases.
+	LogLikelihood
+)
+
+// Options determine which n-grams are counted, and how they are scored
+type Options struct {
+	// MinN and MaxN are the minimum and maximum number of words in a phrase; defaults are 2 and 3
+	MinN, MaxN int
+	// MinCount is the minimum number of occurrences of a phrase for it to be a candidate; default is 2
+	MinCount int
+	// Measure is the scoring method
+	Measure Measure
+	// IgnoreCase counts phrases case-insensitively
+	IgnoreCase bool
+	// Stopwords are words which may not begin or end a phrase, such as "the" or "of"
+	Stopwords []string
+}
+
+// Counter counts words and n-grams, over one or more token streams. Use New to create.
+type Counter struct {
+	options   Options
+	stopwords map[string]bool
+
+	// words is the total number of words
+	words int
+	// counts are keyed by words, joined by sep; lemmas may contain spaces
+	counts map[string]int
+}
+
+const sep = "\x00"
+
+// New creates a new Counter
+func New(options Options) *Counter {
+	if options.MinN < 2 {
+		options.MinN = 2
+	}
+	if options.MaxN == 0 {
+		options.MaxN = 3
+	}
+	if options.MaxN < options.MinN {
+		options.MaxN = options.MinN
+	}
+	if options.MinCount < 1 {
+		options.MinCount = 2
+	}
+
+	stopwords := map[string]bool{}
+	for _, s := range options.Stopwords {
+		stopwords[strings.ToLower(s)] = true
+	}
+
+	return &Counter{
+		options:   options,
+		stopwords: stopwords,
+		counts:    map[string]int{},
+	}
+}
+
+// Add counts the words and n-grams in tokens. Spaces are skipped, and n-grams do not cross punctuation.
+func (c *Counter) Add(tokens *jargon.TokenStream) error {
+	// window holds the most recent words, up to MaxN
+	var window []string
+
+	for tokens.Scan() {
+		token := tokens.Token()
+		if token.IsSpace() {
+			continue
+		}
+		if token.IsPunct() {
+			window = window[:0]
+			continue
+		}
+
+		word := token.String()
+		if c.options.IgnoreCase {
+			word = strings.ToLower(word)
+		}
+
+		c.words++
+		c.counts[word]++
+
+		window = append(window, word)
+		if len(window) > c.options.MaxN {
+			window = window[1:]
+		}
+
+		// Count the n-grams ending in this word; shorter ones are needed as prefixes for scoring
+		for n := 2; n <= len(window); n++ {
+			c.counts[strings.Join(window[len(window)-n:], sep)]++
+		}
+	}
+
+	return tokens.Err()
+}
+
+// Candidate is a phrase, with its score
+type Candidate struct {
+	Words []string
+	Count int
+	Score float64
+}
+
+// Phrase is the words of the candidate, joined by spaces
+func (cand Candidate) Phrase() string {
+	return strings.Join(cand.Words, " ")
+}
+
+// Canonical is a suggested canonical form of the phrase, lowercase and joined by hyphens, e.g. machine-learning
+func (cand Candidate) Canonical() string {
+	return strings.ToLower(strings.Join(cand.Words, "-"))
+}
+
+// Candidates returns phrases which meet the options, highest score first
+func (c *Counter) Candidates() []Candidate {
+	var result []Candidate
+
+	for key, count := range c.counts {
+		if count < c.options.MinCount {
+			continue
+		}
+
+		words := strings.Split(key, sep)
+		if len(words) < c.options.MinN || len(words) > c.options.MaxN {
+			continue
+		}
+		if c.stopwords[strings.ToLower(words[0])] || c.stopwords[strings.ToLower(words[len(words)-1])] {
+			continue
+		}
+
+		result = append(result, Candidate{
+			Words: words,
+			Count: count,
+			Score: c.score(words, count),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Phrase() < result[j].Phrase()
+	})
+
+	return result
+}
+
+func (c *Counter) score(words []string, count int) float64 {
+	n := float64(c.words)
+
+	switch c.options.Measure {
+	case LogLikelihood:
+		prefix := float64(c.counts[strings.Join(words[:len(words)-1], sep)])
+		last := float64(c.counts[words[len(words)-1]])
+		k11 := float64(count)
+		k12 := prefix - k11
+		k21 := last - k11
+		k22 := n - prefix - last + k11
+		return logLikelihood(k11, k12, k21, k22)
+	default:
+		pmi := math.Log2(float64(count) / n)
+		for _, word := range words {
+			pmi -= math.Log2(float64(c.counts[word]) / n)
+		}
+		return pmi
+	}
+}
+
+// logLikelihood is Dunning's G² for a 2x2 contingency table
+func logLikelihood(k11, k12, k21, k22 float64) float64 {
+	total := k11 + k12 + k21 + k22
+	row1, row2 := k11+k12, k21+k22
+	col1, col2 := k11+k21, k12+k22
+
+	g := 0.0
+	for _, cell := range []struct{ k, row, col float64 }{
+		{k11, row1, col1},
+		{k12, row1, col2},
+		{k21, row2, col1},
+		{k22, row2, col2},
+	} {
+		if cell.k > 0 {
+			expected := cell.row * cell.col / total
+			g += cell.k * math.Log(cell.k/expected)
+		}
+	}
+	return 2 * g
+}
+
+// WriteDictionary writes candidates in the format of a synonyms dictionary, each preceded by a comment with its score
+// and count, for review, for example:
+//
+//	# score 11.52, count 40
+//	machine-learning: machine learning
+func WriteDictionary(w io.Writer, candidates []Candidate) error {
+	for _, cand := range candidates {
+		_, err := fmt.Fprintf(w, "# score %.2f, count %d\n%s: %s\n", cand.Score, cand.Count, cand.Canonical(), cand.Phrase())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package collocation_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/collocation"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/filters/synonyms"
+)
+
+const text = `We use quantum widgets with Ruby on Rails. Quantum widgets are the future.
+Our quantum widgets team uses Ruby on Rails, and the team likes flux capacitors.
+Flux capacitors are new. We think flux capacitors and quantum widgets go together.`
+
+func TestCandidates(t *testing.T) {
+	for _, measure := range []collocation.Measure{collocation.PMI, collocation.LogLikelihood} {
+		c := collocation.New(collocation.Options{
+			MinCount:   2,
+			Measure:    measure,
+			IgnoreCase: true,
+			Stopwords:  []string{"the", "and", "we", "is", "are"},
+		})
+
+		tokens := jargon.TokenizeString(text).Filter(stackoverflow.Tags)
+		if err := c.Add(tokens); err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, cand := range c.Candidates() {
+			got = append(got, cand.Phrase())
+		}
+
+		// Known terms are single words, so ruby on rails is not a candidate
+		expected := []string{"quantum widget", "flux capacitors"}
+		if measure == collocation.PMI {
+			expected = []string{"flux capacitors", "quantum widget"}
+		}
+		if !reflect.DeepEqual(expected, got) {
+			t.Errorf("measure %d: expected %v, got %v", measure, expected, got)
+		}
+	}
+}
+
+func TestWriteDictionary(t *testing.T) {
+	c := collocation.New(collocation.Options{IgnoreCase: true})
+	if err := c.Add(jargon.TokenizeString("vector databases. Vector Databases!")); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := collocation.WriteDictionary(&b, c.Candidates()); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(b.String(), "# score") {
+		t.Errorf("expected a comment with score, got %q", b.String())
+	}
+
+	// Should round-trip
+	mappings, err := synonyms.ReadDictionary(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"vector databases": "vector-databases"}
+	if !reflect.DeepEqual(expected, mappings) {
+		t.Errorf("expected %v, got %v", expected, mappings)
+	}
+}
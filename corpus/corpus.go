@@ -0,0 +1,185 @@
+// Package corpus collects term statistics over many documents, to weight the terms of each document by TF-IDF or
+// BM25, for example to find its most characteristic keywords. Documents are typically filtered first, so that
+// canonical terms, such as ruby-on-rails from stackoverflow.Tags, count as single terms.
+package corpus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Corpus is a collection of documents and their term counts. Use New to create, or Load. Terms are case-insensitive.
+type Corpus struct {
+	Docs []Document `json:"docs"`
+	// DF is the document frequency of each term, i.e. the number of documents containing it
+	DF map[string]int `json:"df"`
+}
+
+// Document is the term counts of a document
+type Document struct {
+	ID    string         `json:"id"`
+	Terms map[string]int `json:"terms"`
+	// Length is the number of terms in the document
+	Length int `json:"length"`
+}
+
+// New creates a new, empty Corpus
+func New() *Corpus {
+	return &Corpus{
+		DF: map[string]int{},
+	}
+}
+
+// Count counts the terms in tokens, skipping spaces and punctuation
+func Count(id string, tokens *jargon.TokenStream) (Document, error) {
+	doc := Document{
+		ID:    id,
+		Terms: map[string]int{},
+	}
+
+	for tokens.Scan() {
+		token := tokens.Token()
+		if token.IsSpace() || token.IsPunct() {
+			continue
+		}
+		doc.Terms[strings.ToLower(token.String())]++
+		doc.Length++
+	}
+	if err := tokens.Err(); err != nil {
+		return doc, err
+	}
+
+	return doc, nil
+}
+
+// Add counts the terms in tokens, and adds the document to the corpus
+func (c *Corpus) Add(id string, tokens *jargon.TokenStream) error {
+	doc, err := Count(id, tokens)
+	if err != nil {
+		return err
+	}
+
+	c.AddDocument(doc)
+	return nil
+}
+
+// AddDocument adds a document whose terms have already been counted
+func (c *Corpus) AddDocument(doc Document) {
+	c.Docs = append(c.Docs, doc)
+	for term := range doc.Terms {
+		c.DF[term]++
+	}
+}
+
+// Lookup returns the document with the given id
+func (c *Corpus) Lookup(id string) (Document, bool) {
+	for _, doc := range c.Docs {
+		if doc.ID == id {
+			return doc, true
+		}
+	}
+	return Document{}, false
+}
+
+// Measure is a method of weighting terms
+type Measure int
+
+const (
+	// TFIDF is term frequency (normalized by document length) times smoothed inverse document frequency
+	TFIDF Measure = iota
+	// BM25 is the Okapi BM25 weight, with k1 = 1.2 and b = 0.75
+	BM25
+)
+
+// BM25 parameters
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Weight is a term and its weight in a document
+type Weight struct {
+	Term   string  `json:"term"`
+	Weight float64 `json:"weight"`
+}
+
+// Weights returns the weights of each term in doc, relative to the corpus, highest first. doc need not be in the
+// corpus; see Count.
+func (c *Corpus) Weights(doc Document, measure Measure) []Weight {
+	n := float64(len(c.Docs))
+
+	total := 0
+	for _, d := range c.Docs {
+		total += d.Length
+	}
+	avg := 1.0
+	if len(c.Docs) > 0 && total > 0 {
+		avg = float64(total) / n
+	}
+
+	var result []Weight
+	for term, count := range doc.Terms {
+		df := float64(c.DF[term])
+		tf := float64(count)
+
+		var w float64
+		switch measure {
+		case BM25:
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			w = idf * tf * (k1 + 1) / (tf + k1*(1-b+b*float64(doc.Length)/avg))
+		default:
+			idf := math.Log((1+n)/(1+df)) + 1
+			w = tf / float64(doc.Length) * idf
+		}
+
+		result = append(result, Weight{Term: term, Weight: w})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Weight != result[j].Weight {
+			return result[i].Weight > result[j].Weight
+		}
+		return result[i].Term < result[j].Term
+	})
+	return result
+}
+
+// Keywords returns the n highest-weighted terms of the document with the given id. If n is not positive, there are none.
+func (c *Corpus) Keywords(id string, n int, measure Measure) ([]Weight, error) {
+	doc, found := c.Lookup(id)
+	if !found {
+		return nil, fmt.Errorf("document %q is not in the corpus", id)
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+
+	weights := c.Weights(doc, measure)
+	if len(weights) > n {
+		weights = weights[:n]
+	}
+	return weights, nil
+}
+
+// Save writes the corpus as JSON
+func (c *Corpus) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(c)
+}
+
+// Load reads a corpus written by Save
+func Load(r io.Reader) (*Corpus, error) {
+	c := New()
+	if err := json.NewDecoder(r).Decode(c); err != nil {
+		return nil, err
+	}
+	if c.DF == nil {
+		c.DF = map[string]int{}
+	}
+	return c, nil
+}
@@ -0,0 +1,101 @@
+package corpus_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/corpus"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+)
+
+var docs = []struct {
+	id, text string
+}{
+	{"rails", "We want developers for Ruby on Rails. Rails and more Rails, for the web."},
+	{"go", "We want developers for Go, and the web."},
+	{"rust", "We want developers for Rust. Rust, Rust, Rust."},
+}
+
+func testCorpus(t *testing.T) *corpus.Corpus {
+	c := corpus.New()
+	for _, doc := range docs {
+		tokens := jargon.TokenizeString(doc.text).Filter(stackoverflow.Tags)
+		if err := c.Add(doc.id, tokens); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return c
+}
+
+func TestKeywords(t *testing.T) {
+	c := testCorpus(t)
+
+	type test struct {
+		id       string
+		measure  corpus.Measure
+		expected string
+	}
+
+	tests := []test{
+		// Ruby on Rails is a single term, along with Rails
+		{"rails", corpus.TFIDF, "ruby-on-rails"},
+		{"rails", corpus.BM25, "ruby-on-rails"},
+		{"go", corpus.TFIDF, "go"},
+		{"rust", corpus.BM25, "rust"},
+	}
+
+	for _, test := range tests {
+		keywords, err := c.Keywords(test.id, 3, test.measure)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keywords) != 3 {
+			t.Errorf("expected 3 keywords, got %d", len(keywords))
+		}
+		if keywords[0].Term != test.expected {
+			t.Errorf("%s: expected top keyword %q, got %v", test.id, test.expected, keywords)
+		}
+	}
+
+	// Terms in every document weigh less than those in one
+	weights := c.Weights(c.Docs[1], corpus.TFIDF)
+	for _, w := range weights {
+		if w.Term == "developers" && w.Weight >= weights[0].Weight {
+			t.Errorf("expected developers to weigh less than %v, got %v", weights[0], w)
+		}
+	}
+
+	if _, err := c.Keywords("foo", 3, corpus.TFIDF); err == nil {
+		t.Error("expected an error for an unknown document")
+	}
+
+	for _, n := range []int{0, -1} {
+		keywords, err := c.Keywords("rails", n, corpus.TFIDF)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keywords) != 0 {
+			t.Errorf("expected no keywords for n %d, got %v", n, keywords)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	c := testCorpus(t)
+
+	var b bytes.Buffer
+	if err := c.Save(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := corpus.Load(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(c, loaded) {
+		t.Errorf("expected loaded corpus to equal saved")
+	}
+}
@@ -0,0 +1,249 @@
+// Package dedupe finds near-duplicate documents, using MinHash signatures with LSH (locality-sensitive hashing)
+// banding, or SimHash fingerprints, computed over word shingles. Documents are typically filtered first, for example
+// by ascii.Fold and stackoverflow.Tags, so that trivial differences do not count.
+package dedupe
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/ngram"
+)
+
+// Shingles returns the distinct word n-grams of size words in tokens, lowercase; spaces and punctuation are skipped.
+// Documents of fewer than size words have no shingles. A size of less than 1 is taken as 1.
+func Shingles(tokens *jargon.TokenStream, size int) ([]string, error) {
+	seen := map[string]bool{}
+	var result []string
+
+	shingles := tokens.Filter(ngram.Shingles(size, size, " ", true))
+	for shingles.Scan() {
+		s := strings.ToLower(shingles.Token().String())
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	if err := shingles.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func hash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix is the splitmix64 finalizer, to derive independent hashes from one
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// MinHash is a signature of a set of shingles; the similarity of two signatures estimates the Jaccard similarity of
+// their sets
+type MinHash []uint64
+
+// NewMinHash computes a signature of k hashes; it is nil if there are no shingles
+func NewMinHash(shingles []string, k int) MinHash {
+	if len(shingles) == 0 {
+		return nil
+	}
+
+	m := make(MinHash, k)
+	for i := range m {
+		m[i] = math.MaxUint64
+	}
+
+	for _, s := range shingles {
+		h := hash(s)
+		for i := range m {
+			x := mix(h + uint64(i)*0x9e3779b97f4a7c15)
+			if x < m[i] {
+				m[i] = x
+			}
+		}
+	}
+	return m
+}
+
+// Similarity estimates the Jaccard similarity of the sets from which the signatures were computed, as the fraction
+// of hashes in common. Signatures must be of the same length.
+func (m MinHash) Similarity(other MinHash) float64 {
+	if len(m) == 0 || len(m) != len(other) {
+		return 0
+	}
+
+	same := 0
+	for i := range m {
+		if m[i] == other[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(m))
+}
+
+// SimHash computes a 64-bit fingerprint of shingles; similar sets have fingerprints which differ in few bits, see Distance
+func SimHash(shingles []string) uint64 {
+	var weights [64]int
+	for _, s := range shingles {
+		h := hash(s)
+		for i := range weights {
+			if h&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for i, w := range weights {
+		if w > 0 {
+			fingerprint |= 1 << uint(i)
+		}
+	}
+	return fingerprint
+}
+
+// Distance is the number of bits which differ between two SimHash fingerprints
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// LSH is an index of MinHash signatures, which finds candidate near-duplicates by banding: signatures are divided
+// into bands of rows, and signatures which are identical in any band are candidates. Use NewLSH to create.
+type LSH struct {
+	bands, rows int
+	// buckets are keyed by band and the hashes of the band
+	buckets    map[string][]string
+	signatures map[string]MinHash
+}
+
+// NewLSH creates an LSH index for signatures of bands * rows hashes. More bands (of fewer rows) finds more candidates,
+// at lower similarities; the similarity at which a pair is likely to be a candidate is about (1/bands)^(1/rows).
+func NewLSH(bands, rows int) *LSH {
+	return &LSH{
+		bands:      bands,
+		rows:       rows,
+		buckets:    map[string][]string{},
+		signatures: map[string]MinHash{},
+	}
+}
+
+// Add adds a signature to the index. Nil signatures, of documents without shingles, are ignored.
+func (l *LSH) Add(id string, m MinHash) error {
+	if m == nil {
+		return nil
+	}
+	if len(m) != l.bands*l.rows {
+		return fmt.Errorf("expected a signature of %d hashes, got %d", l.bands*l.rows, len(m))
+	}
+	if _, found := l.signatures[id]; found {
+		return fmt.Errorf("%q is already in the index", id)
+	}
+
+	l.signatures[id] = m
+
+	for band := 0; band < l.bands; band++ {
+		key := fmt.Sprint(band, m[band*l.rows:(band+1)*l.rows])
+		l.buckets[key] = append(l.buckets[key], id)
+	}
+	return nil
+}
+
+// Pair is a pair of near-duplicates
+type Pair struct {
+	A, B string
+	// Similarity is the estimated Jaccard similarity
+	Similarity float64
+}
+
+// Pairs returns candidate pairs whose estimated similarity is at least threshold, most similar first
+func (l *LSH) Pairs(threshold float64) []Pair {
+	seen := map[[2]string]bool{}
+	var result []Pair
+
+	for _, ids := range l.buckets {
+		for i := range ids {
+			for j := i + 1; j < len(ids); j++ {
+				a, b := ids[i], ids[j]
+				if a > b {
+					a, b = b, a
+				}
+				key := [2]string{a, b}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				sim := l.signatures[a].Similarity(l.signatures[b])
+				if sim >= threshold {
+					result = append(result, Pair{A: a, B: b, Similarity: sim})
+				}
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Similarity != result[j].Similarity {
+			return result[i].Similarity > result[j].Similarity
+		}
+		if result[i].A != result[j].A {
+			return result[i].A < result[j].A
+		}
+		return result[i].B < result[j].B
+	})
+	return result
+}
+
+// Groups returns groups of near-duplicates, i.e. the connected components of pairs. IDs within a group, and groups,
+// are sorted.
+func Groups(pairs []Pair) [][]string {
+	parent := map[string]string{}
+	var find func(id string) string
+	find = func(id string) string {
+		if parent[id] == "" || parent[id] == id {
+			parent[id] = id
+			return id
+		}
+		root := find(parent[id])
+		parent[id] = root
+		return root
+	}
+
+	for _, p := range pairs {
+		a, b := find(p.A), find(p.B)
+		if a != b {
+			parent[b] = a
+		}
+	}
+
+	members := map[string][]string{}
+	for id := range parent {
+		root := find(id)
+		members[root] = append(members[root], id)
+	}
+
+	var result [][]string
+	for _, group := range members {
+		sort.Strings(group)
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i][0] < result[j][0]
+	})
+	return result
+}
@@ -0,0 +1,91 @@
+package dedupe_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/dedupe"
+	"github.com/clipperhouse/jargon/filters/ascii"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+)
+
+var docs = map[string]string{
+	"a": "Senior engineer wanted for our café platform, using Ruby on Rails and PostgreSQL. Remote work is possible, with a competitive salary and benefits.",
+	// Reposted with small edits, which filters normalize
+	"b": "Senior engineer wanted for our cafe platform, using RoR and Postgres. Remote work is possible, with a competitive salary and benefits!",
+	// A further edit
+	"c": "Senior engineer wanted for our cafe platform, using Rails and Postgres. Remote work is possible, with a competitive salary and great benefits.",
+	"d": "Junior designer needed for a print magazine in Chicago. Must know Photoshop and InDesign, and love typography.",
+}
+
+func shingles(t *testing.T, text string) []string {
+	tokens := jargon.TokenizeString(text).Filter(ascii.Fold, stackoverflow.Tags)
+	result, err := dedupe.Shingles(tokens, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestShingles(t *testing.T) {
+	got, err := dedupe.Shingles(jargon.TokenizeString("Ruby on Rails"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"ruby", "on", "rails"}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected a size of 0 to be taken as 1, %v, got %v", expected, got)
+	}
+}
+
+func TestMinHash(t *testing.T) {
+	a := dedupe.NewMinHash(shingles(t, docs["a"]), 128)
+	b := dedupe.NewMinHash(shingles(t, docs["b"]), 128)
+	d := dedupe.NewMinHash(shingles(t, docs["d"]), 128)
+
+	if sim := a.Similarity(b); sim != 1 {
+		t.Errorf("expected a and b to be identical after filtering, got %v", sim)
+	}
+	if sim := a.Similarity(d); sim > 0.1 {
+		t.Errorf("expected a and d to be dissimilar, got %v", sim)
+	}
+
+	if dedupe.NewMinHash(shingles(t, "too short"), 128) != nil {
+		t.Error("expected nil signature without shingles")
+	}
+}
+
+func TestSimHash(t *testing.T) {
+	a := dedupe.SimHash(shingles(t, docs["a"]))
+	c := dedupe.SimHash(shingles(t, docs["c"]))
+	d := dedupe.SimHash(shingles(t, docs["d"]))
+
+	if dedupe.Distance(a, c) >= dedupe.Distance(a, d) {
+		t.Errorf("expected a to be closer to c (%d) than to d (%d)", dedupe.Distance(a, c), dedupe.Distance(a, d))
+	}
+}
+
+func TestLSH(t *testing.T) {
+	lsh := dedupe.NewLSH(32, 4)
+	for _, id := range []string{"a", "b", "c", "d"} {
+		if err := lsh.Add(id, dedupe.NewMinHash(shingles(t, docs[id]), 128)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pairs := lsh.Pairs(0.5)
+	groups := dedupe.Groups(pairs)
+
+	expected := [][]string{{"a", "b", "c"}}
+	if !reflect.DeepEqual(expected, groups) {
+		t.Errorf("expected groups %v, got %v (pairs %v)", expected, groups, pairs)
+	}
+
+	if err := lsh.Add("e", make(dedupe.MinHash, 10)); err == nil {
+		t.Error("expected an error for a signature of the wrong length")
+	}
+	if err := lsh.Add("a", make(dedupe.MinHash, 128)); err == nil {
+		t.Error("expected an error for a duplicate id")
+	}
+}
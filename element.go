@@ -0,0 +1,292 @@
+package jargon
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Element describes an HTML element, which encloses tokens from TokenizeHTML; see Token.Element.
+type Element struct {
+	// Tag is the lowercase tag name, such as "div"
+	Tag string
+	// ID is the value of the id attribute, if any
+	ID string
+	// Class is the value of the class attribute, split on white space
+	Class []string
+	// Parent is the enclosing element, nil at the root of the document
+	Parent *Element
+}
+
+// String returns a CSS-like description of the element, such as div#main.content
+func (e *Element) String() string {
+	var b strings.Builder
+	b.WriteString(e.Tag)
+	if e.ID != "" {
+		b.WriteString("#" + e.ID)
+	}
+	for _, class := range e.Class {
+		b.WriteString("." + class)
+	}
+	return b.String()
+}
+
+// Path returns a CSS-like path from the root of the document to the element, such as html > body > div#main.content > p
+func (e *Element) Path() string {
+	var path []string
+	for x := e; x != nil; x = x.Parent {
+		path = append([]string{x.String()}, path...)
+	}
+	return strings.Join(path, " > ")
+}
+
+// Within determines whether the element, or any of its ancestors, has the given tag
+func (e *Element) Within(tag string) bool {
+	for x := e; x != nil; x = x.Parent {
+		if x.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Element) hasClass(class string) bool {
+	for _, c := range e.Class {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Selector is a simplified CSS selector, for matching Elements. Use ParseSelector to create.
+type Selector struct {
+	groups [][]compound
+}
+
+type compound struct {
+	// child indicates that this compound is the immediate child (>) of the preceding one, rather than a descendant
+	child bool
+
+	tag     string
+	id      string
+	classes []string
+}
+
+// ParseSelector parses a simplified CSS selector. It supports type (p), id (#main), class (.content) and universal (*)
+// selectors, in combination (div#main.content); descendant (div p) and child (div > p) combinators; and groups (h1, h2).
+func ParseSelector(s string) (*Selector, error) {
+	sel := &Selector{}
+
+	for _, group := range strings.Split(s, ",") {
+		fields := strings.Fields(strings.ReplaceAll(group, ">", " > "))
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("empty selector in %q", s)
+		}
+
+		var compounds []compound
+		child := false
+		for _, field := range fields {
+			if field == ">" {
+				if child || len(compounds) == 0 {
+					return nil, fmt.Errorf("unexpected > in selector %q", s)
+				}
+				child = true
+				continue
+			}
+
+			c, err := parseCompound(field)
+			if err != nil {
+				return nil, err
+			}
+			c.child = child
+			child = false
+			compounds = append(compounds, c)
+		}
+		if child {
+			return nil, fmt.Errorf("unexpected > at end of selector %q", s)
+		}
+
+		sel.groups = append(sel.groups, compounds)
+	}
+
+	return sel, nil
+}
+
+// parseCompound parses a selector such as div#main.content
+func parseCompound(s string) (compound, error) {
+	var c compound
+
+	i := strings.IndexAny(s, "#.")
+	if i < 0 {
+		i = len(s)
+	}
+	if tag := s[:i]; tag != "*" {
+		c.tag = strings.ToLower(tag)
+	}
+
+	for i < len(s) {
+		kind := s[i]
+		end := strings.IndexAny(s[i+1:], "#.")
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += i + 1
+		}
+
+		name := s[i+1 : end]
+		if name == "" {
+			return c, fmt.Errorf("expected a name following %q in selector %q", kind, s)
+		}
+
+		switch kind {
+		case '#':
+			c.id = name
+		case '.':
+			c.classes = append(c.classes, name)
+		}
+		i = end
+	}
+
+	return c, nil
+}
+
+func (c compound) match(e *Element) bool {
+	if c.tag != "" && c.tag != e.Tag {
+		return false
+	}
+	if c.id != "" && c.id != e.ID {
+		return false
+	}
+	for _, class := range c.classes {
+		if !e.hasClass(class) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match determines whether the element matches the selector
+func (sel *Selector) Match(e *Element) bool {
+	if e == nil {
+		return false
+	}
+	for _, group := range sel.groups {
+		if matchGroup(group, len(group)-1, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGroup matches compounds right-to-left, i.e. group[i] against e, and the preceding compounds against its ancestors
+func matchGroup(group []compound, i int, e *Element) bool {
+	if !group[i].match(e) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+
+	if group[i].child {
+		return e.Parent != nil && matchGroup(group, i-1, e.Parent)
+	}
+
+	for x := e.Parent; x != nil; x = x.Parent {
+		if matchGroup(group, i-1, x) {
+			return true
+		}
+	}
+	return false
+}
+
+// Within determines whether the element, or any of its ancestors, matches the selector
+func (sel *Selector) Within(e *Element) bool {
+	for x := e; x != nil; x = x.Parent {
+		if sel.Match(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns a Filter which applies f only to tokens within elements matching the selector; other tokens pass through
+// untouched. It is intended for use with TokenizeHTML, for example, to recognize terms only within the main content of a page.
+func (sel *Selector) Filter(f Filter) Filter {
+	return func(incoming *TokenStream) *TokenStream {
+		w := &within{
+			selector: sel,
+			incoming: incoming,
+			filter:   f,
+		}
+		return NewTokenStream(w.next)
+	}
+}
+
+type within struct {
+	selector *Selector
+	incoming *TokenStream
+	filter   Filter
+
+	// filtered is the output of the filter over the current run of matching tokens, nil if not in a run
+	filtered *TokenStream
+	// pending is a token which was read ahead, at the boundary of a run
+	pending *Token
+}
+
+func (w *within) next() (*Token, error) {
+	if w.filtered != nil {
+		token, err := w.filtered.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token != nil {
+			return token, nil
+		}
+		// End of run
+		w.filtered = nil
+	}
+
+	token := w.pending
+	w.pending = nil
+	if token == nil {
+		var err error
+		token, err = w.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, nil
+		}
+	}
+
+	if !w.selector.Within(token.Element()) {
+		return token, nil
+	}
+
+	// Start of a run; the filter sees a stream which ends where the run does
+	first := token
+	run := func() (*Token, error) {
+		if first != nil {
+			t := first
+			first = nil
+			return t, nil
+		}
+		if w.pending != nil {
+			// Boundary already found
+			return nil, nil
+		}
+
+		t, err := w.incoming.Next()
+		if err != nil || t == nil {
+			return t, err
+		}
+		if !w.selector.Within(t.Element()) {
+			w.pending = t
+			return nil, nil
+		}
+		return t, nil
+	}
+
+	w.filtered = w.filter(NewTokenStream(run))
+	return w.next()
+}
@@ -0,0 +1,121 @@
+package jargon_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+)
+
+func TestElement(t *testing.T) {
+	h := `<html><body><div id="main" class="content wide"><h1>Ruby on Rails</h1><p>Learn <b>Go</b></p></div><nav><ul><li>Home<li>Jobs</ul></nav></body></html>`
+
+	tokens, err := jargon.TokenizeHTML(strings.NewReader(h)).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	got := map[string]string{}
+	for _, token := range tokens {
+		if e := token.Element(); e != nil {
+			got[token.String()] = e.Path()
+		}
+	}
+
+	expected := map[string]string{
+		"Ruby":  "html > body > div#main.content.wide > h1",
+		"Learn": "html > body > div#main.content.wide > p",
+		"Go":    "html > body > div#main.content.wide > p > b",
+		"<b>":   "html > body > div#main.content.wide > p > b",
+		"</b>":  "html > body > div#main.content.wide > p > b",
+		"<div id=\"main\" class=\"content wide\">": "html > body > div#main.content.wide",
+		// implicitly closed
+		"Home": "html > body > nav > ul > li",
+		"Jobs": "html > body > nav > ul > li",
+	}
+
+	for value, path := range expected {
+		if got[value] != path {
+			t.Errorf("expected token %q to have path %q, got %q", value, path, got[value])
+		}
+	}
+
+	plain, err := jargon.TokenizeString("Ruby").Next()
+	if err != nil {
+		t.Error(err)
+	}
+	if plain.Element() != nil {
+		t.Errorf("expected tokens from Tokenize to have no element")
+	}
+}
+
+func TestSelector(t *testing.T) {
+	div := &jargon.Element{Tag: "div", ID: "main", Class: []string{"content", "wide"}}
+	p := &jargon.Element{Tag: "p", Parent: div}
+	b := &jargon.Element{Tag: "b", Parent: p}
+
+	type test struct {
+		selector string
+		element  *jargon.Element
+		match    bool
+		within   bool
+	}
+
+	tests := []test{
+		{"div", div, true, true},
+		{"#main", div, true, true},
+		{".content", div, true, true},
+		{"div#main.content.wide", div, true, true},
+		{"div.narrow", div, false, false},
+		{"*", b, true, true},
+		{"div p", p, true, true},
+		{"div > p", p, true, true},
+		{"div > b", b, false, false},
+		{"div b", b, true, true},
+		{"#main > p > b", b, true, true},
+		{"h1, p", p, true, true},
+		{"nav", b, false, false},
+		{"div", b, false, true},
+	}
+
+	for _, test := range tests {
+		sel, err := jargon.ParseSelector(test.selector)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if got := sel.Match(test.element); got != test.match {
+			t.Errorf("expected %q to match %q to be %t", test.selector, test.element.Path(), test.match)
+		}
+		if got := sel.Within(test.element); got != test.within {
+			t.Errorf("expected %q within %q to be %t", test.selector, test.element.Path(), test.within)
+		}
+	}
+
+	invalids := []string{"", "div >", "> p", "div,", "div#", "p..x"}
+	for _, invalid := range invalids {
+		if _, err := jargon.ParseSelector(invalid); err == nil {
+			t.Errorf("expected an error parsing selector %q", invalid)
+		}
+	}
+}
+
+func TestSelectorFilter(t *testing.T) {
+	h := `<p>Ruby on Rails</p><div id="main"><p>Ruby on Rails</p></div><p>Ruby on Rails</p>`
+
+	sel, err := jargon.ParseSelector("#main")
+	if err != nil {
+		t.Error(err)
+	}
+
+	got, err := jargon.TokenizeHTML(strings.NewReader(h)).Filter(sel.Filter(stackoverflow.Tags)).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `<p>Ruby on Rails</p><div id="main"><p>ruby-on-rails</p></div><p>Ruby on Rails</p>`
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
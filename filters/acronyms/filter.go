@@ -0,0 +1,393 @@
+// Package acronyms provides a filter to expand acronyms, such as CI → Continuous Integration, using definitions in the
+// text and a dictionary, for use with jargon
+package acronyms
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Mode determines how acronyms are replaced
+type Mode int
+
+const (
+	// Expand replaces an acronym with its long form, e.g. CI → Continuous Integration
+	Expand Mode = iota
+	// Annotate appends the long form to the acronym, e.g. CI → CI (Continuous Integration)
+	Annotate
+)
+
+// Options configure the filter
+type Options struct {
+	// Dictionary maps acronyms to long forms, e.g. CI: Continuous Integration. Acronyms are case-sensitive.
+	Dictionary map[string]string
+	Mode       Mode
+}
+
+// Acronyms is a filter which expands acronyms defined in the text, with no dictionary
+var Acronyms = NewFilter(Options{})
+
+// NewFilter creates a filter which learns acronym definitions in the text, in the form "Continuous Integration (CI)"
+// or "CI (Continuous Integration)", using the Schwartz–Hearst algorithm, and replaces subsequent mentions with a lemma
+// of the long form. Definitions in the text take precedence over the dictionary; they last for the stream, i.e. the
+// document. An acronym at a definition is left as is.
+func NewFilter(options Options) jargon.Filter {
+	f := &filter{
+		options: options,
+	}
+	return f.Filter
+}
+
+// ReadDictionary reads a dictionary of lines in the form "CI: Continuous Integration". Blank lines and lines starting
+// with # are ignored.
+func ReadDictionary(r io.Reader) (map[string]string, error) {
+	dictionary := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected a line in the form acronym: long form, got %q", n, line)
+		}
+		short, long := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if short == "" || long == "" {
+			return nil, fmt.Errorf("line %d: expected a line in the form acronym: long form, got %q", n, line)
+		}
+		dictionary[short] = long
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return dictionary, nil
+}
+
+type filter struct {
+	options Options
+}
+
+// Filter expands acronyms
+func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		filter:      f,
+		incoming:    incoming,
+		buffer:      tokenqueue.New(),
+		outgoing:    tokenqueue.New(),
+		definitions: map[string]string{},
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer   *tokenqueue.TokenQueue
+	outgoing *tokenqueue.TokenQueue
+	// history is the most recent outgoing tokens, in which to look for long forms
+	history []*jargon.Token
+	// definitions are learned from the text
+	definitions map[string]string
+}
+
+// maxHistory is the number of outgoing tokens to remember; long forms are at most 20 words, plus spaces
+const maxHistory = 48
+
+// maxParens is the maximum number of tokens within parentheses
+const maxParens = 24
+
+// peek returns the token at index i of the lookahead buffer, filling the buffer as needed; nil indicates EOF
+func (t *tokens) peek(i int) (*jargon.Token, error) {
+	for t.buffer.Len() <= i {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, nil
+		}
+		t.buffer.Push(token)
+	}
+	return t.buffer.Tokens[i], nil
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	token, err := t.advance()
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		t.history = append(t.history, token)
+		if len(t.history) > maxHistory {
+			t.history = t.history[len(t.history)-maxHistory:]
+		}
+	}
+	return token, nil
+}
+
+func (t *tokens) advance() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.peek(0)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.String() == "(" {
+		defined, err := t.define()
+		if err != nil {
+			return nil, err
+		}
+		if defined > 0 {
+			// Pass the definition along verbatim
+			for i := 0; i < defined; i++ {
+				t.buffer.PopTo(t.outgoing)
+			}
+			return t.outgoing.Pop(), nil
+		}
+		return t.buffer.Pop(), nil
+	}
+
+	if token.IsSpace() || token.IsPunct() {
+		return t.buffer.Pop(), nil
+	}
+
+	long, found := t.lookup(token.String())
+	if !found {
+		return t.buffer.Pop(), nil
+	}
+
+	// An acronym followed by a parenthetical may be a definition, in the form "CI (Continuous Integration)"
+	space, err := t.peek(1)
+	if err != nil {
+		return nil, err
+	}
+	paren, err := t.peek(2)
+	if err != nil {
+		return nil, err
+	}
+	if space != nil && paren != nil && space.IsSpace() && paren.String() == "(" {
+		return t.buffer.Pop(), nil
+	}
+
+	t.buffer.Pop()
+
+	s := long
+	if t.filter.options.Mode == Annotate {
+		s = token.String() + " (" + long + ")"
+	}
+	return jargon.NewTokenFrom(s, true, token), nil
+}
+
+func (t *tokens) lookup(short string) (string, bool) {
+	if long, found := t.definitions[short]; found {
+		return long, true
+	}
+	long, found := t.filter.options.Dictionary[short]
+	return long, found
+}
+
+// define looks for a definition in the parenthetical at the start of the buffer, returning the number of tokens
+// through the closing parenthesis if one is found
+func (t *tokens) define() (int, error) {
+	var inner []*jargon.Token
+	closed := 0
+	for i := 1; i <= maxParens; i++ {
+		token, err := t.peek(i)
+		if err != nil {
+			return 0, err
+		}
+		if token == nil || token.String() == "(" {
+			return 0, nil
+		}
+		if token.String() == ")" {
+			closed = i + 1
+			break
+		}
+		inner = append(inner, token)
+	}
+	if closed == 0 || len(inner) == 0 {
+		return 0, nil
+	}
+
+	content := strings.TrimSpace(join(inner))
+
+	// Continuous Integration (CI)
+	if isShort(content) {
+		candidate := t.preceding(maxWords(content))
+		if long := longForm(content, candidate); long != "" {
+			t.definitions[content] = long
+			return closed, nil
+		}
+	}
+
+	// CI (Continuous Integration)
+	preceding := t.preceding(1)
+	if isShort(preceding) && len(strings.Fields(content)) <= maxWords(preceding) {
+		if long := longForm(preceding, content); long == content {
+			t.definitions[preceding] = long
+			return closed, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// preceding returns up to n words preceding the current position, not crossing punctuation other than hyphens
+func (t *tokens) preceding(n int) string {
+	start := len(t.history)
+	words := 0
+
+	// Skip the space before the parenthesis
+	for start > 0 && t.history[start-1].IsSpace() {
+		start--
+	}
+	end := start
+
+	for start > 0 && words < n {
+		token := t.history[start-1]
+		if token.IsPunct() && !token.IsSpace() && token.String() != "-" {
+			break
+		}
+		if !token.IsSpace() && !token.IsPunct() {
+			words++
+		}
+		start--
+	}
+
+	return strings.TrimSpace(join(t.history[start:end]))
+}
+
+func join(tokens []*jargon.Token) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.String())
+	}
+	return b.String()
+}
+
+// isShort determines whether s may be an acronym: 2 to 10 characters, at most 2 words, beginning with a letter or
+// digit, and containing an uppercase letter
+func isShort(s string) bool {
+	n := len([]rune(s))
+	if n < 2 || n > 10 || len(strings.Fields(s)) > 2 {
+		return false
+	}
+
+	first := []rune(s)[0]
+	if !unicode.IsLetter(first) && !unicode.IsDigit(first) {
+		return false
+	}
+
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxWords is the maximum number of words in a long form for short, per Schwartz & Hearst
+func maxWords(short string) int {
+	n := len([]rune(short))
+	if n+5 < n*2 {
+		return n + 5
+	}
+	return n * 2
+}
+
+// longForm finds the shortest suffix of candidate, starting at a word, which matches short, per Schwartz & Hearst,
+// "A Simple Algorithm for Identifying Abbreviation Definitions in Biomedical Text" (2003). Each character of short must
+// appear in order in the long form, and the first must begin a word. It returns "" if there is none.
+func longForm(short, candidate string) string {
+	s := []rune(strings.ToLower(short))
+	l := []rune(strings.ToLower(candidate))
+
+	si := len(s) - 1
+	li := len(l) - 1
+
+	for si >= 0 {
+		c := s[si]
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			si--
+			continue
+		}
+
+		for li >= 0 && (l[li] != c || (si == 0 && li > 0 && (unicode.IsLetter(l[li-1]) || unicode.IsDigit(l[li-1])))) {
+			li--
+		}
+		if li < 0 {
+			return ""
+		}
+		li--
+		si--
+	}
+
+	// Back up to the start of the word
+	start := li + 1
+	for start > 0 && !unicode.IsSpace(l[start-1]) {
+		start--
+	}
+
+	result := strings.TrimSpace(string([]rune(candidate)[start:]))
+	if len([]rune(result)) <= len(s) || strings.Contains(" "+result+" ", " "+short+" ") {
+		return ""
+	}
+	return result
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "acronyms",
+		Description: "expand acronyms defined in the text, e.g. Continuous Integration (CI) … CI → Continuous Integration",
+		Params: []jargon.Param{
+			{Name: "mode", Description: "expand replaces acronyms with long forms; annotate appends long forms", Default: "expand", Options: []string{"expand", "annotate"}},
+			{Name: "dictionary", Description: "path to a dictionary file, of lines in the form acronym: long form"},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			options := Options{}
+
+			switch params["mode"] {
+			case "expand":
+				options.Mode = Expand
+			case "annotate":
+				options.Mode = Annotate
+			default:
+				return nil, fmt.Errorf("mode %q is not known; options are expand, annotate", params["mode"])
+			}
+
+			if path := params["dictionary"]; path != "" {
+				file, err := os.Open(path)
+				if err != nil {
+					return nil, err
+				}
+				defer file.Close()
+
+				options.Dictionary, err = ReadDictionary(file)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", path, err)
+				}
+			}
+
+			return NewFilter(options), nil
+		},
+	})
+}
@@ -0,0 +1,97 @@
+package acronyms_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/acronyms"
+)
+
+func filtered(t *testing.T, s string, filter jargon.Filter) string {
+	result, err := jargon.TokenizeString(s).Filter(filter).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestDefinitions(t *testing.T) {
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		{
+			"We use Continuous Integration (CI). Our CI is fast.",
+			"We use Continuous Integration (CI). Our Continuous Integration is fast.",
+		},
+		{
+			"The CI (Continuous Integration) server. CI runs tests.",
+			"The CI (Continuous Integration) server. Continuous Integration runs tests.",
+		},
+		{
+			"Use the World Health Organization (WHO) guidelines; WHO says so.",
+			"Use the World Health Organization (WHO) guidelines; World Health Organization says so.",
+		},
+		// Mentions before the definition are not expanded
+		{
+			"CI is great. Continuous Integration (CI) rocks.",
+			"CI is great. Continuous Integration (CI) rocks.",
+		},
+		// Not definitions
+		{
+			"We like it (a lot). Cats (CI) are nice, CI.",
+			"We like it (a lot). Cats (CI) are nice, CI.",
+		},
+	}
+
+	for _, test := range tests {
+		got := filtered(t, test.input, acronyms.Acronyms)
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	dictionary, err := acronyms.ReadDictionary(strings.NewReader("# acronyms\nCI: Continuous Integration\n\nCD: Continuous Delivery\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"CI": "Continuous Integration", "CD": "Continuous Delivery"}
+	if !reflect.DeepEqual(dictionary, expected) {
+		t.Errorf("expected %v, got %v", expected, dictionary)
+	}
+
+	if _, err := acronyms.ReadDictionary(strings.NewReader("CI Continuous Integration")); err == nil {
+		t.Error("expected an error for a line without a colon")
+	}
+
+	input := "CI and CD, not cd. CD (Compact Disc) is old; CD."
+
+	got := filtered(t, input, acronyms.NewFilter(acronyms.Options{Dictionary: dictionary}))
+	expected1 := "Continuous Integration and Continuous Delivery, not cd. CD (Compact Disc) is old; Compact Disc."
+	if got != expected1 {
+		t.Errorf("expected %q, got %q", expected1, got)
+	}
+
+	got = filtered(t, "CI and CD", acronyms.NewFilter(acronyms.Options{Dictionary: dictionary, Mode: acronyms.Annotate}))
+	expected2 := "CI (Continuous Integration) and CD (Continuous Delivery)"
+	if got != expected2 {
+		t.Errorf("expected %q, got %q", expected2, got)
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tokens := jargon.TokenizeString("Continuous Integration (CI) and CI").Filter(acronyms.Acronyms).Lemmas()
+	lemmas, err := tokens.ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lemmas) != 1 || lemmas[0].String() != "Continuous Integration" || lemmas[0].Original() != "CI" {
+		t.Errorf("expected one lemma of Continuous Integration from CI, got %v", lemmas)
+	}
+}
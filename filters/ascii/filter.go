@@ -30,10 +30,18 @@ import (
 // Ported from Lucene org.apache.lucene.analysis.miscellaneous
 var Fold = mapper.NewFilter(folder)
 
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "ascii",
+		Description: "replace diacritics with ascii equivalents, e.g. café → cafe",
+		Filter:      Fold,
+	})
+}
+
 func folder(token *jargon.Token) *jargon.Token {
 	fold, folded := FoldString(token.String())
 	if folded {
-		return jargon.NewToken(fold, true)
+		return jargon.NewTokenFrom(fold, true, token)
 	}
 	return token
 }
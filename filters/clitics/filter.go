@@ -0,0 +1,250 @@
+// Package clitics provides a filter to strip possessives and elisions from words, such as "React's" → "React" and
+// "l'ordinateur" → "ordinateur", so that the words match dictionaries, for use with jargon
+package clitics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Options determine which clitics are stripped
+type Options struct {
+	// Possessives strips English possessive 's, and trailing apostrophes following s, e.g. React's → React and
+	// Kubernetes’ → Kubernetes. An apostrophe which closes a quote, as in 'jobs', is not a possessive.
+	Possessives bool
+	// Elisions are prefixes to strip, including the apostrophe, e.g. l'; see contractions.FrenchElisions and
+	// contractions.ItalianElisions. They are matched case-insensitively, with straight ' or smart ’ apostrophes.
+	Elisions []string
+	// Emit re-emits the clitic as a separate token, so that the stream still round-trips to the original text
+	Emit bool
+}
+
+// Possessives is a filter which strips English possessives
+var Possessives = NewFilter(Options{Possessives: true})
+
+// NewFilter creates a filter which strips clitics from words. Stripped words are lemmas, recording the original token
+// as their origin.
+func NewFilter(options Options) jargon.Filter {
+	f := &filter{
+		options: options,
+		elider:  contractions.NewElider(options.Elisions),
+	}
+	return f.Filter
+}
+
+type filter struct {
+	options Options
+	elider  contractions.Elider
+}
+
+// Filter strips clitics
+func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		filter:   f,
+		incoming: incoming,
+		buffer:   tokenqueue.New(),
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer   *tokenqueue.TokenQueue
+	outgoing *tokenqueue.TokenQueue
+	// previous is the most recent incoming token, and quoted indicates that a quote was opened, such as 'jobs', whose
+	// closing apostrophe is not a possessive
+	previous *jargon.Token
+	quoted   bool
+}
+
+// peek returns the token at index i of the lookahead buffer, filling the buffer as needed; nil indicates EOF
+func (t *tokens) peek(i int) (*jargon.Token, error) {
+	for t.buffer.Len() <= i {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, nil
+		}
+		t.buffer.Push(token)
+	}
+	return t.buffer.Tokens[i], nil
+}
+
+// contracted are words whose 's is a contraction of is or has, rather than a possessive
+var contracted = map[string]bool{
+	"it": true, "he": true, "she": true, "that": true, "what": true, "who": true, "where": true, "when": true,
+	"why": true, "how": true, "there": true, "here": true, "let": true,
+}
+
+func isApostrophe(s string) bool {
+	return s == "'" || s == "’"
+}
+
+// quote tracks opening and closing quotes, given a punctuation or space token; an apostrophe which precedes a word,
+// and does not follow one, opens a quote
+func (t *tokens) quote(token *jargon.Token) error {
+	s := token.String()
+	switch {
+	case s == "‘" || (s == "'" && (t.previous == nil || t.previous.IsPunct() || t.previous.IsSpace())):
+		following, err := t.peek(0)
+		if err != nil {
+			return err
+		}
+		if following != nil && startsWord(following.String()) {
+			t.quoted = true
+		}
+	case isApostrophe(s), strings.Contains(s, "\n"):
+		t.quoted = false
+	}
+	return nil
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.peek(0)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+	t.buffer.Pop()
+	defer func() {
+		t.previous = token
+	}()
+
+	if token.IsPunct() || token.IsSpace() {
+		if err := t.quote(token); err != nil {
+			return nil, err
+		}
+		return token, nil
+	}
+
+	s := token.String()
+	var prefix, suffix string
+	var origin []*jargon.Token
+
+	if p, rest, ok := t.filter.elider.Split(s); ok {
+		prefix, s = p, rest
+	}
+
+	if t.filter.options.Possessives {
+		lower := strings.ToLower(s)
+		switch {
+		case strings.HasSuffix(lower, "'s") || strings.HasSuffix(lower, "’s"):
+			width := len("'s")
+			if strings.HasSuffix(lower, "’s") {
+				width = len("’s")
+			}
+			if word := s[:len(s)-width]; word != "" && !contracted[strings.ToLower(word)] {
+				s, suffix = word, s[len(word):]
+			}
+		case strings.HasSuffix(lower, "s"):
+			// A trailing apostrophe is a separate token, e.g. Kubernetes, ’
+			apostrophe, err := t.peek(0)
+			if err != nil {
+				return nil, err
+			}
+			if apostrophe == nil || !isApostrophe(apostrophe.String()) {
+				break
+			}
+			// Closing a quote, e.g. 'jobs'
+			if t.quoted {
+				break
+			}
+
+			// Not an apostrophe within a word
+			following, err := t.peek(1)
+			if err != nil {
+				return nil, err
+			}
+			if following != nil && startsWord(following.String()) {
+				break
+			}
+
+			suffix = apostrophe.String()
+			origin = []*jargon.Token{apostrophe}
+			t.buffer.Pop()
+		}
+	}
+
+	if prefix == "" && suffix == "" {
+		return token, nil
+	}
+
+	origin = append([]*jargon.Token{token}, origin...)
+	stripped := jargon.NewTokenFrom(s, true, origin...)
+
+	if !t.filter.options.Emit {
+		return stripped, nil
+	}
+
+	if suffix != "" {
+		t.outgoing.Push(stripped, jargon.NewTokenFrom(suffix, false, origin[len(origin)-1]))
+	} else {
+		t.outgoing.Push(stripped)
+	}
+	if prefix != "" {
+		return jargon.NewTokenFrom(prefix, false, token), nil
+	}
+	return t.outgoing.Pop(), nil
+}
+
+func startsWord(s string) bool {
+	for _, r := range s {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+	return false
+}
+
+var elisions = map[string][]string{
+	"none":    nil,
+	"french":  contractions.FrenchElisions,
+	"italian": contractions.ItalianElisions,
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "clitics",
+		Description: "strip possessives and elisions, e.g. React's → React, l'ordinateur → ordinateur",
+		Params: []jargon.Param{
+			{Name: "possessives", Description: "whether to strip English possessives", Default: "true", Options: []string{"true", "false"}},
+			{Name: "elisions", Description: "language of elisions to strip", Default: "none", Options: []string{"none", "french", "italian"}},
+			{Name: "emit", Description: "whether to re-emit clitics as separate tokens", Default: "false", Options: []string{"true", "false"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			possessives, err := strconv.ParseBool(params["possessives"])
+			if err != nil {
+				return nil, fmt.Errorf("possessives: %v", err)
+			}
+			emit, err := strconv.ParseBool(params["emit"])
+			if err != nil {
+				return nil, fmt.Errorf("emit: %v", err)
+			}
+			e, found := elisions[params["elisions"]]
+			if !found {
+				return nil, fmt.Errorf("elisions %q is not known; options are none, french, italian", params["elisions"])
+			}
+
+			if possessives && !emit && e == nil {
+				return Possessives, nil
+			}
+			return NewFilter(Options{Possessives: possessives, Elisions: e, Emit: emit}), nil
+		},
+	})
+}
@@ -0,0 +1,116 @@
+package clitics_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/clitics"
+	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+)
+
+func values(t *testing.T, s string, filter jargon.Filter) []string {
+	tokens, err := jargon.TokenizeString(s).Filter(filter).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result []string
+	for _, token := range tokens {
+		result = append(result, token.String())
+	}
+	return result
+}
+
+func TestPossessives(t *testing.T) {
+	type test struct {
+		given    string
+		expected []string
+	}
+
+	tests := []test{
+		{"React's hooks", []string{"React", " ", "hooks"}},
+		{"Kubernetes’ pods, the users' data", []string{"Kubernetes", " ", "pods", ",", " ", "the", " ", "users", " ", "data"}},
+		// Contractions, not possessives
+		{"it's what's here", []string{"it's", " ", "what's", " ", "here"}},
+		// Not trailing apostrophes
+		{"a 'quote'", []string{"a", " ", "'", "quote", "'"}},
+		// Closing quotes, not possessives
+		{"the 'jobs' page", []string{"the", " ", "'", "jobs", "'", " ", "page"}},
+		{"‘jobs’ and 'big jobs'", []string{"‘", "jobs", "’", " ", "and", " ", "'", "big", " ", "jobs", "'"}},
+		{"'Kubernetes' pods, the users' data", []string{"'", "Kubernetes", "'", " ", "pods", ",", " ", "the", " ", "users", " ", "data"}},
+	}
+
+	for _, test := range tests {
+		got := values(t, test.given, clitics.Possessives)
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+}
+
+func TestElisions(t *testing.T) {
+	filter := clitics.NewFilter(clitics.Options{Elisions: contractions.FrenchElisions})
+
+	given := "l'ordinateur et L’homme d'aujourd'hui"
+	expected := []string{"ordinateur", " ", "et", " ", "homme", " ", "aujourd'hui"}
+	got := values(t, given, filter)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestEmit(t *testing.T) {
+	filter := clitics.NewFilter(clitics.Options{Possessives: true, Elisions: contractions.FrenchElisions, Emit: true})
+
+	given := "React's l'ordinateur Kubernetes’ d'Angular's"
+	expected := []string{"React", "'s", " ", "l'", "ordinateur", " ", "Kubernetes", "’", " ", "d'", "Angular", "'s"}
+
+	tokens, err := jargon.TokenizeString(given).Filter(filter).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	var roundtrip string
+	for _, token := range tokens {
+		got = append(got, token.String())
+		roundtrip += token.String()
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+	if roundtrip != given {
+		t.Errorf("expected round trip %q, got %q", given, roundtrip)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	// Without the possessive, the dictionary matches
+	got, err := jargon.TokenizeString("Ruby on Rails's routes").Filter(clitics.Possessives, stackoverflow.Tags).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "ruby-on-rails routes"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	filter, err := jargon.ParseFilter("clitics:possessives=false:elisions=italian")
+	if err != nil {
+		t.Fatal(err)
+	}
+	given := "dell'anno React's"
+	expected := []string{"anno", " ", "React's"}
+	got := values(t, given, filter)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+
+	if _, err := jargon.ParseFilter("clitics:elisions=klingon"); err == nil {
+		t.Error("expected an error for unknown elisions")
+	}
+}
@@ -0,0 +1,296 @@
+// Package compounds provides a filter to decompose compound words, such as "Datenbankadministrator" → "Datenbank",
+// "administrator", using a dictionary, for use with jargon. It follows Lucene's DictionaryCompoundWordTokenFilter.
+package compounds
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Strategy determines how subwords are found
+type Strategy int
+
+const (
+	// Longest finds the longest dictionary word starting at each character, as Lucene's onlyLongestMatch. Subwords
+	// may overlap.
+	Longest Strategy = iota
+	// Greedy segments the word from left to right, taking the longest dictionary word at each point, and skipping
+	// linking morphemes between words. Subwords are only emitted if the whole word is segmented.
+	Greedy
+)
+
+// Options configure decompounding
+type Options struct {
+	// Dictionary is the subwords to find; it is case-insensitive
+	Dictionary []string
+	Strategy   Strategy
+	// MinWordSize is the minimum length, in runes, of words to decompose; if zero, 5
+	MinWordSize int
+	// MinSubwordSize is the minimum length, in runes, of subwords; if zero, 2
+	MinSubwordSize int
+	// MaxSubwordSize is the maximum length, in runes, of subwords; if zero, 15
+	MaxSubwordSize int
+	// Links are linking morphemes which may join subwords with the Greedy strategy, such as s in Arbeitsmarkt, or e in
+	// Hundehütte
+	Links []string
+}
+
+// NewFilter creates a filter which emits each word, followed by its subwords. Word tokens are assigned positions
+// (see jargon.Token.Position), counting words from zero, and subwords have the same position as their word, so that
+// they may be indexed as alternatives.
+func NewFilter(options Options) jargon.Filter {
+	if options.MinWordSize == 0 {
+		options.MinWordSize = 5
+	}
+	if options.MinSubwordSize == 0 {
+		options.MinSubwordSize = 2
+	}
+	if options.MaxSubwordSize == 0 {
+		options.MaxSubwordSize = 15
+	}
+
+	f := &filter{
+		options:    options,
+		dictionary: map[string]bool{},
+	}
+	for _, word := range options.Dictionary {
+		f.dictionary[strings.ToLower(word)] = true
+	}
+	return f.Filter
+}
+
+// ReadDictionary reads a dictionary of one word per line. Blank lines and lines starting with # are ignored.
+func ReadDictionary(r io.Reader) ([]string, error) {
+	var words []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return words, nil
+}
+
+type filter struct {
+	options    Options
+	dictionary map[string]bool
+}
+
+// Filter decomposes compound words
+func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		filter:   f,
+		incoming: incoming,
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	outgoing *tokenqueue.TokenQueue
+	// position is the position of the next word
+	position int
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.incoming.Next()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.IsSpace() || token.IsPunct() {
+		return token, nil
+	}
+
+	pos := t.position
+	t.position++
+
+	for _, subword := range t.filter.subwords(token.String()) {
+		t.outgoing.Push(jargon.NewTokenFrom(subword, false, token).WithPosition(pos))
+	}
+
+	return token.WithPosition(pos), nil
+}
+
+// lower is a word in lowercase, with offsets of its runes in the original, so that subwords are taken from the
+// original with its case
+type lower struct {
+	runes   []rune
+	offsets []int
+}
+
+func newLower(s string) lower {
+	l := lower{}
+	for i, r := range s {
+		l.runes = append(l.runes, unicode.ToLower(r))
+		l.offsets = append(l.offsets, i)
+	}
+	l.offsets = append(l.offsets, len(s))
+	return l
+}
+
+func (f *filter) subwords(s string) []string {
+	if utf8.RuneCountInString(s) < f.options.MinWordSize {
+		return nil
+	}
+
+	l := newLower(s)
+	switch f.options.Strategy {
+	case Greedy:
+		return f.greedy(s, l)
+	default:
+		return f.longest(s, l)
+	}
+}
+
+// match returns the length of the longest dictionary word starting at rune i, or 0
+func (f *filter) match(l lower, i int) int {
+	for n := f.options.MaxSubwordSize; n >= f.options.MinSubwordSize; n-- {
+		if i+n > len(l.runes) {
+			continue
+		}
+		if f.dictionary[string(l.runes[i:i+n])] {
+			return n
+		}
+	}
+	return 0
+}
+
+func (f *filter) longest(s string, l lower) []string {
+	var result []string
+	for i := 0; i+f.options.MinSubwordSize <= len(l.runes); i++ {
+		n := f.match(l, i)
+		// The whole word is not a subword of itself
+		if n == 0 || n == len(l.runes) {
+			continue
+		}
+		result = append(result, s[l.offsets[i]:l.offsets[i+n]])
+	}
+	return result
+}
+
+func (f *filter) greedy(s string, l lower) []string {
+	var result []string
+	for i := 0; i < len(l.runes); {
+		n := f.match(l, i)
+		if n == 0 {
+			// Not segmented
+			return nil
+		}
+		result = append(result, s[l.offsets[i]:l.offsets[i+n]])
+		i += n
+
+		if i < len(l.runes) && f.match(l, i) == 0 {
+			i += f.link(l, i)
+		}
+	}
+
+	if len(result) < 2 {
+		return nil
+	}
+	return result
+}
+
+// link returns the length of a linking morpheme at rune i, which is followed by a dictionary word, or 0
+func (f *filter) link(l lower, i int) int {
+	for _, link := range f.options.Links {
+		n := utf8.RuneCountInString(link)
+		if i+n > len(l.runes) || string(l.runes[i:i+n]) != strings.ToLower(link) {
+			continue
+		}
+		if f.match(l, i+n) > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "compounds",
+		Description: "decompose compound words using a dictionary, e.g. Datenbankadministrator → Datenbankadministrator, Datenbank, administrator",
+		Params: []jargon.Param{
+			{Name: "dictionary", Description: "path to a dictionary file, of one word per line", Required: true},
+			{Name: "strategy", Description: "longest finds the longest word at each character; greedy segments the word", Default: "longest", Options: []string{"longest", "greedy"}},
+			{Name: "minWord", Description: "the minimum length of words to decompose", Default: "5"},
+			{Name: "minSubword", Description: "the minimum length of subwords", Default: "2"},
+			{Name: "maxSubword", Description: "the maximum length of subwords", Default: "15"},
+			{Name: "links", Description: "comma-separated linking morphemes, for the greedy strategy, e.g. s,e"},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			options := Options{}
+
+			switch params["strategy"] {
+			case "longest":
+				options.Strategy = Longest
+			case "greedy":
+				options.Strategy = Greedy
+			default:
+				return nil, fmt.Errorf("strategy %q is not known; options are longest, greedy", params["strategy"])
+			}
+
+			sizes := []struct {
+				name string
+				dst  *int
+			}{
+				{"minWord", &options.MinWordSize},
+				{"minSubword", &options.MinSubwordSize},
+				{"maxSubword", &options.MaxSubwordSize},
+			}
+			for _, size := range sizes {
+				n, err := strconv.Atoi(params[size.name])
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", size.name, err)
+				}
+				if n < 1 {
+					return nil, fmt.Errorf("%s: expected at least 1, got %d", size.name, n)
+				}
+				*size.dst = n
+			}
+
+			if s := params["links"]; s != "" {
+				options.Links = strings.Split(s, ",")
+			}
+
+			path := params["dictionary"]
+			file, err := os.Open(path)
+			if err != nil {
+				return nil, err
+			}
+			defer file.Close()
+
+			options.Dictionary, err = ReadDictionary(file)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", path, err)
+			}
+
+			return NewFilter(options), nil
+		},
+	})
+}
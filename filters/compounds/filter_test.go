@@ -0,0 +1,107 @@
+package compounds_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/compounds"
+)
+
+var dictionary = []string{"daten", "bank", "datenbank", "administrator", "arbeit", "markt", "hund", "hütte", "program", "programvare", "vare", "utvikler"}
+
+type word struct {
+	value    string
+	position int
+}
+
+func words(t *testing.T, s string, filter jargon.Filter) []word {
+	tokens := jargon.TokenizeString(s).Filter(filter)
+
+	var result []word
+	for tokens.Scan() {
+		token := tokens.Token()
+		if token.IsSpace() || token.IsPunct() {
+			continue
+		}
+		pos, ok := token.Position()
+		if !ok {
+			t.Errorf("expected %q to have a position", token)
+		}
+		result = append(result, word{token.String(), pos})
+	}
+	if err := tokens.Err(); err != nil {
+		t.Error(err)
+	}
+	return result
+}
+
+func TestLongest(t *testing.T) {
+	filter := compounds.NewFilter(compounds.Options{Dictionary: dictionary})
+
+	given := "Der Datenbankadministrator, programvareutvikler"
+	expected := []word{
+		{"Der", 0},
+		{"Datenbankadministrator", 1}, {"Datenbank", 1}, {"bank", 1}, {"administrator", 1},
+		{"programvareutvikler", 2}, {"programvare", 2}, {"vare", 2}, {"utvikler", 2},
+	}
+
+	got := words(t, given, filter)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("given %q, expected %v, got %v", given, expected, got)
+	}
+}
+
+func TestGreedy(t *testing.T) {
+	type test struct {
+		given    string
+		links    []string
+		expected []word
+	}
+
+	tests := []test{
+		{"Datenbankadministrator", nil, []word{{"Datenbankadministrator", 0}, {"Datenbank", 0}, {"administrator", 0}}},
+		// Linking morphemes
+		{"Arbeitsmarkt Hundehütte", []string{"s", "e"}, []word{{"Arbeitsmarkt", 0}, {"Arbeit", 0}, {"markt", 0}, {"Hundehütte", 1}, {"Hund", 1}, {"hütte", 1}}},
+		{"Arbeitsmarkt", nil, []word{{"Arbeitsmarkt", 0}}},
+		// Not entirely segmented
+		{"Datenbankserver", nil, []word{{"Datenbankserver", 0}}},
+	}
+
+	for _, test := range tests {
+		filter := compounds.NewFilter(compounds.Options{Dictionary: dictionary, Strategy: compounds.Greedy, Links: test.links})
+		got := words(t, test.given, filter)
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("given %q, expected %v, got %v", test.given, test.expected, got)
+		}
+	}
+}
+
+func TestSizes(t *testing.T) {
+	filter := compounds.NewFilter(compounds.Options{Dictionary: dictionary, MinSubwordSize: 5, MinWordSize: 10})
+
+	given := "Datenbank Datenbankadministrator"
+	expected := []word{
+		// Too short to decompose
+		{"Datenbank", 0},
+		// bank is too short to be a subword
+		{"Datenbankadministrator", 1}, {"Datenbank", 1}, {"administrator", 1},
+	}
+
+	got := words(t, given, filter)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("given %q, expected %v, got %v", given, expected, got)
+	}
+}
+
+func TestReadDictionary(t *testing.T) {
+	got, err := compounds.ReadDictionary(strings.NewReader("# German\ndaten\n\n bank \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"daten", "bank"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
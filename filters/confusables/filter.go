@@ -0,0 +1,146 @@
+// Package confusables provides a filter to replace words with their confusable skeletons, per Unicode Technical
+// Standard #39, such as "Jаvа" (with Cyrillic а) → "Java", "𝐣𝐚𝐯𝐚" → "java" and "ｊａｖａ" → "java", so that
+// lookalikes match dictionaries, for use with jargon
+package confusables
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/mapper"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+//go:generate go run generate/main.go
+
+// Options configure the filter
+type Options struct {
+	// Mixed flags tokens which mix scripts, such as Latin and Cyrillic; see IsMixed
+	Mixed bool
+}
+
+// Skeletons is a filter which replaces words with their skeletons
+var Skeletons = NewFilter(Options{})
+
+// NewFilter creates a filter which replaces words with their skeletons, see Skeleton. Words are first folded to their
+// usual width, e.g. ｍ → m, as confusables.txt does not map all fullwidth letters. Skeletons are lemmas, recording the
+// original token as their origin. Skeletons are not meant for display: I and 1 become l, and m becomes rn, so
+// dictionaries should be matched on the skeletons of their words, too.
+func NewFilter(options Options) jargon.Filter {
+	f := func(token *jargon.Token) *jargon.Token {
+		if token.IsPunct() || token.IsSpace() {
+			return token
+		}
+
+		result := token
+		if s := Skeleton(width.Fold.String(token.String())); s != token.String() {
+			result = jargon.NewTokenFrom(s, true, token)
+		}
+		if options.Mixed && MixedScript(token.String()) {
+			result = result.WithValue(mixed{}, true)
+		}
+
+		return result
+	}
+
+	return mapper.NewFilter(f)
+}
+
+// Skeleton returns the skeleton of s, per UTS #39: its characters are replaced by their prototypes, so that
+// confusable strings have the same skeleton
+func Skeleton(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if p, found := prototypes[r]; found {
+			b.WriteString(p)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFD.String(b.String())
+}
+
+type mixed struct{}
+
+// IsMixed reports whether the token, or its origin, was flagged as mixing scripts, see Options.Mixed
+func IsMixed(token *jargon.Token) bool {
+	m, _ := token.Value(mixed{}).(bool)
+	return m
+}
+
+// MixedScript reports whether s has characters of more than one script, ignoring common characters, such as digits
+// and punctuation, and inherited characters, such as combining marks. Han, Hiragana, Katakana, Hangul and Bopomofo
+// are considered one script, as they are commonly written together.
+func MixedScript(s string) bool {
+	first := ""
+	for _, r := range s {
+		script := scriptOf(r)
+		if script == "" {
+			continue
+		}
+		if first == "" {
+			first = script
+			continue
+		}
+		if script != first {
+			return true
+		}
+	}
+	return false
+}
+
+// cjk are scripts which are written together
+var cjk = map[string]bool{"Han": true, "Hiragana": true, "Katakana": true, "Hangul": true, "Bopomofo": true}
+
+// scriptOf returns the name of the script of r, or "" if it is common or inherited
+func scriptOf(r rune) string {
+	switch {
+	case r < unicode.MaxASCII:
+		if unicode.IsLetter(r) {
+			return "Latin"
+		}
+		return ""
+	case unicode.Is(unicode.Latin, r):
+		return "Latin"
+	case unicode.Is(unicode.Cyrillic, r):
+		return "Cyrillic"
+	case unicode.Is(unicode.Greek, r):
+		return "Greek"
+	case unicode.Is(unicode.Common, r), unicode.Is(unicode.Inherited, r):
+		return ""
+	}
+
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			if cjk[name] {
+				return "Han"
+			}
+			return name
+		}
+	}
+	return ""
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "confusables",
+		Description: "replace words with their UTS #39 confusable skeletons, e.g. Jаvа (with Cyrillic а) → Java",
+		Params: []jargon.Param{
+			{Name: "mixed", Description: "whether to flag tokens which mix scripts", Default: "false", Options: []string{"true", "false"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			m, err := strconv.ParseBool(params["mixed"])
+			if err != nil {
+				return nil, fmt.Errorf("mixed: %v", err)
+			}
+			if !m {
+				return Skeletons, nil
+			}
+			return NewFilter(Options{Mixed: m}), nil
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/confusables"
+	"github.com/clipperhouse/jargon/filters/synonyms"
+)
+
+func TestSkeleton(t *testing.T) {
+	type test struct {
+		given    string
+		expected string
+	}
+
+	tests := []test{
+		// Cyrillic а and о
+		{"Jаvа", "Java"},
+		{"gо", "go"},
+		// Greek Κ and ο
+		{"Κubernetes", "Kubernetes"},
+		// Mathematical bold
+		{"𝐣𝐚𝐯𝐚", "java"},
+		// Cherokee
+		{"ᎪᎡᎢ", "ART"},
+		// Prototypes need not be the same letter
+		{"IO", "lO"},
+		{"mongo", "rnongo"},
+		{"py3", "py3"},
+	}
+
+	for _, test := range tests {
+		got := confusables.Skeleton(test.given)
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+
+	// Confusable strings have the same skeleton
+	if confusables.Skeleton("rnongo") != confusables.Skeleton("mongo") {
+		t.Errorf("expected rnongo and mongo to have the same skeleton")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	// Fullwidth letters are folded, whether or not confusables.txt maps them
+	given := "Jаvа, ＪＡＶＡ and ｍｏｎｇｏ"
+	expected := "Java, JAVA and rnongo"
+
+	got, err := jargon.TokenizeString(given).Filter(confusables.Skeletons).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	// Dictionaries are matched on skeletons
+	words := []string{"Java", "mongo"}
+	dictionary := map[string]string{}
+	for _, word := range words {
+		dictionary[confusables.Skeleton(word)] = word
+	}
+
+	given := "Jаvа and ｍｏｎｇｏ are cheap"
+	expected := "Java and mongo are cheap"
+
+	got, err := jargon.TokenizeString(given).Filter(confusables.Skeletons, synonyms.NewFilter(dictionary, false, nil)).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestMixed(t *testing.T) {
+	type test struct {
+		given    string
+		expected bool
+	}
+
+	tests := []test{
+		{"Jаvа", true},
+		{"Java", false},
+		{"ｊａｖａ", false},
+		{"Москва", false},
+		{"py3", false},
+	}
+
+	filter := confusables.NewFilter(confusables.Options{Mixed: true})
+	for _, test := range tests {
+		if got := confusables.MixedScript(test.given); got != test.expected {
+			t.Errorf("given %q, expected MixedScript %t, got %t", test.given, test.expected, got)
+		}
+
+		tokens, err := jargon.TokenizeString(test.given).Filter(filter).ToSlice()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tokens) != 1 {
+			t.Fatalf("given %q, expected 1 token, got %d", test.given, len(tokens))
+		}
+		if got := confusables.IsMixed(tokens[0]); got != test.expected {
+			t.Errorf("given %q, expected IsMixed %t, got %t", test.given, test.expected, got)
+		}
+	}
+
+	// Han and Katakana are written together
+	if confusables.MixedScript("東京タワー") {
+		t.Errorf("expected 東京タワー not to be mixed")
+	}
+
+	// Not flagged by default
+	tokens, err := jargon.TokenizeString("Jаvа").Filter(confusables.Skeletons).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confusables.IsMixed(tokens[0]) {
+		t.Errorf("expected tokens not to be flagged without the Mixed option")
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// url is pinned to a version, so that generated.go can be reproduced; update it to upgrade
+const url = "https://www.unicode.org/Public/security/13.0.0/confusables.txt"
+
+// file is a local copy of confusables.txt, for use in place of fetching it
+var file = flag.String("file", "", "path to a local confusables.txt, rather than fetching "+url)
+
+func main() {
+	flag.Parse()
+
+	var r io.ReadCloser
+	var err error
+	if *file != "" {
+		r, err = os.Open(*file)
+	} else {
+		r, err = fetch()
+	}
+	check(err)
+	defer r.Close()
+
+	header, prototypes, err := parse(r)
+	check(err)
+
+	err = write(header, prototypes)
+	check(err)
+}
+
+func fetch() (io.ReadCloser, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("status code error: %d %s", resp.StatusCode, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// header is the version of confusables.txt, from the header of the file
+type header struct {
+	Version string
+	Date    string
+}
+
+type prototype struct {
+	Source rune
+	Target string
+}
+
+// parse reads the header, and lines of the form
+// 0430 ;	0061 ;	MA	# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A	#
+// Comments are ignored, so that the result depends only on the data.
+func parse(r io.Reader) (header, []prototype, error) {
+	var h header
+	var prototypes []prototype
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+
+		if strings.HasPrefix(line, "# Version:") {
+			h.Version = strings.TrimSpace(strings.TrimPrefix(line, "# Version:"))
+		}
+		if strings.HasPrefix(line, "# Date:") {
+			// e.g. 2020-02-13, 01:38:49 GMT
+			h.Date = strings.TrimSpace(strings.Split(strings.TrimPrefix(line, "# Date:"), ",")[0])
+		}
+
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			return h, nil, fmt.Errorf("expected source, target and type fields, got %q", line)
+		}
+		// MA is the superset of the types of confusables
+		if strings.TrimSpace(fields[2]) != "MA" {
+			continue
+		}
+
+		source, err := strconv.ParseInt(strings.TrimSpace(fields[0]), 16, 32)
+		if err != nil {
+			return h, nil, err
+		}
+
+		var target strings.Builder
+		for _, hex := range strings.Fields(fields[1]) {
+			r, err := strconv.ParseInt(hex, 16, 32)
+			if err != nil {
+				return h, nil, err
+			}
+			target.WriteRune(rune(r))
+		}
+
+		prototypes = append(prototypes, prototype{rune(source), target.String()})
+	}
+	if err := scanner.Err(); err != nil {
+		return h, nil, err
+	}
+	if h.Version == "" {
+		return h, nil, fmt.Errorf("expected a version in the header of confusables.txt")
+	}
+
+	sort.Slice(prototypes, func(i, j int) bool {
+		return prototypes[i].Source < prototypes[j].Source
+	})
+
+	return h, prototypes, nil
+}
+
+func write(h header, prototypes []prototype) error {
+	data := struct {
+		header
+		Prototypes []prototype
+	}{h, prototypes}
+
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, data)
+	if err != nil {
+		return err
+	}
+
+	formatted, fmtErr := format.Source(b.Bytes())
+	if fmtErr != nil {
+		return fmtErr
+	}
+
+	f, createErr := os.Create("generated.go")
+	if createErr != nil {
+		return createErr
+	}
+	defer f.Close()
+
+	_, writeErr := f.Write(formatted)
+	if writeErr != nil {
+		return writeErr
+	}
+
+	return nil
+}
+
+var tmpl = template.Must(template.New("").Parse(`
+package confusables
+
+// This file is generated from confusables.txt, version {{ .Version }}{{ with .Date }} of {{ . }}{{ end }}.
+// Best not to modify it, as it will likely be overwritten.
+
+// prototypes maps characters to their prototypes, from which skeletons are made
+var prototypes = map[rune]string{
+{{- range .Prototypes }}
+	{{ printf "0x%04X" .Source }}: {{ printf "%q" .Target }}, // {{ printf "%q" .Source }}
+{{- end }}
+}
+`))
+
+func check(err error) {
+	if err != nil {
+		os.Stderr.WriteString(err.Error())
+		os.Stderr.WriteString("\n")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,6319 @@
+package confusables
+
+// This file is generated from confusables.txt, version 13.0.0 of 2020-02-13.
+// Best not to modify it, as it will likely be overwritten.
+
+// prototypes maps characters to their prototypes, from which skeletons are made
+var prototypes = map[rune]string{
+	0x0022:  "''",                 // '"'
+	0x0025:  "º/₀",                // '%'
+	0x0030:  "O",                  // '0'
+	0x0031:  "l",                  // '1'
+	0x0049:  "l",                  // 'I'
+	0x0060:  "'",                  // '`'
+	0x006D:  "rn",                 // 'm'
+	0x007C:  "l",                  // '|'
+	0x00A0:  " ",                  // '\u00a0'
+	0x00A2:  "c̸",                 // '¢'
+	0x00A5:  "Y̵",                 // '¥'
+	0x00AF:  "ˉ",                  // '¯'
+	0x00B4:  "'",                  // '´'
+	0x00B5:  "μ",                  // 'µ'
+	0x00B8:  ",",                  // '¸'
+	0x00C6:  "AE",                 // 'Æ'
+	0x00C7:  "C̦",                 // 'Ç'
+	0x00D0:  "D̵",                 // 'Ð'
+	0x00D7:  "x",                  // '×'
+	0x00D8:  "O̸",                 // 'Ø'
+	0x00E6:  "ae",                 // 'æ'
+	0x00E7:  "c̦",                 // 'ç'
+	0x00F0:  "∂̵",                 // 'ð'
+	0x00F6:  "ة",                  // 'ö'
+	0x00F8:  "o̸",                 // 'ø'
+	0x0110:  "D̵",                 // 'Đ'
+	0x0111:  "d̵",                 // 'đ'
+	0x011A:  "Ĕ",                  // 'Ě'
+	0x011B:  "ĕ",                  // 'ě'
+	0x0126:  "H̵",                 // 'Ħ'
+	0x0127:  "h̵",                 // 'ħ'
+	0x0131:  "i",                  // 'ı'
+	0x0132:  "lJ",                 // 'Ĳ'
+	0x0133:  "ij",                 // 'ĳ'
+	0x013F:  "l·",                 // 'Ŀ'
+	0x0140:  "l·",                 // 'ŀ'
+	0x0141:  "L̸",                 // 'Ł'
+	0x0142:  "l̸",                 // 'ł'
+	0x0146:  "ɲ",                  // 'ņ'
+	0x0149:  "'n",                 // 'ŉ'
+	0x0150:  "Ö",                  // 'Ő'
+	0x0152:  "OE",                 // 'Œ'
+	0x0153:  "oe",                 // 'œ'
+	0x0163:  "ƫ",                  // 'ţ'
+	0x0166:  "T̵",                 // 'Ŧ'
+	0x0167:  "t̵",                 // 'ŧ'
+	0x017F:  "f",                  // 'ſ'
+	0x0180:  "b̵",                 // 'ƀ'
+	0x0181:  "'B",                 // 'Ɓ'
+	0x0182:  "b̄",                 // 'Ƃ'
+	0x0183:  "b̄",                 // 'ƃ'
+	0x0184:  "b",                  // 'Ƅ'
+	0x0187:  "C'",                 // 'Ƈ'
+	0x0189:  "D̵",                 // 'Ɖ'
+	0x018A:  "'D",                 // 'Ɗ'
+	0x018C:  "d̄",                 // 'ƌ'
+	0x018D:  "g",                  // 'ƍ'
+	0x0191:  "F̦",                 // 'Ƒ'
+	0x0192:  "f̦",                 // 'ƒ'
+	0x0193:  "G'",                 // 'Ɠ'
+	0x0196:  "l",                  // 'Ɩ'
+	0x0197:  "l̵",                 // 'Ɨ'
+	0x0198:  "K'",                 // 'Ƙ'
+	0x0199:  "k̔",                 // 'ƙ'
+	0x019A:  "l̵",                 // 'ƚ'
+	0x019D:  "N̦",                 // 'Ɲ'
+	0x019E:  "n̩",                 // 'ƞ'
+	0x019F:  "O̵",                 // 'Ɵ'
+	0x01A0:  "O'",                 // 'Ơ'
+	0x01A1:  "o'",                 // 'ơ'
+	0x01A4:  "'P",                 // 'Ƥ'
+	0x01A5:  "p̔",                 // 'ƥ'
+	0x01A6:  "R",                  // 'Ʀ'
+	0x01A7:  "2",                  // 'Ƨ'
+	0x01AC:  "'T",                 // 'Ƭ'
+	0x01AD:  "t̔",                 // 'ƭ'
+	0x01AE:  "T̨",                 // 'Ʈ'
+	0x01B3:  "'Y",                 // 'Ƴ'
+	0x01B4:  "y̔",                 // 'ƴ'
+	0x01B5:  "Z̵",                 // 'Ƶ'
+	0x01B6:  "z̵",                 // 'ƶ'
+	0x01B7:  "3",                  // 'Ʒ'
+	0x01BB:  "2̵",                 // 'ƻ'
+	0x01BC:  "5",                  // 'Ƽ'
+	0x01BD:  "s",                  // 'ƽ'
+	0x01BF:  "þ",                  // 'ƿ'
+	0x01C0:  "l",                  // 'ǀ'
+	0x01C1:  "ll",                 // 'ǁ'
+	0x01C3:  "!",                  // 'ǃ'
+	0x01C4:  "DŽ",                 // 'Ǆ'
+	0x01C5:  "Dž",                 // 'ǅ'
+	0x01C6:  "dž",                 // 'ǆ'
+	0x01C7:  "LJ",                 // 'Ǉ'
+	0x01C8:  "Lj",                 // 'ǈ'
+	0x01C9:  "lj",                 // 'ǉ'
+	0x01CA:  "NJ",                 // 'Ǌ'
+	0x01CB:  "Nj",                 // 'ǋ'
+	0x01CC:  "nj",                 // 'ǌ'
+	0x01CD:  "Ă",                  // 'Ǎ'
+	0x01CE:  "ă",                  // 'ǎ'
+	0x01CF:  "Ĭ",                  // 'Ǐ'
+	0x01D0:  "ĭ",                  // 'ǐ'
+	0x01D1:  "Ŏ",                  // 'Ǒ'
+	0x01D2:  "ŏ",                  // 'ǒ'
+	0x01D3:  "Ŭ",                  // 'Ǔ'
+	0x01D4:  "ŭ",                  // 'ǔ'
+	0x01E4:  "G̵",                 // 'Ǥ'
+	0x01E5:  "g̵",                 // 'ǥ'
+	0x01E6:  "Ğ",                  // 'Ǧ'
+	0x01E7:  "ğ",                  // 'ǧ'
+	0x01F1:  "DZ",                 // 'Ǳ'
+	0x01F2:  "Dz",                 // 'ǲ'
+	0x01F3:  "dz",                 // 'ǳ'
+	0x01F5:  "ģ",                  // 'ǵ'
+	0x01FE:  "Ó̸",                // 'Ǿ'
+	0x021A:  "Ţ",                  // 'Ț'
+	0x021B:  "ƫ",                  // 'ț'
+	0x021C:  "3",                  // 'Ȝ'
+	0x0222:  "8",                  // 'Ȣ'
+	0x0223:  "8",                  // 'ȣ'
+	0x0224:  "Z̦",                 // 'Ȥ'
+	0x0225:  "z̦",                 // 'ȥ'
+	0x0226:  "Å",                  // 'Ȧ'
+	0x0227:  "å",                  // 'ȧ'
+	0x023C:  "c̸",                 // 'ȼ'
+	0x023E:  "T̸",                 // 'Ⱦ'
+	0x0241:  "?",                  // 'Ɂ'
+	0x0244:  "U̵",                 // 'Ʉ'
+	0x0246:  "E̸",                 // 'Ɇ'
+	0x0247:  "e̸",                 // 'ɇ'
+	0x0248:  "J̵",                 // 'Ɉ'
+	0x0249:  "j̵",                 // 'ɉ'
+	0x024D:  "r̵",                 // 'ɍ'
+	0x024E:  "Y̵",                 // 'Ɏ'
+	0x024F:  "y̵",                 // 'ɏ'
+	0x0251:  "a",                  // 'ɑ'
+	0x0253:  "b̔",                 // 'ɓ'
+	0x0256:  "d̨",                 // 'ɖ'
+	0x0257:  "d̔",                 // 'ɗ'
+	0x0259:  "ǝ",                  // 'ə'
+	0x025A:  "ǝ˞",                 // 'ɚ'
+	0x025B:  "ꞓ",                  // 'ɛ'
+	0x0260:  "g̔",                 // 'ɠ'
+	0x0261:  "g",                  // 'ɡ'
+	0x0263:  "y",                  // 'ɣ'
+	0x0266:  "h̔",                 // 'ɦ'
+	0x0268:  "i̵",                 // 'ɨ'
+	0x0269:  "i",                  // 'ɩ'
+	0x026A:  "i",                  // 'ɪ'
+	0x026B:  "l̴",                 // 'ɫ'
+	0x026D:  "l̨",                 // 'ɭ'
+	0x026E:  "lȝ",                 // 'ɮ'
+	0x026F:  "w",                  // 'ɯ'
+	0x0271:  "rn̦",                // 'ɱ'
+	0x0273:  "n̨",                 // 'ɳ'
+	0x0275:  "o̵",                 // 'ɵ'
+	0x0276:  "oᴇ",                 // 'ɶ'
+	0x027C:  "r̩",                 // 'ɼ'
+	0x027D:  "r̨",                 // 'ɽ'
+	0x0282:  "s̨",                 // 'ʂ'
+	0x028B:  "u",                  // 'ʋ'
+	0x028F:  "y",                  // 'ʏ'
+	0x0290:  "z̨",                 // 'ʐ'
+	0x0292:  "ȝ",                  // 'ʒ'
+	0x0294:  "?",                  // 'ʔ'
+	0x02A0:  "q̔",                 // 'ʠ'
+	0x02A3:  "dz",                 // 'ʣ'
+	0x02A4:  "dȝ",                 // 'ʤ'
+	0x02A5:  "dʑ",                 // 'ʥ'
+	0x02A6:  "ts",                 // 'ʦ'
+	0x02A7:  "tʃ",                 // 'ʧ'
+	0x02A8:  "tɕ",                 // 'ʨ'
+	0x02A9:  "fŋ",                 // 'ʩ'
+	0x02AA:  "ls",                 // 'ʪ'
+	0x02AB:  "lz",                 // 'ʫ'
+	0x02B3:  "ᣴ",                  // 'ʳ'
+	0x02B9:  "'",                  // 'ʹ'
+	0x02BA:  "''",                 // 'ʺ'
+	0x02BB:  "'",                  // 'ʻ'
+	0x02BC:  "'",                  // 'ʼ'
+	0x02BD:  "'",                  // 'ʽ'
+	0x02BE:  "'",                  // 'ʾ'
+	0x02BF:  "ՙ",                  // 'ʿ'
+	0x02C2:  "<",                  // '˂'
+	0x02C3:  ">",                  // '˃'
+	0x02C4:  "^",                  // '˄'
+	0x02C6:  "^",                  // 'ˆ'
+	0x02C8:  "'",                  // 'ˈ'
+	0x02CA:  "'",                  // 'ˊ'
+	0x02CB:  "'",                  // 'ˋ'
+	0x02D0:  ":",                  // 'ː'
+	0x02D3:  "ՙ",                  // '˓'
+	0x02D7:  "-",                  // '˗'
+	0x02D8:  "ˇ",                  // '˘'
+	0x02D9:  "ॱ",                  // '˙'
+	0x02DA:  "°",                  // '˚'
+	0x02DB:  "i",                  // '˛'
+	0x02DC:  "~",                  // '˜'
+	0x02DD:  "''",                 // '˝'
+	0x02E1:  "ᣳ",                  // 'ˡ'
+	0x02E2:  "ᣵ",                  // 'ˢ'
+	0x02E4:  "ˁ",                  // 'ˤ'
+	0x02EE:  "''",                 // 'ˮ'
+	0x02F4:  "'",                  // '˴'
+	0x02F6:  "''",                 // '˶'
+	0x02F8:  ":",                  // '˸'
+	0x02FB:  "˪",                  // '˻'
+	0x0305:  "̄",                  // '̅'
+	0x030C:  "̆",                  // '̌'
+	0x030D:  "ٰ",                  // '̍'
+	0x0310:  "̆̇",                 // '̐'
+	0x0311:  "̂",                  // '̑'
+	0x0315:  "̓",                  // '̕'
+	0x0317:  "ِ",                  // '̗'
+	0x0320:  "̱",                  // '̠'
+	0x0321:  "̦",                  // '̡'
+	0x0322:  "̨",                  // '̢'
+	0x0327:  "̦",                  // '̧'
+	0x0336:  "̵",                  // '̶'
+	0x0337:  "̸",                  // '̷'
+	0x0339:  "̦",                  // '̹'
+	0x0340:  "̀",                  // '̀'
+	0x0341:  "́",                  // '́'
+	0x0342:  "̃",                  // '͂'
+	0x0343:  "̓",                  // '̓'
+	0x0345:  "̨",                  // 'ͅ'
+	0x0347:  "̳",                  // '͇'
+	0x0357:  "͐",                  // '͗'
+	0x0358:  "̇",                  // '͘'
+	0x0366:  "̊",                  // 'ͦ'
+	0x036E:  "̆",                  // 'ͮ'
+	0x0370:  "Ⱶ",                  // 'Ͱ'
+	0x0374:  "'",                  // 'ʹ'
+	0x0375:  "ˏ",                  // '͵'
+	0x0376:  "И",                  // 'Ͷ'
+	0x0377:  "ᴎ",                  // 'ͷ'
+	0x037A:  "i",                  // 'ͺ'
+	0x037B:  "ɔ",                  // 'ͻ'
+	0x037D:  "ꜿ",                  // 'ͽ'
+	0x037E:  ";",                  // ';'
+	0x037F:  "J",                  // 'Ϳ'
+	0x0384:  "'",                  // '΄'
+	0x0387:  "·",                  // '·'
+	0x0391:  "A",                  // 'Α'
+	0x0392:  "B",                  // 'Β'
+	0x0395:  "E",                  // 'Ε'
+	0x0396:  "Z",                  // 'Ζ'
+	0x0397:  "H",                  // 'Η'
+	0x0398:  "O̵",                 // 'Θ'
+	0x0399:  "l",                  // 'Ι'
+	0x039A:  "K",                  // 'Κ'
+	0x039B:  "Ʌ",                  // 'Λ'
+	0x039C:  "M",                  // 'Μ'
+	0x039D:  "N",                  // 'Ν'
+	0x039F:  "O",                  // 'Ο'
+	0x03A1:  "P",                  // 'Ρ'
+	0x03A3:  "Ʃ",                  // 'Σ'
+	0x03A4:  "T",                  // 'Τ'
+	0x03A5:  "Y",                  // 'Υ'
+	0x03A7:  "X",                  // 'Χ'
+	0x03B1:  "a",                  // 'α'
+	0x03B2:  "ß",                  // 'β'
+	0x03B3:  "y",                  // 'γ'
+	0x03B4:  "ẟ",                  // 'δ'
+	0x03B5:  "ꞓ",                  // 'ε'
+	0x03B7:  "n̩",                 // 'η'
+	0x03B8:  "O̵",                 // 'θ'
+	0x03B9:  "i",                  // 'ι'
+	0x03BA:  "ĸ",                  // 'κ'
+	0x03BD:  "v",                  // 'ν'
+	0x03BF:  "o",                  // 'ο'
+	0x03C1:  "p",                  // 'ρ'
+	0x03C3:  "o",                  // 'σ'
+	0x03C4:  "ᴛ",                  // 'τ'
+	0x03C5:  "u",                  // 'υ'
+	0x03C6:  "ɸ",                  // 'φ'
+	0x03D0:  "ß",                  // 'ϐ'
+	0x03D1:  "O̵",                 // 'ϑ'
+	0x03D2:  "Y",                  // 'ϒ'
+	0x03D5:  "ɸ",                  // 'ϕ'
+	0x03D6:  "π",                  // 'ϖ'
+	0x03DB:  "ς",                  // 'ϛ'
+	0x03DC:  "F",                  // 'Ϝ'
+	0x03E8:  "2",                  // 'Ϩ'
+	0x03E9:  "ƨ",                  // 'ϩ'
+	0x03F0:  "ĸ",                  // 'ϰ'
+	0x03F1:  "p",                  // 'ϱ'
+	0x03F2:  "c",                  // 'ϲ'
+	0x03F3:  "j",                  // 'ϳ'
+	0x03F4:  "O̵",                 // 'ϴ'
+	0x03F5:  "ꞓ",                  // 'ϵ'
+	0x03F7:  "Þ",                  // 'Ϸ'
+	0x03F8:  "þ",                  // 'ϸ'
+	0x03F9:  "C",                  // 'Ϲ'
+	0x03FA:  "M",                  // 'Ϻ'
+	0x03FD:  "Ɔ",                  // 'Ͻ'
+	0x03FF:  "Ꜿ",                  // 'Ͽ'
+	0x0404:  "Ꞓ",                  // 'Є'
+	0x0405:  "S",                  // 'Ѕ'
+	0x0406:  "l",                  // 'І'
+	0x0408:  "J",                  // 'Ј'
+	0x0410:  "A",                  // 'А'
+	0x0411:  "b̄",                 // 'Б'
+	0x0412:  "B",                  // 'В'
+	0x0413:  "Γ",                  // 'Г'
+	0x0415:  "E",                  // 'Е'
+	0x0417:  "3",                  // 'З'
+	0x0419:  "Ѝ",                  // 'Й'
+	0x041A:  "K",                  // 'К'
+	0x041B:  "Ʌ",                  // 'Л'
+	0x041C:  "M",                  // 'М'
+	0x041D:  "H",                  // 'Н'
+	0x041E:  "O",                  // 'О'
+	0x041F:  "Π",                  // 'П'
+	0x0420:  "P",                  // 'Р'
+	0x0421:  "C",                  // 'С'
+	0x0422:  "T",                  // 'Т'
+	0x0423:  "Y",                  // 'У'
+	0x0424:  "Φ",                  // 'Ф'
+	0x0425:  "X",                  // 'Х'
+	0x042B:  "bl",                 // 'Ы'
+	0x042C:  "b",                  // 'Ь'
+	0x042E:  "lO",                 // 'Ю'
+	0x0430:  "a",                  // 'а'
+	0x0431:  "6",                  // 'б'
+	0x0432:  "ʙ",                  // 'в'
+	0x0433:  "r",                  // 'г'
+	0x0435:  "e",                  // 'е'
+	0x0437:  "ɜ",                  // 'з'
+	0x0438:  "ᴎ",                  // 'и'
+	0x043A:  "ĸ",                  // 'к'
+	0x043C:  "ʍ",                  // 'м'
+	0x043D:  "ʜ",                  // 'н'
+	0x043E:  "o",                  // 'о'
+	0x043F:  "π",                  // 'п'
+	0x0440:  "p",                  // 'р'
+	0x0441:  "c",                  // 'с'
+	0x0442:  "ᴛ",                  // 'т'
+	0x0443:  "y",                  // 'у'
+	0x0444:  "ɸ",                  // 'ф'
+	0x0445:  "x",                  // 'х'
+	0x044A:  "ˉb",                 // 'ъ'
+	0x044B:  "ƅi",                 // 'ы'
+	0x044C:  "ƅ",                  // 'ь'
+	0x044F:  "ᴙ",                  // 'я'
+	0x0454:  "ꞓ",                  // 'є'
+	0x0455:  "s",                  // 'ѕ'
+	0x0456:  "i",                  // 'і'
+	0x0458:  "j",                  // 'ј'
+	0x045B:  "h̵",                 // 'ћ'
+	0x045D:  "й",                  // 'ѝ'
+	0x0461:  "w",                  // 'ѡ'
+	0x0462:  "b̵",                 // 'Ѣ'
+	0x0463:  "b̵",                 // 'ѣ'
+	0x0470:  "Ψ",                  // 'Ѱ'
+	0x0471:  "ψ",                  // 'ѱ'
+	0x0472:  "O̵",                 // 'Ѳ'
+	0x0473:  "o̵",                 // 'ѳ'
+	0x0474:  "V",                  // 'Ѵ'
+	0x0475:  "v",                  // 'ѵ'
+	0x047C:  "Ѡ҆҇",                // 'Ѽ'
+	0x047D:  "w҆҇",                // 'ѽ'
+	0x048A:  "Ѝ̦",                 // 'Ҋ'
+	0x048B:  "й̦",                 // 'ҋ'
+	0x048C:  "b̵",                 // 'Ҍ'
+	0x048D:  "b̵",                 // 'ҍ'
+	0x0490:  "Γ'",                 // 'Ґ'
+	0x0491:  "r'",                 // 'ґ'
+	0x0492:  "Γ̵",                 // 'Ғ'
+	0x0493:  "r̵",                 // 'ғ'
+	0x0496:  "Ж̩",                 // 'Җ'
+	0x0497:  "ж̩",                 // 'җ'
+	0x0498:  "3̦",                 // 'Ҙ'
+	0x0499:  "ɜ̦",                 // 'ҙ'
+	0x049A:  "K̩",                 // 'Қ'
+	0x049B:  "ĸ̩",                 // 'қ'
+	0x049E:  "K̵",                 // 'Ҟ'
+	0x049F:  "ĸ̵",                 // 'ҟ'
+	0x04A2:  "H̩",                 // 'Ң'
+	0x04A3:  "ʜ̩",                 // 'ң'
+	0x04AA:  "C̦",                 // 'Ҫ'
+	0x04AB:  "c̦",                 // 'ҫ'
+	0x04AC:  "T̩",                 // 'Ҭ'
+	0x04AD:  "ᴛ̩",                 // 'ҭ'
+	0x04AE:  "Y",                  // 'Ү'
+	0x04AF:  "y",                  // 'ү'
+	0x04B0:  "Y̵",                 // 'Ұ'
+	0x04B1:  "y̵",                 // 'ұ'
+	0x04B2:  "X̩",                 // 'Ҳ'
+	0x04BB:  "h",                  // 'һ'
+	0x04BD:  "e",                  // 'ҽ'
+	0x04BE:  "Ҽ̨",                 // 'Ҿ'
+	0x04BF:  "ę",                 // 'ҿ'
+	0x04C0:  "l",                  // 'Ӏ'
+	0x04C5:  "Ʌ̦",                 // 'Ӆ'
+	0x04C6:  "л̦",                 // 'ӆ'
+	0x04C7:  "H̦",                 // 'Ӈ'
+	0x04C8:  "ʜ̦",                 // 'ӈ'
+	0x04C9:  "H̦",                 // 'Ӊ'
+	0x04CA:  "ʜ̦",                 // 'ӊ'
+	0x04CB:  "Ҷ",                  // 'Ӌ'
+	0x04CC:  "ҷ",                  // 'ӌ'
+	0x04CD:  "M̦",                 // 'Ӎ'
+	0x04CE:  "ʍ̦",                 // 'ӎ'
+	0x04CF:  "i",                  // 'ӏ'
+	0x04D4:  "AE",                 // 'Ӕ'
+	0x04D5:  "ae",                 // 'ӕ'
+	0x04D8:  "Ə",                  // 'Ә'
+	0x04D9:  "ǝ",                  // 'ә'
+	0x04E0:  "3",                  // 'Ӡ'
+	0x04E1:  "ȝ",                  // 'ӡ'
+	0x04E8:  "O̵",                 // 'Ө'
+	0x04E9:  "o̵",                 // 'ө'
+	0x0501:  "d",                  // 'ԁ'
+	0x050A:  "Ƕ",                  // 'Ԋ'
+	0x050C:  "G",                  // 'Ԍ'
+	0x050D:  "ɢ",                  // 'ԍ'
+	0x0510:  "Ɛ",                  // 'Ԑ'
+	0x0511:  "ꞓ",                  // 'ԑ'
+	0x051B:  "q",                  // 'ԛ'
+	0x051C:  "W",                  // 'Ԝ'
+	0x051D:  "w",                  // 'ԝ'
+	0x053B:  "ኮ",                  // 'Ի'
+	0x0544:  "ሆ",                  // 'Մ'
+	0x054A:  "ጣ",                  // 'Պ'
+	0x054C:  "ቡ",                  // 'Ռ'
+	0x054D:  "U",                  // 'Ս'
+	0x054F:  "S",                  // 'Տ'
+	0x0553:  "Φ",                  // 'Փ'
+	0x0555:  "O",                  // 'Օ'
+	0x055A:  "'",                  // '՚'
+	0x055D:  "'",                  // '՝'
+	0x0561:  "w",                  // 'ա'
+	0x0563:  "q",                  // 'գ'
+	0x0566:  "q",                  // 'զ'
+	0x056E:  "ẟ",                  // 'ծ'
+	0x0570:  "h",                  // 'հ'
+	0x0575:  "ȷ",                  // 'յ'
+	0x0578:  "n",                  // 'ո'
+	0x057A:  "ɰ",                  // 'պ'
+	0x057C:  "n",                  // 'ռ'
+	0x057D:  "u",                  // 'ս'
+	0x0581:  "g",                  // 'ց'
+	0x0584:  "f",                  // 'ք'
+	0x0585:  "o",                  // 'օ'
+	0x0587:  "եւ",                 // 'և'
+	0x0589:  ":",                  // '։'
+	0x059C:  "́",                  // '֜'
+	0x059D:  "́",                  // '֝'
+	0x05A4:  "֚",                  // '֤'
+	0x05A8:  "֙",                  // '֨'
+	0x05AD:  "֖",                  // '֭'
+	0x05AE:  "֘",                  // '֮'
+	0x05AF:  "̊",                  // '֯'
+	0x05B4:  "̣",                  // 'ִ'
+	0x05B9:  "̇",                  // 'ֹ'
+	0x05BA:  "̇",                  // 'ֺ'
+	0x05C0:  "l",                  // '׀'
+	0x05C1:  "̇",                  // 'ׁ'
+	0x05C2:  "̇",                  // 'ׂ'
+	0x05C3:  ":",                  // '׃'
+	0x05C4:  "̇",                  // 'ׄ'
+	0x05C5:  "̣",                  // 'ׅ'
+	0x05D5:  "l",                  // 'ו'
+	0x05D8:  "v",                  // 'ט'
+	0x05D9:  "'",                  // 'י'
+	0x05DF:  "l",                  // 'ן'
+	0x05E1:  "o",                  // 'ס'
+	0x05F0:  "ll",                 // 'װ'
+	0x05F1:  "l'",                 // 'ױ'
+	0x05F2:  "''",                 // 'ײ'
+	0x05F3:  "'",                  // '׳'
+	0x05F4:  "''",                 // '״'
+	0x0609:  "º/₀₀",               // '؉'
+	0x060A:  "º/₀₀₀",              // '؊'
+	0x060D:  ",",                  // '؍'
+	0x060F:  "ع",                  // '؏'
+	0x0618:  "́",                  // 'ؘ'
+	0x0619:  "̓",                  // 'ؙ'
+	0x061A:  "ِ",                  // 'ؚ'
+	0x0623:  "lٴ",                 // 'أ'
+	0x0624:  "وٴ",                 // 'ؤ'
+	0x0625:  "lٕ",                 // 'إ'
+	0x0626:  "ىٴ",                 // 'ئ'
+	0x0627:  "l",                  // 'ا'
+	0x062B:  "ىۛ",                 // 'ث'
+	0x0634:  "سۛ",                 // 'ش'
+	0x063D:  "ى̂",                 // 'ؽ'
+	0x063F:  "ىۛ",                 // 'ؿ'
+	0x0647:  "o",                  // 'ه'
+	0x064A:  "ى",                  // 'ي'
+	0x064B:  "̋",                  // 'ً'
+	0x064E:  "́",                  // 'َ'
+	0x064F:  "̓",                  // 'ُ'
+	0x0652:  "̊",                  // 'ْ'
+	0x0653:  "̃",                  // 'ٓ'
+	0x0656:  "̩",                  // 'ٖ'
+	0x0657:  "̒",                  // 'ٗ'
+	0x0658:  "̆",                  // '٘'
+	0x0659:  "̄",                  // 'ٙ'
+	0x065A:  "̆",                  // 'ٚ'
+	0x065B:  "̂",                  // 'ٛ'
+	0x065C:  "̣",                  // 'ٜ'
+	0x065D:  "̔",                  // 'ٝ'
+	0x065F:  "ٕ",                  // 'ٟ'
+	0x0660:  ".",                  // '٠'
+	0x0661:  "l",                  // '١'
+	0x0665:  "o",                  // '٥'
+	0x0667:  "V",                  // '٧'
+	0x0668:  "Ʌ",                  // '٨'
+	0x066A:  "º/₀",                // '٪'
+	0x066B:  ",",                  // '٫'
+	0x066C:  "،",                  // '٬'
+	0x066D:  "*",                  // '٭'
+	0x066E:  "ى",                  // 'ٮ'
+	0x066F:  "ڡ",                  // 'ٯ'
+	0x0672:  "lٴ",                 // 'ٲ'
+	0x0673:  "lٕ",                 // 'ٳ'
+	0x0675:  "lٴ",                 // 'ٵ'
+	0x0676:  "وٴ",                 // 'ٶ'
+	0x0677:  "و̓ٴ",                // 'ٷ'
+	0x0678:  "ىٴ",                 // 'ٸ'
+	0x0679:  "ىؕ",                 // 'ٹ'
+	0x067E:  "ىۛ",                 // 'پ'
+	0x0681:  "حٔ",                 // 'ځ'
+	0x0685:  "حۛ",                 // 'څ'
+	0x0688:  "دؕ",                 // 'ڈ'
+	0x068B:  "ڊؕ",                 // 'ڋ'
+	0x068E:  "دۛ",                 // 'ڎ'
+	0x0691:  "رؕ",                 // 'ڑ'
+	0x0692:  "ر̆",                 // 'ڒ'
+	0x0698:  "رۛ",                 // 'ژ'
+	0x069E:  "صۛ",                 // 'ڞ'
+	0x069F:  "طۛ",                 // 'ڟ'
+	0x06A4:  "ڡۛ",                 // 'ڤ'
+	0x06A7:  "ف",                  // 'ڧ'
+	0x06A8:  "ڡۛ",                 // 'ڨ'
+	0x06A9:  "ك",                  // 'ک'
+	0x06AA:  "ك",                  // 'ڪ'
+	0x06AD:  "كۛ",                 // 'ڭ'
+	0x06B4:  "گۛ",                 // 'ڴ'
+	0x06B5:  "ل̆",                 // 'ڵ'
+	0x06B7:  "لۛ",                 // 'ڷ'
+	0x06BA:  "ى",                  // 'ں'
+	0x06BB:  "ىؕ",                 // 'ڻ'
+	0x06BD:  "ىۛ",                 // 'ڽ'
+	0x06BE:  "o",                  // 'ھ'
+	0x06C1:  "o",                  // 'ہ'
+	0x06C2:  "ۀ",                  // 'ۂ'
+	0x06C3:  "ة",                  // 'ۃ'
+	0x06C6:  "و̆",                 // 'ۆ'
+	0x06C7:  "و̓",                 // 'ۇ'
+	0x06C8:  "وٰ",                 // 'ۈ'
+	0x06C9:  "و̂",                 // 'ۉ'
+	0x06CB:  "وۛ",                 // 'ۋ'
+	0x06CC:  "ى",                  // 'ی'
+	0x06CE:  "ى̆",                 // 'ێ'
+	0x06D0:  "ٻ",                  // 'ې'
+	0x06D1:  "ىۛ",                 // 'ۑ'
+	0x06D2:  "ى",                  // 'ے'
+	0x06D4:  "-",                  // '۔'
+	0x06D5:  "o",                  // 'ە'
+	0x06DF:  "̊",                  // '۟'
+	0x06E8:  "̆̇",                 // 'ۨ'
+	0x06EC:  "̇",                  // '۬'
+	0x06EE:  "د̂",                 // 'ۮ'
+	0x06EF:  "ر̂",                 // 'ۯ'
+	0x06F0:  ".",                  // '۰'
+	0x06F1:  "l",                  // '۱'
+	0x06F2:  "٢",                  // '۲'
+	0x06F3:  "٣",                  // '۳'
+	0x06F4:  "٤",                  // '۴'
+	0x06F5:  "o",                  // '۵'
+	0x06F6:  "٦",                  // '۶'
+	0x06F7:  "V",                  // '۷'
+	0x06F8:  "Ʌ",                  // '۸'
+	0x06F9:  "٩",                  // '۹'
+	0x06FD:  "ء͈",                 // '۽'
+	0x06FE:  "م͈",                 // '۾'
+	0x06FF:  "ô",                 // 'ۿ'
+	0x0701:  ".",                  // '܁'
+	0x0702:  ".",                  // '܂'
+	0x0703:  ":",                  // '܃'
+	0x0704:  ":",                  // '܄'
+	0x0740:  "̇",                  // '݀'
+	0x0741:  "̇",                  // '݁'
+	0x0742:  "ܼ",                  // '݂'
+	0x0747:  "́",                  // '݇'
+	0x0751:  "بۛ",                 // 'ݑ'
+	0x0756:  "ى̆",                 // 'ݖ'
+	0x0762:  "ڬ",                  // 'ݢ'
+	0x0763:  "كۛ",                 // 'ݣ'
+	0x0767:  "ݔ",                  // 'ݧ'
+	0x0768:  "نؕ",                 // 'ݨ'
+	0x0769:  "ن̆",                 // 'ݩ'
+	0x076C:  "رٔ",                 // 'ݬ'
+	0x0771:  "ڗؕ",                 // 'ݱ'
+	0x0772:  "حٔ",                 // 'ݲ'
+	0x077E:  "س̂",                 // 'ݾ'
+	0x07C0:  "O",                  // '߀'
+	0x07CA:  "l",                  // 'ߊ'
+	0x07EB:  "̄",                  // '߫'
+	0x07ED:  "̇",                  // '߭'
+	0x07EE:  "̂",                  // '߮'
+	0x07F3:  "̈",                  // '߳'
+	0x07F4:  "'",                  // 'ߴ'
+	0x07F5:  "'",                  // 'ߵ'
+	0x07FA:  "_",                  // 'ߺ'
+	0x08A1:  "بٔ",                 // 'ࢡ'
+	0x08A4:  "ڢۛ",                 // 'ࢤ'
+	0x08A7:  "مۛ",                 // 'ࢧ'
+	0x08A8:  "ىٔ",                 // 'ࢨ'
+	0x08A9:  "ݔ",                  // 'ࢩ'
+	0x08AE:  "د̤̣",                // 'ࢮ'
+	0x08AF:  "ص̤̣",                // 'ࢯ'
+	0x08B0:  "گ",                  // 'ࢰ'
+	0x08B1:  "و",                  // 'ࢱ'
+	0x08B2:  "ز̂",                 // 'ࢲ'
+	0x08B6:  "بۢ",                 // 'ࢶ'
+	0x08B7:  "ىۛۢ",                // 'ࢷ'
+	0x08B9:  "ر̆̇",                // 'ࢹ'
+	0x08BA:  "ى̆̇",                // 'ࢺ'
+	0x08BB:  "ڡ",                  // 'ࢻ'
+	0x08BC:  "ڡ",                  // 'ࢼ'
+	0x08BD:  "ى",                  // 'ࢽ'
+	0x08E5:  "ٌ",                  // 'ࣥ'
+	0x08E8:  "ٌ",                  // 'ࣨ'
+	0x08EA:  "̇",                  // '࣪'
+	0x08EB:  "̈",                  // '࣫'
+	0x08ED:  "̣",                  // '࣭'
+	0x08EE:  "̤",                  // '࣮'
+	0x08F0:  "̋",                  // 'ࣰ'
+	0x08F1:  "ٌ",                  // 'ࣱ'
+	0x08F2:  "ٍ",                  // 'ࣲ'
+	0x08F3:  "̓",                  // 'ࣳ'
+	0x08F8:  "͐",                  // 'ࣸ'
+	0x08F9:  "͔",                  // 'ࣹ'
+	0x08FA:  "͕",                  // 'ࣺ'
+	0x08FF:  "͐",                  // 'ࣿ'
+	0x0900:  "͒",                  // 'ऀ'
+	0x0901:  "̆̇",                 // 'ँ'
+	0x0902:  "̇",                  // 'ं'
+	0x0903:  ":",                  // 'ः'
+	0x0904:  "अॆ",                 // 'ऄ'
+	0x0906:  "अा",                 // 'आ'
+	0x0908:  "र्इ",                // 'ई'
+	0x090D:  "एॅ",                 // 'ऍ'
+	0x090E:  "एॆ",                 // 'ऎ'
+	0x0910:  "एे",                 // 'ऐ'
+	0x0911:  "अॉ",                 // 'ऑ'
+	0x0912:  "अाॆ",                // 'ऒ'
+	0x0913:  "अाे",                // 'ओ'
+	0x0914:  "अाै",                // 'औ'
+	0x093C:  "̣",                  // '़'
+	0x0952:  "̱",                  // '॒'
+	0x0953:  "̀",                  // '॓'
+	0x0954:  "́",                  // '॔'
+	0x0965:  "।।",                 // '॥'
+	0x0966:  "o",                  // '०'
+	0x0967:  "٩",                  // '१'
+	0x097D:  "?",                  // 'ॽ'
+	0x0981:  "̆̇",                 // 'ঁ'
+	0x0986:  "অা",                 // 'আ'
+	0x09BC:  "̣",                  // '়'
+	0x09E0:  "ঋৃ",                 // 'ৠ'
+	0x09E1:  "ঋৃ",                 // 'ৡ'
+	0x09E6:  "O",                  // '০'
+	0x09EA:  "8",                  // '৪'
+	0x09ED:  "9",                  // '৭'
+	0x0A02:  "̇",                  // 'ਂ'
+	0x0A03:  "ঃ",                  // 'ਃ'
+	0x0A06:  "ਅਾ",                 // 'ਆ'
+	0x0A07:  "ੲਿ",                 // 'ਇ'
+	0x0A08:  "ੲੀ",                 // 'ਈ'
+	0x0A09:  "ੳੁ",                 // 'ਉ'
+	0x0A0A:  "ੳੂ",                 // 'ਊ'
+	0x0A0F:  "ੲੇ",                 // 'ਏ'
+	0x0A10:  "ਅੈ",                 // 'ਐ'
+	0x0A14:  "ਅੌ",                 // 'ਔ'
+	0x0A3C:  "̣",                  // '਼'
+	0x0A4B:  "ॆ",                  // 'ੋ'
+	0x0A4D:  "्",                  // '੍'
+	0x0A66:  "o",                  // '੦'
+	0x0A67:  "9",                  // '੧'
+	0x0A6A:  "8",                  // '੪'
+	0x0A81:  "̆̇",                 // 'ઁ'
+	0x0A82:  "̇",                  // 'ં'
+	0x0A83:  ":",                  // 'ઃ'
+	0x0A86:  "અા",                 // 'આ'
+	0x0A8D:  "અૅ",                 // 'ઍ'
+	0x0A8F:  "અે",                 // 'એ'
+	0x0A90:  "અૈ",                 // 'ઐ'
+	0x0A91:  "અાૅ",                // 'ઑ'
+	0x0A93:  "અાે",                // 'ઓ'
+	0x0A94:  "અાૈ",                // 'ઔ'
+	0x0ABC:  "̣",                  // '઼'
+	0x0ABD:  "ऽ",                  // 'ઽ'
+	0x0AC1:  "ु",                  // 'ુ'
+	0x0AC2:  "ू",                  // 'ૂ'
+	0x0ACD:  "्",                  // '્'
+	0x0AE6:  "o",                  // '૦'
+	0x0AE8:  "२",                  // '૨'
+	0x0AE9:  "३",                  // '૩'
+	0x0AEA:  "४",                  // '૪'
+	0x0AEE:  "८",                  // '૮'
+	0x0AF0:  "॰",                  // '૰'
+	0x0B01:  "̆̇",                 // 'ଁ'
+	0x0B03:  "8",                  // 'ଃ'
+	0x0B06:  "ଅା",                 // 'ଆ'
+	0x0B20:  "O",                  // 'ଠ'
+	0x0B3C:  "̣",                  // '଼'
+	0x0B66:  "O",                  // '୦'
+	0x0B68:  "9",                  // '୨'
+	0x0B82:  "̊",                  // 'ஂ'
+	0x0B8A:  "உள",                 // 'ஊ'
+	0x0B9C:  "ஐ",                  // 'ஜ'
+	0x0BB0:  "ஈ",                  // 'ர'
+	0x0BBE:  "ஈ",                  // 'ா'
+	0x0BC8:  "ன",                  // 'ை'
+	0x0BCA:  "ெஈ",                 // 'ொ'
+	0x0BCB:  "ேஈ",                 // 'ோ'
+	0x0BCC:  "ெள",                 // 'ௌ'
+	0x0BCD:  "̇",                  // '்'
+	0x0BD7:  "ள",                  // 'ௗ'
+	0x0BE6:  "o",                  // '௦'
+	0x0BE7:  "க",                  // '௧'
+	0x0BE8:  "உ",                  // '௨'
+	0x0BEA:  "ச",                  // '௪'
+	0x0BEB:  "ஈு",                 // '௫'
+	0x0BEC:  "சு",                 // '௬'
+	0x0BED:  "எ",                  // '௭'
+	0x0BEE:  "அ",                  // '௮'
+	0x0BF0:  "ய",                  // '௰'
+	0x0BF2:  "சூ",                 // '௲'
+	0x0BF4:  "மீ",                 // '௴'
+	0x0BF5:  "௳",                  // '௵'
+	0x0BF7:  "எவ",                 // '௷'
+	0x0BF8:  "ஷ",                  // '௸'
+	0x0BFA:  "நீ",                 // '௺'
+	0x0C00:  "̆̇",                 // 'ఀ'
+	0x0C02:  "o",                  // 'ం'
+	0x0C03:  "ঃ",                  // 'ః'
+	0x0C13:  "ఒౕ",                 // 'ఓ'
+	0x0C14:  "ఒౌ",                 // 'ఔ'
+	0x0C20:  "రּ",                 // 'ఠ'
+	0x0C22:  "డ̣",                 // 'ఢ'
+	0x0C25:  "ధּ",                 // 'థ'
+	0x0C2D:  "బ̣",                 // 'భ'
+	0x0C2E:  "వు",                 // 'మ'
+	0x0C37:  "వ̣",                 // 'ష'
+	0x0C39:  "వా",                 // 'హ'
+	0x0C42:  "ుా",                 // 'ూ'
+	0x0C44:  "ృా",                 // 'ౄ'
+	0x0C60:  "ఋా",                 // 'ౠ'
+	0x0C61:  "ఌా",                 // 'ౡ'
+	0x0C66:  "o",                  // '౦'
+	0x0C81:  "̆̇",                 // 'ಁ'
+	0x0C82:  "o",                  // 'ಂ'
+	0x0C83:  "ঃ",                  // 'ಃ'
+	0x0C85:  "అ",                  // 'ಅ'
+	0x0C86:  "ఆ",                  // 'ಆ'
+	0x0C87:  "ఇ",                  // 'ಇ'
+	0x0C92:  "ఒ",                  // 'ಒ'
+	0x0C93:  "ఒౕ",                 // 'ಓ'
+	0x0C94:  "ఒౌ",                 // 'ಔ'
+	0x0C9C:  "జ",                  // 'ಜ'
+	0x0C9E:  "ఞ",                  // 'ಞ'
+	0x0CA3:  "ణ",                  // 'ಣ'
+	0x0CAF:  "య",                  // 'ಯ'
+	0x0CB1:  "ఱ",                  // 'ಱ'
+	0x0CB2:  "ల",                  // 'ಲ'
+	0x0CE1:  "ಌಾ",                 // 'ೡ'
+	0x0CE6:  "o",                  // '೦'
+	0x0CE7:  "౧",                  // '೧'
+	0x0CE8:  "౨",                  // '೨'
+	0x0CEF:  "౯",                  // '೯'
+	0x0D01:  "̆̇",                 // 'ഁ'
+	0x0D02:  "o",                  // 'ം'
+	0x0D03:  "ঃ",                  // 'ഃ'
+	0x0D08:  "ഇൗ",                 // 'ഈ'
+	0x0D09:  "உ",                  // 'ഉ'
+	0x0D0A:  "உൗ",                 // 'ഊ'
+	0x0D0C:  "നു",                 // 'ഌ'
+	0x0D10:  "എെ",                 // 'ഐ'
+	0x0D13:  "ഒാ",                 // 'ഓ'
+	0x0D14:  "ഒൗ",                 // 'ഔ'
+	0x0D19:  "നു",                 // 'ങ'
+	0x0D1C:  "ஐ",                  // 'ജ'
+	0x0D20:  "o",                  // 'ഠ'
+	0x0D23:  "ண",                  // 'ണ'
+	0x0D31:  "ര",                  // 'റ'
+	0x0D34:  "ழ",                  // 'ഴ'
+	0x0D36:  "ஶ",                  // 'ശ'
+	0x0D3A:  "டி",                 // 'ഺ'
+	0x0D3F:  "ி",                  // 'ി'
+	0x0D40:  "ி",                  // 'ീ'
+	0x0D42:  "ു",                  // 'ൂ'
+	0x0D43:  "ു",                  // 'ൃ'
+	0x0D48:  "െെ",                 // 'ൈ'
+	0x0D4E:  "ॱ",                  // 'ൎ'
+	0x0D5A:  "ന്മ",                // '൚'
+	0x0D5F:  "oരo",                // 'ൟ'
+	0x0D61:  "ഞ",                  // 'ൡ'
+	0x0D66:  "o",                  // '൦'
+	0x0D6A:  "ര്",                 // '൪'
+	0x0D6B:  "ദ്ര",                // '൫'
+	0x0D6C:  "ന്ന",                // '൬'
+	0x0D6D:  "9",                  // '൭'
+	0x0D6E:  "വ്ര",                // '൮'
+	0x0D6F:  "ന്",                 // '൯'
+	0x0D76:  "ഹ്മ",                // '൶'
+	0x0D79:  "നു",                 // '൹'
+	0x0D7B:  "ന്",                 // 'ൻ'
+	0x0D7C:  "ര്",                 // 'ർ'
+	0x0D82:  "o",                  // 'ං'
+	0x0D83:  "ঃ",                  // 'ඃ'
+	0x0DE9:  "෨ා",                 // '෩'
+	0x0DEA:  "ජ",                  // '෪'
+	0x0DEB:  "ද",                  // '෫'
+	0x0DEF:  "෨ී",                 // '෯'
+	0x0E03:  "ข",                  // 'ฃ'
+	0x0E0B:  "ช",                  // 'ซ'
+	0x0E0F:  "ฎ",                  // 'ฏ'
+	0x0E14:  "ค",                  // 'ด'
+	0x0E15:  "ค",                  // 'ต'
+	0x0E17:  "ฑ",                  // 'ท'
+	0x0E21:  "ฆ",                  // 'ม'
+	0x0E26:  "ภ",                  // 'ฦ'
+	0x0E33:  "̊า",                 // 'ำ'
+	0x0E41:  "เเ",                 // 'แ'
+	0x0E45:  "า",                  // 'ๅ'
+	0x0E4D:  "̊",                  // 'ํ'
+	0x0E50:  "o",                  // '๐'
+	0x0E88:  "จ",                  // 'ຈ'
+	0x0E8D:  "ย",                  // 'ຍ'
+	0x0E9A:  "บ",                  // 'ບ'
+	0x0E9B:  "ป",                  // 'ປ'
+	0x0E9D:  "ฝ",                  // 'ຝ'
+	0x0E9E:  "พ",                  // 'ພ'
+	0x0E9F:  "ฟ",                  // 'ຟ'
+	0x0EB3:  "̊າ",                 // 'ຳ'
+	0x0EB8:  "ุ",                  // 'ຸ'
+	0x0EB9:  "ู",                  // 'ູ'
+	0x0EC8:  "่",                  // '່'
+	0x0EC9:  "้",                  // '້'
+	0x0ECA:  "๊",                  // '໊'
+	0x0ECB:  "๋",                  // '໋'
+	0x0ECD:  "̊",                  // 'ໍ'
+	0x0ED0:  "o",                  // '໐'
+	0x0EDC:  "ຫນ",                 // 'ໜ'
+	0x0EDD:  "ຫມ",                 // 'ໝ'
+	0x0F00:  "ཨོཾ",                // 'ༀ'
+	0x0F02:  "འུྂཿ",               // '༂'
+	0x0F03:  "འུྂ༔",               // '༃'
+	0x0F0C:  "་",                  // '༌'
+	0x0F0E:  "།།",                 // '༎'
+	0x0F1B:  "༚༚",                 // '༛'
+	0x0F1E:  "༝༝",                 // '༞'
+	0x0F1F:  "༚༝",                 // '༟'
+	0x0F37:  "̥",                  // '༷'
+	0x0F6A:  "ར",                  // 'ཪ'
+	0x0F77:  "ྲཱྀ",                // 'ཷ'
+	0x0F79:  "ླཱྀ",                // 'ཹ'
+	0x0FCE:  "༝༚",                 // '࿎'
+	0x0FD5:  "卐",                  // '࿕'
+	0x0FD6:  "卍",                  // '࿖'
+	0x1000:  "ဂာ",                 // 'က'
+	0x1010:  "oာ",                 // 'တ'
+	0x101D:  "o",                  // 'ဝ'
+	0x101F:  "ပာ",                 // 'ဟ'
+	0x1029:  "သြ",                 // 'ဩ'
+	0x102A:  "သြော်",              // 'ဪ'
+	0x1036:  "̊",                  // 'ံ'
+	0x1038:  "ঃ",                  // 'း'
+	0x1040:  "o",                  // '၀'
+	0x104B:  "၊၊",                 // '။'
+	0x1065:  "၁",                  // 'ၥ'
+	0x1066:  "ပှ",                 // 'ၦ'
+	0x106F:  "ပာှ",                // 'ၯ'
+	0x1070:  "ဃှ",                 // 'ၰ'
+	0x107E:  "ၽှ",                 // 'ၾ'
+	0x1081:  "ဂှ",                 // 'ႁ'
+	0x109E:  "ႃ̊",                 // '႞'
+	0x10A0:  "Ꞇ",                  // 'Ⴀ'
+	0x10E7:  "y",                  // 'ყ'
+	0x10F3:  "ȝ",                  // 'ჳ'
+	0x10FF:  "o",                  // 'ჿ'
+	0x1101:  "ᄀᄀ",                 // 'ᄁ'
+	0x1104:  "ᄃᄃ",                 // 'ᄄ'
+	0x1108:  "ᄇᄇ",                 // 'ᄈ'
+	0x110A:  "ᄉᄉ",                 // 'ᄊ'
+	0x110D:  "ᄌᄌ",                 // 'ᄍ'
+	0x1113:  "ᄂᄀ",                 // 'ᄓ'
+	0x1114:  "ᄂᄂ",                 // 'ᄔ'
+	0x1115:  "ᄂᄃ",                 // 'ᄕ'
+	0x1116:  "ᄂᄇ",                 // 'ᄖ'
+	0x1117:  "ᄃᄀ",                 // 'ᄗ'
+	0x1118:  "ᄅᄂ",                 // 'ᄘ'
+	0x1119:  "ᄅᄅ",                 // 'ᄙ'
+	0x111A:  "ᄅᄒ",                 // 'ᄚ'
+	0x111B:  "ᄅᄋ",                 // 'ᄛ'
+	0x111C:  "ᄆᄇ",                 // 'ᄜ'
+	0x111D:  "ᄆᄋ",                 // 'ᄝ'
+	0x111E:  "ᄇᄀ",                 // 'ᄞ'
+	0x111F:  "ᄇᄂ",                 // 'ᄟ'
+	0x1120:  "ᄇᄃ",                 // 'ᄠ'
+	0x1121:  "ᄇᄉ",                 // 'ᄡ'
+	0x1122:  "ᄇᄉᄀ",                // 'ᄢ'
+	0x1123:  "ᄇᄉᄃ",                // 'ᄣ'
+	0x1124:  "ᄇᄉᄇ",                // 'ᄤ'
+	0x1125:  "ᄇᄉᄉ",                // 'ᄥ'
+	0x1126:  "ᄇᄉᄌ",                // 'ᄦ'
+	0x1127:  "ᄇᄌ",                 // 'ᄧ'
+	0x1128:  "ᄇᄎ",                 // 'ᄨ'
+	0x1129:  "ᄇᄐ",                 // 'ᄩ'
+	0x112A:  "ᄇᄑ",                 // 'ᄪ'
+	0x112B:  "ᄇᄋ",                 // 'ᄫ'
+	0x112C:  "ᄇᄇᄋ",                // 'ᄬ'
+	0x112D:  "ᄉᄀ",                 // 'ᄭ'
+	0x112E:  "ᄉᄂ",                 // 'ᄮ'
+	0x112F:  "ᄉᄃ",                 // 'ᄯ'
+	0x1130:  "ᄉᄅ",                 // 'ᄰ'
+	0x1131:  "ᄉᄆ",                 // 'ᄱ'
+	0x1132:  "ᄉᄇ",                 // 'ᄲ'
+	0x1133:  "ᄉᄇᄀ",                // 'ᄳ'
+	0x1134:  "ᄉᄉᄉ",                // 'ᄴ'
+	0x1135:  "ᄉᄋ",                 // 'ᄵ'
+	0x1136:  "ᄉᄌ",                 // 'ᄶ'
+	0x1137:  "ᄉᄎ",                 // 'ᄷ'
+	0x1138:  "ᄉᄏ",                 // 'ᄸ'
+	0x1139:  "ᄉᄐ",                 // 'ᄹ'
+	0x113A:  "ᄉᄑ",                 // 'ᄺ'
+	0x113B:  "ᄅᄒ",                 // 'ᄻ'
+	0x113D:  "ᄼᄼ",                 // 'ᄽ'
+	0x113F:  "ᄾᄾ",                 // 'ᄿ'
+	0x1141:  "ᄋᄀ",                 // 'ᅁ'
+	0x1142:  "ᄋᄃ",                 // 'ᅂ'
+	0x1143:  "ᄋᄆ",                 // 'ᅃ'
+	0x1144:  "ᄋᄇ",                 // 'ᅄ'
+	0x1145:  "ᄋᄉ",                 // 'ᅅ'
+	0x1146:  "ᄋᅀ",                 // 'ᅆ'
+	0x1147:  "ᄋᄋ",                 // 'ᅇ'
+	0x1148:  "ᄋᄌ",                 // 'ᅈ'
+	0x1149:  "ᄋᄎ",                 // 'ᅉ'
+	0x114A:  "ᄋᄐ",                 // 'ᅊ'
+	0x114B:  "ᄋᄑ",                 // 'ᅋ'
+	0x114D:  "ᄌᄋ",                 // 'ᅍ'
+	0x114F:  "ᅎᅎ",                 // 'ᅏ'
+	0x1151:  "ᅐᅐ",                 // 'ᅑ'
+	0x1152:  "ᄎᄏ",                 // 'ᅒ'
+	0x1153:  "ᄎᄒ",                 // 'ᅓ'
+	0x1156:  "ᄑᄇ",                 // 'ᅖ'
+	0x1157:  "ᄑᄋ",                 // 'ᅗ'
+	0x1158:  "ᄒᄒ",                 // 'ᅘ'
+	0x115A:  "ᄀᄃ",                 // 'ᅚ'
+	0x115B:  "ᄂᄉ",                 // 'ᅛ'
+	0x115C:  "ᄂᄌ",                 // 'ᅜ'
+	0x115D:  "ᄂᄒ",                 // 'ᅝ'
+	0x115E:  "ᄃᄅ",                 // 'ᅞ'
+	0x1162:  "ᅡ丨",                 // 'ᅢ'
+	0x1164:  "ᅣ丨",                 // 'ᅤ'
+	0x1166:  "ᅥ丨",                 // 'ᅦ'
+	0x1168:  "ᅧ丨",                 // 'ᅨ'
+	0x116A:  "ᅩᅡ",                 // 'ᅪ'
+	0x116B:  "ᅩᅡ丨",                // 'ᅫ'
+	0x116C:  "ᅩ丨",                 // 'ᅬ'
+	0x116F:  "ᅮᅥ",                 // 'ᅯ'
+	0x1170:  "ᅮᅥ丨",                // 'ᅰ'
+	0x1171:  "ᅮ丨",                 // 'ᅱ'
+	0x1173:  "ー",                  // 'ᅳ'
+	0x1174:  "ー丨",                 // 'ᅴ'
+	0x1175:  "丨",                  // 'ᅵ'
+	0x1176:  "ᅡᅩ",                 // 'ᅶ'
+	0x1177:  "ᅡᅮ",                 // 'ᅷ'
+	0x1178:  "ᅣᅩ",                 // 'ᅸ'
+	0x1179:  "ᅣᅭ",                 // 'ᅹ'
+	0x117A:  "ᅥᅩ",                 // 'ᅺ'
+	0x117B:  "ᅥᅮ",                 // 'ᅻ'
+	0x117C:  "ᅥー",                 // 'ᅼ'
+	0x117D:  "ᅧᅩ",                 // 'ᅽ'
+	0x117E:  "ᅧᅮ",                 // 'ᅾ'
+	0x117F:  "ᅩᅥ",                 // 'ᅿ'
+	0x1180:  "ᅩᅥ丨",                // 'ᆀ'
+	0x1181:  "ᅩᅧ丨",                // 'ᆁ'
+	0x1182:  "ᅩᅩ",                 // 'ᆂ'
+	0x1183:  "ᅩᅮ",                 // 'ᆃ'
+	0x1184:  "ᅭᅣ",                 // 'ᆄ'
+	0x1185:  "ᅭᅣ丨",                // 'ᆅ'
+	0x1186:  "ᅭᅣ",                 // 'ᆆ'
+	0x1187:  "ᅭᅩ",                 // 'ᆇ'
+	0x1188:  "ᅭ丨",                 // 'ᆈ'
+	0x1189:  "ᅮᅡ",                 // 'ᆉ'
+	0x118A:  "ᅮᅡ丨",                // 'ᆊ'
+	0x118B:  "ᅮᅥー",                // 'ᆋ'
+	0x118C:  "ᅮᅧ丨",                // 'ᆌ'
+	0x118D:  "ᅮᅮ",                 // 'ᆍ'
+	0x118E:  "ᅲᅡ",                 // 'ᆎ'
+	0x118F:  "ᅲᅥ",                 // 'ᆏ'
+	0x1190:  "ᅲᅥ丨",                // 'ᆐ'
+	0x1191:  "ᅲᅧ",                 // 'ᆑ'
+	0x1192:  "ᅲᅧ丨",                // 'ᆒ'
+	0x1193:  "ᅲᅮ",                 // 'ᆓ'
+	0x1194:  "ᅲ丨",                 // 'ᆔ'
+	0x1195:  "ーᅮ",                 // 'ᆕ'
+	0x1196:  "ーー",                 // 'ᆖ'
+	0x1197:  "ー丨ᅮ",                // 'ᆗ'
+	0x1198:  "丨ᅡ",                 // 'ᆘ'
+	0x1199:  "丨ᅣ",                 // 'ᆙ'
+	0x119A:  "丨ᅩ",                 // 'ᆚ'
+	0x119B:  "丨ᅮ",                 // 'ᆛ'
+	0x119C:  "丨ー",                 // 'ᆜ'
+	0x119D:  "丨ᆞ",                 // 'ᆝ'
+	0x119F:  "ᆞᅥ",                 // 'ᆟ'
+	0x11A0:  "ᆞᅮ",                 // 'ᆠ'
+	0x11A1:  "ᆞ丨",                 // 'ᆡ'
+	0x11A2:  "ᆞᆞ",                 // 'ᆢ'
+	0x11A3:  "ᅡー",                 // 'ᆣ'
+	0x11A4:  "ᅣᅮ",                 // 'ᆤ'
+	0x11A5:  "ᅧᅣ",                 // 'ᆥ'
+	0x11A6:  "ᅩᅣ",                 // 'ᆦ'
+	0x11A7:  "ᅩᅣ丨",                // 'ᆧ'
+	0x11A8:  "ᄀ",                  // 'ᆨ'
+	0x11A9:  "ᄀᄀ",                 // 'ᆩ'
+	0x11AA:  "ᄀᄉ",                 // 'ᆪ'
+	0x11AB:  "ᄂ",                  // 'ᆫ'
+	0x11AC:  "ᄂᄌ",                 // 'ᆬ'
+	0x11AD:  "ᄂᄒ",                 // 'ᆭ'
+	0x11AE:  "ᄃ",                  // 'ᆮ'
+	0x11AF:  "ᄅ",                  // 'ᆯ'
+	0x11B0:  "ᄅᄀ",                 // 'ᆰ'
+	0x11B1:  "ᄅᄆ",                 // 'ᆱ'
+	0x11B2:  "ᄅᄇ",                 // 'ᆲ'
+	0x11B3:  "ᄅᄉ",                 // 'ᆳ'
+	0x11B4:  "ᄅᄐ",                 // 'ᆴ'
+	0x11B5:  "ᄅᄑ",                 // 'ᆵ'
+	0x11B6:  "ᄅᄒ",                 // 'ᆶ'
+	0x11B7:  "ᄆ",                  // 'ᆷ'
+	0x11B8:  "ᄇ",                  // 'ᆸ'
+	0x11B9:  "ᄇᄉ",                 // 'ᆹ'
+	0x11BA:  "ᄉ",                  // 'ᆺ'
+	0x11BB:  "ᄉᄉ",                 // 'ᆻ'
+	0x11BC:  "ᄋ",                  // 'ᆼ'
+	0x11BD:  "ᄌ",                  // 'ᆽ'
+	0x11BE:  "ᄎ",                  // 'ᆾ'
+	0x11BF:  "ᄏ",                  // 'ᆿ'
+	0x11C0:  "ᄐ",                  // 'ᇀ'
+	0x11C1:  "ᄑ",                  // 'ᇁ'
+	0x11C2:  "ᄒ",                  // 'ᇂ'
+	0x11C3:  "ᄀᄅ",                 // 'ᇃ'
+	0x11C4:  "ᄀᄉᄀ",                // 'ᇄ'
+	0x11C5:  "ᄂᄀ",                 // 'ᇅ'
+	0x11C6:  "ᄂᄃ",                 // 'ᇆ'
+	0x11C7:  "ᄂᄉ",                 // 'ᇇ'
+	0x11C8:  "ᄂᅀ",                 // 'ᇈ'
+	0x11C9:  "ᄂᄐ",                 // 'ᇉ'
+	0x11CA:  "ᄃᄀ",                 // 'ᇊ'
+	0x11CB:  "ᄃᄅ",                 // 'ᇋ'
+	0x11CC:  "ᄅᄀᄉ",                // 'ᇌ'
+	0x11CD:  "ᄅᄂ",                 // 'ᇍ'
+	0x11CE:  "ᄅᄃ",                 // 'ᇎ'
+	0x11CF:  "ᄅᄃᄒ",                // 'ᇏ'
+	0x11D0:  "ᄅᄅ",                 // 'ᇐ'
+	0x11D1:  "ᄅᄆᄀ",                // 'ᇑ'
+	0x11D2:  "ᄅᄆᄉ",                // 'ᇒ'
+	0x11D3:  "ᄅᄇᄉ",                // 'ᇓ'
+	0x11D4:  "ᄅᄇᄒ",                // 'ᇔ'
+	0x11D5:  "ᄅᄇᄋ",                // 'ᇕ'
+	0x11D6:  "ᄅᄉᄉ",                // 'ᇖ'
+	0x11D7:  "ᄅᅀ",                 // 'ᇗ'
+	0x11D8:  "ᄅᄏ",                 // 'ᇘ'
+	0x11D9:  "ᄅᅙ",                 // 'ᇙ'
+	0x11DA:  "ᄆᄀ",                 // 'ᇚ'
+	0x11DB:  "ᄆᄅ",                 // 'ᇛ'
+	0x11DC:  "ᄆᄇ",                 // 'ᇜ'
+	0x11DD:  "ᄆᄉ",                 // 'ᇝ'
+	0x11DE:  "ᄆᄉᄉ",                // 'ᇞ'
+	0x11DF:  "ᄆᅀ",                 // 'ᇟ'
+	0x11E0:  "ᄆᄎ",                 // 'ᇠ'
+	0x11E1:  "ᄆᄒ",                 // 'ᇡ'
+	0x11E2:  "ᄆᄋ",                 // 'ᇢ'
+	0x11E3:  "ᄇᄅ",                 // 'ᇣ'
+	0x11E4:  "ᄇᄑ",                 // 'ᇤ'
+	0x11E5:  "ᄇᄒ",                 // 'ᇥ'
+	0x11E6:  "ᄇᄋ",                 // 'ᇦ'
+	0x11E7:  "ᄉᄀ",                 // 'ᇧ'
+	0x11E8:  "ᄉᄃ",                 // 'ᇨ'
+	0x11E9:  "ᄉᄅ",                 // 'ᇩ'
+	0x11EA:  "ᄉᄇ",                 // 'ᇪ'
+	0x11EB:  "ᅀ",                  // 'ᇫ'
+	0x11EC:  "ᄋᄀ",                 // 'ᇬ'
+	0x11ED:  "ᄋᄀᄀ",                // 'ᇭ'
+	0x11EE:  "ᄋᄋ",                 // 'ᇮ'
+	0x11EF:  "ᄋᄏ",                 // 'ᇯ'
+	0x11F0:  "ᅌ",                  // 'ᇰ'
+	0x11F1:  "ᄋᄉ",                 // 'ᇱ'
+	0x11F2:  "ᄋᅀ",                 // 'ᇲ'
+	0x11F3:  "ᄑᄇ",                 // 'ᇳ'
+	0x11F4:  "ᄑᄋ",                 // 'ᇴ'
+	0x11F5:  "ᄒᄂ",                 // 'ᇵ'
+	0x11F6:  "ᄒᄅ",                 // 'ᇶ'
+	0x11F7:  "ᄒᄆ",                 // 'ᇷ'
+	0x11F8:  "ᄒᄇ",                 // 'ᇸ'
+	0x11F9:  "ᅙ",                  // 'ᇹ'
+	0x11FA:  "ᄀᄂ",                 // 'ᇺ'
+	0x11FB:  "ᄀᄇ",                 // 'ᇻ'
+	0x11FC:  "ᄀᄎ",                 // 'ᇼ'
+	0x11FD:  "ᄀᄏ",                 // 'ᇽ'
+	0x11FE:  "ᄀᄒ",                 // 'ᇾ'
+	0x11FF:  "ᄂᄂ",                 // 'ᇿ'
+	0x1200:  "U",                  // 'ሀ'
+	0x1223:  "ɰ",                  // 'ሣ'
+	0x1240:  "Φ",                  // 'ቀ'
+	0x1260:  "Ո",                  // 'በ'
+	0x1294:  "ձ",                  // 'ኔ'
+	0x12D0:  "O",                  // 'ዐ'
+	0x13A0:  "D",                  // 'Ꭰ'
+	0x13A1:  "R",                  // 'Ꭱ'
+	0x13A2:  "T",                  // 'Ꭲ'
+	0x13A4:  "O'",                 // 'Ꭴ'
+	0x13A5:  "i",                  // 'Ꭵ'
+	0x13A8:  "Ⱶ",                  // 'Ꭸ'
+	0x13A9:  "Y",                  // 'Ꭹ'
+	0x13AA:  "A",                  // 'Ꭺ'
+	0x13AB:  "J",                  // 'Ꭻ'
+	0x13AC:  "E",                  // 'Ꭼ'
+	0x13AE:  "?",                  // 'Ꭾ'
+	0x13B0:  "Ⱶ",                  // 'Ꮀ'
+	0x13B1:  "Γ",                  // 'Ꮁ'
+	0x13B3:  "W",                  // 'Ꮃ'
+	0x13B7:  "M",                  // 'Ꮇ'
+	0x13BB:  "H",                  // 'Ꮋ'
+	0x13BD:  "Y",                  // 'Ꮍ'
+	0x13BE:  "O̵",                 // 'Ꮎ'
+	0x13BF:  "ƫ",                  // 'Ꮏ'
+	0x13C0:  "G",                  // 'Ꮐ'
+	0x13C2:  "h",                  // 'Ꮒ'
+	0x13C3:  "Z",                  // 'Ꮓ'
+	0x13C7:  "Ѡ",                  // 'Ꮗ'
+	0x13CB:  "Ɛ",                  // 'Ꮛ'
+	0x13CC:  "U̵",                 // 'Ꮜ'
+	0x13CE:  "4",                  // 'Ꮞ'
+	0x13CF:  "b",                  // 'Ꮟ'
+	0x13D2:  "R",                  // 'Ꮢ'
+	0x13D4:  "W",                  // 'Ꮤ'
+	0x13D5:  "S",                  // 'Ꮥ'
+	0x13D9:  "V",                  // 'Ꮩ'
+	0x13DA:  "S",                  // 'Ꮪ'
+	0x13DE:  "L",                  // 'Ꮮ'
+	0x13DF:  "C",                  // 'Ꮯ'
+	0x13E2:  "P",                  // 'Ꮲ'
+	0x13E6:  "K",                  // 'Ꮶ'
+	0x13E7:  "d",                  // 'Ꮷ'
+	0x13EB:  "O̵",                 // 'Ꮻ'
+	0x13EE:  "6",                  // 'Ꮾ'
+	0x13F0:  "ß",                  // 'Ᏸ'
+	0x13F2:  "h̔",                 // 'Ᏺ'
+	0x13F3:  "G",                  // 'Ᏻ'
+	0x13F4:  "B",                  // 'Ᏼ'
+	0x13FB:  "ɢ",                  // 'ᏻ'
+	0x13FC:  "ʙ",                  // 'ᏼ'
+	0x1400:  "=",                  // '᐀'
+	0x1403:  "Δ",                  // 'ᐃ'
+	0x140C:  "·ᐁ",                 // 'ᐌ'
+	0x140D:  "ᐁ·",                 // 'ᐍ'
+	0x140E:  "·Δ",                 // 'ᐎ'
+	0x140F:  "Δ·",                 // 'ᐏ'
+	0x1410:  "·ᐄ",                 // 'ᐐ'
+	0x1411:  "ᐄ·",                 // 'ᐑ'
+	0x1412:  "·ᐅ",                 // 'ᐒ'
+	0x1413:  "ᐅ·",                 // 'ᐓ'
+	0x1414:  "·ᐆ",                 // 'ᐔ'
+	0x1415:  "ᐆ·",                 // 'ᐕ'
+	0x1417:  "·ᐊ",                 // 'ᐗ'
+	0x1418:  "ᐊ·",                 // 'ᐘ'
+	0x1419:  "·ᐋ",                 // 'ᐙ'
+	0x141A:  "ᐋ·",                 // 'ᐚ'
+	0x1427:  "·",                  // 'ᐧ'
+	0x142B:  "ᐁᐠ",                 // 'ᐫ'
+	0x142C:  "Δᐠ",                 // 'ᐬ'
+	0x142D:  "ᐅᐠ",                 // 'ᐭ'
+	0x142E:  "ᐊᐠ",                 // 'ᐮ'
+	0x142F:  "V",                  // 'ᐯ'
+	0x1431:  "Ʌ",                  // 'ᐱ'
+	0x1433:  ">",                  // 'ᐳ'
+	0x1437:  "·>",                 // 'ᐷ'
+	0x1438:  "<",                  // 'ᐸ'
+	0x143A:  "·V",                 // 'ᐺ'
+	0x143B:  "V·",                 // 'ᐻ'
+	0x143C:  "·Ʌ",                 // 'ᐼ'
+	0x143D:  "Ʌ·",                 // 'ᐽ'
+	0x143E:  "·ᐲ",                 // 'ᐾ'
+	0x143F:  "ᐲ·",                 // 'ᐿ'
+	0x1440:  "·>",                 // 'ᑀ'
+	0x1441:  ">·",                 // 'ᑁ'
+	0x1442:  "·ᐴ",                 // 'ᑂ'
+	0x1443:  "ᐴ·",                 // 'ᑃ'
+	0x1444:  "·<",                 // 'ᑄ'
+	0x1445:  "<·",                 // 'ᑅ'
+	0x1446:  "·ᐹ",                 // 'ᑆ'
+	0x1447:  "ᐹ·",                 // 'ᑇ'
+	0x144A:  "'",                  // 'ᑊ'
+	0x144C:  "U",                  // 'ᑌ'
+	0x144E:  "Ո",                  // 'ᑎ'
+	0x1454:  "·ᑐ",                 // 'ᑔ'
+	0x1457:  "·U",                 // 'ᑗ'
+	0x1458:  "U·",                 // 'ᑘ'
+	0x1459:  "·Ո",                 // 'ᑙ'
+	0x145A:  "Ո·",                 // 'ᑚ'
+	0x145B:  "·ᑏ",                 // 'ᑛ'
+	0x145C:  "ᑏ·",                 // 'ᑜ'
+	0x145D:  "·ᑐ",                 // 'ᑝ'
+	0x145E:  "ᑐ·",                 // 'ᑞ'
+	0x145F:  "·ᑑ",                 // 'ᑟ'
+	0x1460:  "ᑑ·",                 // 'ᑠ'
+	0x1461:  "·ᑕ",                 // 'ᑡ'
+	0x1462:  "ᑕ·",                 // 'ᑢ'
+	0x1463:  "·ᑖ",                 // 'ᑣ'
+	0x1464:  "ᑖ·",                 // 'ᑤ'
+	0x1467:  "U'",                 // 'ᑧ'
+	0x1468:  "Ո'",                 // 'ᑨ'
+	0x1469:  "ᑐ'",                 // 'ᑩ'
+	0x146A:  "ᑕ'",                 // 'ᑪ'
+	0x146D:  "P",                  // 'ᑭ'
+	0x146F:  "d",                  // 'ᑯ'
+	0x1472:  "b",                  // 'ᑲ'
+	0x1473:  "ḃ",                 // 'ᑳ'
+	0x1474:  "·ᑫ",                 // 'ᑴ'
+	0x1475:  "ᑫ·",                 // 'ᑵ'
+	0x1476:  "·P",                 // 'ᑶ'
+	0x1477:  "p·",                 // 'ᑷ'
+	0x1478:  "·ᑮ",                 // 'ᑸ'
+	0x1479:  "ᑮ·",                 // 'ᑹ'
+	0x147A:  "·d",                 // 'ᑺ'
+	0x147B:  "d·",                 // 'ᑻ'
+	0x147C:  "·ᑰ",                 // 'ᑼ'
+	0x147D:  "ᑰ·",                 // 'ᑽ'
+	0x147E:  "·b",                 // 'ᑾ'
+	0x147F:  "b·",                 // 'ᑿ'
+	0x1480:  "·ḃ",                // 'ᒀ'
+	0x1481:  "ḃ·",                // 'ᒁ'
+	0x1485:  "ᑫ'",                 // 'ᒅ'
+	0x1486:  "P'",                 // 'ᒆ'
+	0x1487:  "d'",                 // 'ᒇ'
+	0x1488:  "b'",                 // 'ᒈ'
+	0x148D:  "J",                  // 'ᒍ'
+	0x1492:  "·ᒉ",                 // 'ᒒ'
+	0x1493:  "ᒉ·",                 // 'ᒓ'
+	0x1494:  "·ᒋ",                 // 'ᒔ'
+	0x1495:  "ᒋ·",                 // 'ᒕ'
+	0x1496:  "·ᒌ",                 // 'ᒖ'
+	0x1497:  "ᒌ·",                 // 'ᒗ'
+	0x1498:  "·J",                 // 'ᒘ'
+	0x1499:  "J·",                 // 'ᒙ'
+	0x149A:  "·ᒎ",                 // 'ᒚ'
+	0x149B:  "ᒎ·",                 // 'ᒛ'
+	0x149C:  "·ᒐ",                 // 'ᒜ'
+	0x149D:  "ᒐ·",                 // 'ᒝ'
+	0x149E:  "·ᒑ",                 // 'ᒞ'
+	0x149F:  "ᒑ·",                 // 'ᒟ'
+	0x14A5:  "Γ",                  // 'ᒥ'
+	0x14AA:  "L",                  // 'ᒪ'
+	0x14AC:  "·ᒣ",                 // 'ᒬ'
+	0x14AD:  "ᒣ·",                 // 'ᒭ'
+	0x14AE:  "·Γ",                 // 'ᒮ'
+	0x14AF:  "Γ·",                 // 'ᒯ'
+	0x14B0:  "·ᒦ",                 // 'ᒰ'
+	0x14B1:  "ᒦ·",                 // 'ᒱ'
+	0x14B2:  "·ᒧ",                 // 'ᒲ'
+	0x14B3:  "ᒧ·",                 // 'ᒳ'
+	0x14B4:  "·ᒨ",                 // 'ᒴ'
+	0x14B5:  "ᒨ·",                 // 'ᒵ'
+	0x14B6:  "·L",                 // 'ᒶ'
+	0x14B7:  "l·",                 // 'ᒷ'
+	0x14B8:  "·ᒫ",                 // 'ᒸ'
+	0x14B9:  "ᒫ·",                 // 'ᒹ'
+	0x14BF:  "2",                  // 'ᒿ'
+	0x14C9:  "·ᓀ",                 // 'ᓉ'
+	0x14CA:  "ᓀ·",                 // 'ᓊ'
+	0x14CB:  "·ᓇ",                 // 'ᓋ'
+	0x14CC:  "ᓇ·",                 // 'ᓌ'
+	0x14CD:  "·ᓈ",                 // 'ᓍ'
+	0x14CE:  "ᓈ·",                 // 'ᓎ'
+	0x14D1:  "ᐡ",                  // 'ᓑ'
+	0x14DC:  "·ᓓ",                 // 'ᓜ'
+	0x14DD:  "ᓓ·",                 // 'ᓝ'
+	0x14DE:  "·ᓕ",                 // 'ᓞ'
+	0x14DF:  "ᓕ·",                 // 'ᓟ'
+	0x14E0:  "·ᓖ",                 // 'ᓠ'
+	0x14E1:  "ᓖ·",                 // 'ᓡ'
+	0x14E2:  "·ᓗ",                 // 'ᓢ'
+	0x14E3:  "ᓗ·",                 // 'ᓣ'
+	0x14E4:  "·ᓘ",                 // 'ᓤ'
+	0x14E5:  "ᓘ·",                 // 'ᓥ'
+	0x14E6:  "·ᓚ",                 // 'ᓦ'
+	0x14E7:  "ᓚ·",                 // 'ᓧ'
+	0x14E8:  "·ᓛ",                 // 'ᓨ'
+	0x14E9:  "ᓛ·",                 // 'ᓩ'
+	0x14F6:  "·ᓭ",                 // 'ᓶ'
+	0x14F7:  "ᓭ·",                 // 'ᓷ'
+	0x14F8:  "·ᓯ",                 // 'ᓸ'
+	0x14F9:  "ᓯ·",                 // 'ᓹ'
+	0x14FA:  "·ᓰ",                 // 'ᓺ'
+	0x14FB:  "ᓰ·",                 // 'ᓻ'
+	0x14FC:  "·ᓱ",                 // 'ᓼ'
+	0x14FD:  "ᓱ·",                 // 'ᓽ'
+	0x14FE:  "·ᓲ",                 // 'ᓾ'
+	0x14FF:  "ᓲ·",                 // 'ᓿ'
+	0x1500:  "·ᓴ",                 // 'ᔀ'
+	0x1501:  "ᓴ·",                 // 'ᔁ'
+	0x1502:  "·ᓵ",                 // 'ᔂ'
+	0x1503:  "ᓵ·",                 // 'ᔃ'
+	0x150C:  "ᔋ<",                 // 'ᔌ'
+	0x150D:  "ᔋᑕ",                 // 'ᔍ'
+	0x150E:  "ᔋb",                 // 'ᔎ'
+	0x150F:  "ᔋᒐ",                 // 'ᔏ'
+	0x1517:  "·ᔐ",                 // 'ᔗ'
+	0x1518:  "ᔐ·",                 // 'ᔘ'
+	0x1519:  "·ᔑ",                 // 'ᔙ'
+	0x151A:  "ᔑ·",                 // 'ᔚ'
+	0x151B:  "·ᔒ",                 // 'ᔛ'
+	0x151C:  "ᔒ·",                 // 'ᔜ'
+	0x151D:  "·ᔓ",                 // 'ᔝ'
+	0x151E:  "ᔓ·",                 // 'ᔞ'
+	0x151F:  "·ᔔ",                 // 'ᔟ'
+	0x1520:  "ᔔ·",                 // 'ᔠ'
+	0x1521:  "·ᔕ",                 // 'ᔡ'
+	0x1522:  "ᔕ·",                 // 'ᔢ'
+	0x1523:  "·ᔖ",                 // 'ᔣ'
+	0x1524:  "ᔖ·",                 // 'ᔤ'
+	0x152F:  "·4",                 // 'ᔯ'
+	0x1530:  "4·",                 // 'ᔰ'
+	0x1531:  "·ᔨ",                 // 'ᔱ'
+	0x1532:  "ᔨ·",                 // 'ᔲ'
+	0x1533:  "·ᔩ",                 // 'ᔳ'
+	0x1534:  "ᔩ·",                 // 'ᔴ'
+	0x1535:  "·ᔪ",                 // 'ᔵ'
+	0x1536:  "ᔪ·",                 // 'ᔶ'
+	0x1537:  "·ᔫ",                 // 'ᔷ'
+	0x1538:  "ᔫ·",                 // 'ᔸ'
+	0x1539:  "·ᔭ",                 // 'ᔹ'
+	0x153A:  "ᔭ·",                 // 'ᔺ'
+	0x153B:  "·ᔮ",                 // 'ᔻ'
+	0x153C:  "ᔮ·",                 // 'ᔼ'
+	0x1540:  "ᐩ",                  // 'ᕀ'
+	0x1541:  "x",                  // 'ᕁ'
+	0x154E:  "·ᕌ",                 // 'ᕎ'
+	0x154F:  "ᕌ·",                 // 'ᕏ'
+	0x155B:  "·ᕚ",                 // 'ᕛ'
+	0x155C:  "ᕚ·",                 // 'ᕜ'
+	0x1568:  "·ᕧ",                 // 'ᕨ'
+	0x1569:  "ᕧ·",                 // 'ᕩ'
+	0x1577:  "ẟ",                  // 'ᕷ'
+	0x157C:  "H",                  // 'ᕼ'
+	0x157D:  "x",                  // 'ᕽ'
+	0x157E:  "ᕐᑬ",                 // 'ᕾ'
+	0x157F:  "ᕐP",                 // 'ᕿ'
+	0x1580:  "ᕐᑮ",                 // 'ᖀ'
+	0x1581:  "ᕐd",                 // 'ᖁ'
+	0x1582:  "ᕐᑰ",                 // 'ᖂ'
+	0x1583:  "ᕐb",                 // 'ᖃ'
+	0x1584:  "ᕐḃ",                // 'ᖄ'
+	0x1585:  "ᕐᒃ",                 // 'ᖅ'
+	0x1587:  "R",                  // 'ᖇ'
+	0x158E:  "ᖕᒊ",                 // 'ᖎ'
+	0x158F:  "ᖕᒋ",                 // 'ᖏ'
+	0x1590:  "ᖕᒌ",                 // 'ᖐ'
+	0x1591:  "ᖕJ",                 // 'ᖑ'
+	0x1592:  "ᖕᒎ",                 // 'ᖒ'
+	0x1593:  "ᖕᒐ",                 // 'ᖓ'
+	0x1594:  "ᖕᒑ",                 // 'ᖔ'
+	0x15AF:  "b",                  // 'ᖯ'
+	0x15B4:  "F",                  // 'ᖴ'
+	0x15B5:  "Ⅎ",                  // 'ᖵ'
+	0x15B7:  "ꟻ",                  // 'ᖷ'
+	0x15C4:  "Ɐ",                  // 'ᗄ'
+	0x15C5:  "A",                  // 'ᗅ'
+	0x15DE:  "D",                  // 'ᗞ'
+	0x15EA:  "D",                  // 'ᗪ'
+	0x15EF:  "Ѡ",                  // 'ᗯ'
+	0x15F0:  "M",                  // 'ᗰ'
+	0x15F7:  "B",                  // 'ᗷ'
+	0x1602:  "ᒐ",                  // 'ᘂ'
+	0x1603:  "ᒉ",                  // 'ᘃ'
+	0x1604:  "ᓓ",                  // 'ᘄ'
+	0x1607:  "ᓚ",                  // 'ᘇ'
+	0x1622:  "ᕃ",                  // 'ᘢ'
+	0x1623:  "ᕆ",                  // 'ᘣ'
+	0x1624:  "ᕊ",                  // 'ᘤ'
+	0x162E:  "Ʊ",                  // 'ᘮ'
+	0x162F:  "Ω",                  // 'ᘯ'
+	0x1634:  "Ʊ",                  // 'ᘴ'
+	0x1635:  "Ω",                  // 'ᘵ'
+	0x166D:  "X",                  // '᙭'
+	0x166E:  "x",                  // '᙮'
+	0x166F:  "ᕐᑫ",                 // 'ᙯ'
+	0x1670:  "ᖕᒉ",                 // 'ᙰ'
+	0x1671:  "ᖖᒋ",                 // 'ᙱ'
+	0x1672:  "ᖖᒌ",                 // 'ᙲ'
+	0x1673:  "ᖖJ",                 // 'ᙳ'
+	0x1674:  "ᖖᒎ",                 // 'ᙴ'
+	0x1675:  "ᖖᒐ",                 // 'ᙵ'
+	0x1676:  "ᖖᒑ",                 // 'ᙶ'
+	0x1677:  "ᖧ·",                 // 'ᙷ'
+	0x1678:  "ᖨ·",                 // 'ᙸ'
+	0x1679:  "ᖩ·",                 // 'ᙹ'
+	0x167A:  "ᖪ·",                 // 'ᙺ'
+	0x167B:  "ᖫ·",                 // 'ᙻ'
+	0x167C:  "ᖬ·",                 // 'ᙼ'
+	0x167D:  "ᖭ·",                 // 'ᙽ'
+	0x1680:  " ",                  // '\u1680'
+	0x16B2:  "<",                  // 'ᚲ'
+	0x16B7:  "X",                  // 'ᚷ'
+	0x16C1:  "l",                  // 'ᛁ'
+	0x16C2:  "ᚽ",                  // 'ᛂ'
+	0x16CC:  "'",                  // 'ᛌ'
+	0x16D5:  "K",                  // 'ᛕ'
+	0x16D6:  "M",                  // 'ᛖ'
+	0x16D8:  "Ψ",                  // 'ᛘ'
+	0x16E1:  "ᚼ",                  // 'ᛡ'
+	0x16EB:  "·",                  // '᛫'
+	0x16EC:  ":",                  // '᛬'
+	0x16ED:  "+",                  // '᛭'
+	0x16F0:  "Φ",                  // 'ᛰ'
+	0x1735:  "/",                  // '᜵'
+	0x17A3:  "អ",                  // 'ឣ'
+	0x17B7:  "ิ",                  // 'ិ'
+	0x17B8:  "ี",                  // 'ី'
+	0x17B9:  "ึ",                  // 'ឹ'
+	0x17BA:  "ื",                  // 'ឺ'
+	0x17C6:  "̊",                  // 'ំ'
+	0x17CB:  "่",                  // '់'
+	0x17D3:  "̊",                  // '៓'
+	0x17D4:  "ฯ",                  // '។'
+	0x17D5:  "๚",                  // '៕'
+	0x17D9:  "๏",                  // '៙'
+	0x17DA:  "๛",                  // '៚'
+	0x1803:  ":",                  // '᠃'
+	0x1809:  ":",                  // '᠉'
+	0x1855:  "ᠵ",                  // 'ᡕ'
+	0x1896:  "ᡜ",                  // 'ᢖ'
+	0x18B3:  "·ᢱ",                 // 'ᢳ'
+	0x18B6:  "·ᢴ",                 // 'ᢶ'
+	0x18B9:  "·ᢸ",                 // 'ᢹ'
+	0x18C2:  "·ᣀ",                 // 'ᣂ'
+	0x18C6:  "·ᓂ",                 // 'ᣆ'
+	0x18C7:  "ᓂ·",                 // 'ᣇ'
+	0x18C8:  "·ᓃ",                 // 'ᣈ'
+	0x18C9:  "ᓃ·",                 // 'ᣉ'
+	0x18CA:  "·ᓄ",                 // 'ᣊ'
+	0x18CB:  "ᓄ·",                 // 'ᣋ'
+	0x18CC:  "·ᓅ",                 // 'ᣌ'
+	0x18CD:  "ᓅ·",                 // 'ᣍ'
+	0x18CE:  "·ᕃ",                 // 'ᣎ'
+	0x18CF:  "·ᕆ",                 // 'ᣏ'
+	0x18D0:  "·ᕇ",                 // 'ᣐ'
+	0x18D1:  "·ᕈ",                 // 'ᣑ'
+	0x18D2:  "·ᕉ",                 // 'ᣒ'
+	0x18D3:  "·ᕋ",                 // 'ᣓ'
+	0x18DB:  "ᣵ",                  // 'ᣛ'
+	0x18DC:  "ᣟᐞ",                 // 'ᣜ'
+	0x18DD:  "ᐞᣟ",                 // 'ᣝ'
+	0x18E0:  "ᕃ·",                 // 'ᣠ'
+	0x18E3:  "ᕞ·",                 // 'ᣣ'
+	0x18E4:  "ᕦ·",                 // 'ᣤ'
+	0x18E5:  "ᕫ·",                 // 'ᣥ'
+	0x18E8:  "ᖆ·",                 // 'ᣨ'
+	0x18EA:  "ᖗ·",                 // 'ᣪ'
+	0x18ED:  "Ѡ·",                 // 'ᣭ'
+	0x18F0:  "ᗴ·",                 // 'ᣰ'
+	0x18F2:  "ᘛ·",                 // 'ᣲ'
+	0x19D0:  "ᦞ",                  // '᧐'
+	0x19D1:  "ᦱ",                  // '᧑'
+	0x1A80:  "ᩅ",                  // '᪀'
+	0x1A90:  "ᩅ",                  // '᪐'
+	0x1AA9:  "᪨᪨",                 // '᪩'
+	0x1AAB:  "᪪᪨",                 // '᪫'
+	0x1AB4:  "ۛ",                  // '᪴'
+	0x1AB7:  "̨",                  // '᪷'
+	0x1B52:  "ᬍ",                  // '᭒'
+	0x1B53:  "ᬑ",                  // '᭓'
+	0x1B58:  "ᬨ",                  // '᭘'
+	0x1B5C:  "᭐",                  // '᭜'
+	0x1B5F:  "᭞᭞",                 // '᭟'
+	0x1C3C:  "᰻᰻",                 // '᰼'
+	0x1C7F:  "᱾᱾",                 // '᱿'
+	0x1CD0:  "̂",                  // '᳐'
+	0x1CD2:  "̄",                  // '᳒'
+	0x1CD3:  "''",                 // '᳓'
+	0x1CD5:  "̫",                  // '᳕'
+	0x1CD8:  "̮",                  // '᳘'
+	0x1CD9:  "̭",                  // '᳙'
+	0x1CDA:  "̎",                  // '᳚'
+	0x1CDC:  "̩",                  // '᳜'
+	0x1CDD:  "̣",                  // '᳝'
+	0x1CDE:  "̤",                  // '᳞'
+	0x1CED:  "̖",                  // '᳭'
+	0x1D04:  "c",                  // 'ᴄ'
+	0x1D08:  "ɜ",                  // 'ᴈ'
+	0x1D0B:  "ĸ",                  // 'ᴋ'
+	0x1D0D:  "ʍ",                  // 'ᴍ'
+	0x1D0F:  "o",                  // 'ᴏ'
+	0x1D10:  "ɔ",                  // 'ᴐ'
+	0x1D11:  "o",                  // 'ᴑ'
+	0x1D14:  "ǝo",                 // 'ᴔ'
+	0x1D1C:  "u",                  // 'ᴜ'
+	0x1D20:  "v",                  // 'ᴠ'
+	0x1D21:  "w",                  // 'ᴡ'
+	0x1D22:  "z",                  // 'ᴢ'
+	0x1D24:  "ƨ",                  // 'ᴤ'
+	0x1D26:  "r",                  // 'ᴦ'
+	0x1D27:  "ʌ",                  // 'ᴧ'
+	0x1D28:  "π",                  // 'ᴨ'
+	0x1D29:  "ᴘ",                  // 'ᴩ'
+	0x1D2B:  "л",                  // 'ᴫ'
+	0x1D3E:  "ᣖ",                  // 'ᴾ'
+	0x1D52:  "º",                  // 'ᵒ'
+	0x1D6B:  "ue",                 // 'ᵫ'
+	0x1D6E:  "f̴",                 // 'ᵮ'
+	0x1D6F:  "rn̴",                // 'ᵯ'
+	0x1D70:  "n̴",                 // 'ᵰ'
+	0x1D72:  "r̴",                 // 'ᵲ'
+	0x1D73:  "ɾ̴",                 // 'ᵳ'
+	0x1D74:  "s̴",                 // 'ᵴ'
+	0x1D75:  "t̴",                 // 'ᵵ'
+	0x1D76:  "z̴",                 // 'ᵶ'
+	0x1D78:  "ᴴ",                  // 'ᵸ'
+	0x1D7B:  "i̵",                 // 'ᵻ'
+	0x1D7C:  "i̵",                 // 'ᵼ'
+	0x1D7D:  "p̵",                 // 'ᵽ'
+	0x1D7E:  "u̵",                 // 'ᵾ'
+	0x1D7F:  "ʊ̵",                 // 'ᵿ'
+	0x1D83:  "g",                  // 'ᶃ'
+	0x1D8C:  "y",                  // 'ᶌ'
+	0x1D90:  "ɋ",                  // 'ᶐ'
+	0x1D9F:  "ᵋ",                  // 'ᶟ'
+	0x1DA2:  "ᵍ",                  // 'ᶢ'
+	0x1DBA:  "ᣔ",                  // 'ᶺ'
+	0x1DBB:  "ᙆ",                  // 'ᶻ'
+	0x1DEE:  "ⷬ",                  // 'ᷮ'
+	0x1E43:  "ꭑ",                  // 'ṃ'
+	0x1E9A:  "ả",                  // 'ẚ'
+	0x1E9D:  "f",                  // 'ẝ'
+	0x1EFF:  "y",                  // 'ỿ'
+	0x1F7D:  "ῴ",                  // 'ώ'
+	0x1FBD:  "'",                  // '᾽'
+	0x1FBE:  "i",                  // 'ι'
+	0x1FBF:  "'",                  // '᾿'
+	0x1FC0:  "~",                  // '῀'
+	0x1FEF:  "'",                  // '`'
+	0x1FF6:  "Ꮿ",                  // 'ῶ'
+	0x1FFD:  "'",                  // '´'
+	0x1FFE:  "'",                  // '῾'
+	0x2000:  " ",                  // '\u2000'
+	0x2001:  " ",                  // '\u2001'
+	0x2002:  " ",                  // '\u2002'
+	0x2003:  " ",                  // '\u2003'
+	0x2004:  " ",                  // '\u2004'
+	0x2005:  " ",                  // '\u2005'
+	0x2006:  " ",                  // '\u2006'
+	0x2007:  " ",                  // '\u2007'
+	0x2008:  " ",                  // '\u2008'
+	0x2009:  " ",                  // '\u2009'
+	0x200A:  " ",                  // '\u200a'
+	0x2010:  "-",                  // '‐'
+	0x2011:  "-",                  // '‑'
+	0x2012:  "-",                  // '‒'
+	0x2013:  "-",                  // '–'
+	0x2014:  "ー",                  // '—'
+	0x2015:  "ー",                  // '―'
+	0x2016:  "ll",                 // '‖'
+	0x2018:  "'",                  // '‘'
+	0x2019:  "'",                  // '’'
+	0x201A:  ",",                  // '‚'
+	0x201B:  "'",                  // '‛'
+	0x201C:  "''",                 // '“'
+	0x201D:  "''",                 // '”'
+	0x201F:  "''",                 // '‟'
+	0x2022:  "·",                  // '•'
+	0x2024:  ".",                  // '․'
+	0x2025:  "..",                 // '‥'
+	0x2026:  "...",                // '…'
+	0x2027:  "·",                  // '‧'
+	0x2028:  " ",                  // '\u2028'
+	0x2029:  " ",                  // '\u2029'
+	0x202F:  " ",                  // '\u202f'
+	0x2030:  "º/₀₀",               // '‰'
+	0x2031:  "º/₀₀₀",              // '‱'
+	0x2032:  "'",                  // '′'
+	0x2033:  "''",                 // '″'
+	0x2034:  "'''",                // '‴'
+	0x2035:  "'",                  // '‵'
+	0x2036:  "''",                 // '‶'
+	0x2037:  "'''",                // '‷'
+	0x2039:  "<",                  // '‹'
+	0x203A:  ">",                  // '›'
+	0x203C:  "!!",                 // '‼'
+	0x203E:  "ˉ",                  // '‾'
+	0x2041:  "/",                  // '⁁'
+	0x2043:  "-",                  // '⁃'
+	0x2044:  "/",                  // '⁄'
+	0x2047:  "??",                 // '⁇'
+	0x2048:  "?!",                 // '⁈'
+	0x2049:  "!?",                 // '⁉'
+	0x204E:  "*",                  // '⁎'
+	0x2052:  "º/₀",                // '⁒'
+	0x2053:  "~",                  // '⁓'
+	0x2057:  "''''",               // '⁗'
+	0x205A:  ":",                  // '⁚'
+	0x205D:  "ⵗ",                  // '⁝'
+	0x205E:  "ⵂ",                  // '⁞'
+	0x205F:  " ",                  // '\u205f'
+	0x2070:  "º",                  // '⁰'
+	0x2079:  "ꝰ",                  // '⁹'
+	0x20A1:  "C⃫",                 // '₡'
+	0x20A4:  "£",                  // '₤'
+	0x20A5:  "rn̸",                // '₥'
+	0x20A8:  "Rs",                 // '₨'
+	0x20A9:  "W̵",                 // '₩'
+	0x20AB:  "ḏ̵",                // '₫'
+	0x20AC:  "Ꞓ",                  // '€'
+	0x20AD:  "K̵",                 // '₭'
+	0x20AE:  "T⃫",                 // '₮'
+	0x20B6:  "lt",                 // '₶'
+	0x20BD:  "Ք",                  // '₽'
+	0x20DB:  "ۛ",                  // '⃛'
+	0x2100:  "a/c",                // '℀'
+	0x2101:  "a/s",                // '℁'
+	0x2102:  "C",                  // 'ℂ'
+	0x2103:  "°C",                 // '℃'
+	0x2105:  "c/o",                // '℅'
+	0x2106:  "c/u",                // '℆'
+	0x2107:  "Ɛ",                  // 'ℇ'
+	0x2108:  "Э",                  // '℈'
+	0x2109:  "°F",                 // '℉'
+	0x210A:  "g",                  // 'ℊ'
+	0x210B:  "H",                  // 'ℋ'
+	0x210C:  "H",                  // 'ℌ'
+	0x210D:  "H",                  // 'ℍ'
+	0x210E:  "h",                  // 'ℎ'
+	0x210F:  "h̵",                 // 'ℏ'
+	0x2110:  "l",                  // 'ℐ'
+	0x2111:  "l",                  // 'ℑ'
+	0x2112:  "L",                  // 'ℒ'
+	0x2113:  "l",                  // 'ℓ'
+	0x2115:  "N",                  // 'ℕ'
+	0x2116:  "No",                 // '№'
+	0x2119:  "P",                  // 'ℙ'
+	0x211A:  "Q",                  // 'ℚ'
+	0x211B:  "R",                  // 'ℛ'
+	0x211C:  "R",                  // 'ℜ'
+	0x211D:  "R",                  // 'ℝ'
+	0x2121:  "TEL",                // '℡'
+	0x2124:  "Z",                  // 'ℤ'
+	0x2126:  "Ω",                  // 'Ω'
+	0x2127:  "Ʊ",                  // '℧'
+	0x2128:  "Z",                  // 'ℨ'
+	0x2129:  "ɿ",                  // '℩'
+	0x212A:  "K",                  // 'K'
+	0x212C:  "B",                  // 'ℬ'
+	0x212D:  "C",                  // 'ℭ'
+	0x212E:  "e",                  // '℮'
+	0x212F:  "e",                  // 'ℯ'
+	0x2130:  "E",                  // 'ℰ'
+	0x2131:  "F",                  // 'ℱ'
+	0x2133:  "M",                  // 'ℳ'
+	0x2134:  "o",                  // 'ℴ'
+	0x2135:  "א",                  // 'ℵ'
+	0x2136:  "ב",                  // 'ℶ'
+	0x2137:  "ג",                  // 'ℷ'
+	0x2138:  "ד",                  // 'ℸ'
+	0x2139:  "i",                  // 'ℹ'
+	0x213B:  "FAX",                // '℻'
+	0x213C:  "π",                  // 'ℼ'
+	0x213D:  "y",                  // 'ℽ'
+	0x213E:  "Γ",                  // 'ℾ'
+	0x213F:  "Π",                  // 'ℿ'
+	0x2140:  "Ʃ",                  // '⅀'
+	0x2141:  "ꓨ",                  // '⅁'
+	0x2142:  "ꓶ",                  // '⅂'
+	0x2143:  "𖼀",                  // '⅃'
+	0x2145:  "D",                  // 'ⅅ'
+	0x2146:  "d",                  // 'ⅆ'
+	0x2147:  "e",                  // 'ⅇ'
+	0x2148:  "i",                  // 'ⅈ'
+	0x2149:  "j",                  // 'ⅉ'
+	0x2160:  "l",                  // 'Ⅰ'
+	0x2161:  "ll",                 // 'Ⅱ'
+	0x2162:  "lll",                // 'Ⅲ'
+	0x2163:  "lV",                 // 'Ⅳ'
+	0x2164:  "V",                  // 'Ⅴ'
+	0x2165:  "Vl",                 // 'Ⅵ'
+	0x2166:  "Vll",                // 'Ⅶ'
+	0x2167:  "Vlll",               // 'Ⅷ'
+	0x2168:  "lX",                 // 'Ⅸ'
+	0x2169:  "X",                  // 'Ⅹ'
+	0x216A:  "Xl",                 // 'Ⅺ'
+	0x216B:  "Xll",                // 'Ⅻ'
+	0x216C:  "L",                  // 'Ⅼ'
+	0x216D:  "C",                  // 'Ⅽ'
+	0x216E:  "D",                  // 'Ⅾ'
+	0x216F:  "M",                  // 'Ⅿ'
+	0x2170:  "i",                  // 'ⅰ'
+	0x2171:  "ii",                 // 'ⅱ'
+	0x2172:  "iii",                // 'ⅲ'
+	0x2173:  "iv",                 // 'ⅳ'
+	0x2174:  "v",                  // 'ⅴ'
+	0x2175:  "vi",                 // 'ⅵ'
+	0x2176:  "vii",                // 'ⅶ'
+	0x2177:  "viii",               // 'ⅷ'
+	0x2178:  "ix",                 // 'ⅸ'
+	0x2179:  "x",                  // 'ⅹ'
+	0x217A:  "xi",                 // 'ⅺ'
+	0x217B:  "xii",                // 'ⅻ'
+	0x217C:  "l",                  // 'ⅼ'
+	0x217D:  "c",                  // 'ⅽ'
+	0x217E:  "d",                  // 'ⅾ'
+	0x217F:  "rn",                 // 'ⅿ'
+	0x2183:  "Ɔ",                  // 'Ↄ'
+	0x2184:  "ɔ",                  // 'ↄ'
+	0x2191:  "ᛏ",                  // '↑'
+	0x2195:  "ᛨ",                  // '↕'
+	0x21B5:  "↲",                  // '↵'
+	0x21BA:  "🄎",                  // '↺'
+	0x21BE:  "ᛚ",                  // '↾'
+	0x21BF:  "ᛐ",                  // '↿'
+	0x2200:  "Ɐ",                  // '∀'
+	0x2203:  "Ǝ",                  // '∃'
+	0x2206:  "Δ",                  // '∆'
+	0x220F:  "Π",                  // '∏'
+	0x2211:  "Ʃ",                  // '∑'
+	0x2212:  "-",                  // '−'
+	0x2214:  "+̇",                 // '∔'
+	0x2215:  "/",                  // '∕'
+	0x2216:  "\\",                 // '∖'
+	0x2217:  "*",                  // '∗'
+	0x2218:  "°",                  // '∘'
+	0x2219:  "·",                  // '∙'
+	0x221E:  "oo",                 // '∞'
+	0x2223:  "l",                  // '∣'
+	0x2225:  "ll",                 // '∥'
+	0x2228:  "v",                  // '∨'
+	0x2229:  "Ո",                  // '∩'
+	0x222A:  "U",                  // '∪'
+	0x222B:  "ʃ",                  // '∫'
+	0x222C:  "ʃʃ",                 // '∬'
+	0x222D:  "ʃʃʃ",                // '∭'
+	0x222F:  "∮∮",                 // '∯'
+	0x2230:  "∮∮∮",                // '∰'
+	0x2236:  ":",                  // '∶'
+	0x2238:  "-̇",                 // '∸'
+	0x223C:  "~",                  // '∼'
+	0x2250:  "=̇",                 // '≐'
+	0x2251:  "=̣̇",                // '≑'
+	0x2257:  "=̊",                 // '≗'
+	0x2259:  "=̂",                 // '≙'
+	0x225A:  "=̆",                 // '≚'
+	0x225E:  "=ͫ",                 // '≞'
+	0x2263:  "≡",                  // '≣'
+	0x226A:  "<<",                 // '≪'
+	0x226B:  ">>",                 // '≫'
+	0x2282:  "ᑕ",                  // '⊂'
+	0x2283:  "ᑐ",                  // '⊃'
+	0x2295:  "𐊨",                  // '⊕'
+	0x2296:  "O̵",                 // '⊖'
+	0x2299:  "ʘ",                  // '⊙'
+	0x229D:  "O̵",                 // '⊝'
+	0x22A4:  "T",                  // '⊤'
+	0x22A5:  "ꓕ",                  // '⊥'
+	0x22C0:  "∧",                  // '⋀'
+	0x22C1:  "v",                  // '⋁'
+	0x22C2:  "Ո",                  // '⋂'
+	0x22C3:  "U",                  // '⋃'
+	0x22C4:  "ᛜ",                  // '⋄'
+	0x22C5:  "·",                  // '⋅'
+	0x22C8:  "ᛞ",                  // '⋈'
+	0x22D6:  "<·",                 // '⋖'
+	0x22D7:  "·>",                 // '⋗'
+	0x22D8:  "<<<",                // '⋘'
+	0x22D9:  ">>>",                // '⋙'
+	0x22EE:  "ⵗ",                  // '⋮'
+	0x22EF:  "···",                // '⋯'
+	0x22F4:  "ꞓ",                  // '⋴'
+	0x22FF:  "E",                  // '⋿'
+	0x2300:  "∅",                  // '⌀'
+	0x2325:  "⌤",                  // '⌥'
+	0x2329:  "❬",                  // '〈'
+	0x232A:  "❭",                  // '〉'
+	0x2341:  "〼",                  // '⍁'
+	0x2359:  "Δ̲",                 // '⍙'
+	0x235A:  "ᛜ̲",                 // '⍚'
+	0x235C:  "°̲",                 // '⍜'
+	0x235F:  "⊛",                  // '⍟'
+	0x2361:  "T̈",                 // '⍡'
+	0x2362:  "∇̈",                 // '⍢'
+	0x2363:  "⋆̈",                 // '⍣'
+	0x2364:  "°̈",                 // '⍤'
+	0x2365:  "ة",                  // '⍥'
+	0x2368:  "~̈",                 // '⍨'
+	0x2369:  "ᐵ",                  // '⍩'
+	0x236B:  "∇̴",                 // '⍫'
+	0x236C:  "O̵",                 // '⍬'
+	0x2373:  "i",                  // '⍳'
+	0x2374:  "p",                  // '⍴'
+	0x2375:  "ω",                  // '⍵'
+	0x2376:  "a̲",                 // '⍶'
+	0x2377:  "ꞓ̲",                 // '⍷'
+	0x2378:  "i̲",                 // '⍸'
+	0x2379:  "ω̲",                 // '⍹'
+	0x237A:  "a",                  // '⍺'
+	0x237F:  "ᚽ",                  // '⍿'
+	0x239C:  "丨",                  // '⎜'
+	0x239F:  "丨",                  // '⎟'
+	0x23A2:  "丨",                  // '⎢'
+	0x23A5:  "丨",                  // '⎥'
+	0x23AA:  "丨",                  // '⎪'
+	0x23AE:  "丨",                  // '⎮'
+	0x23C1:  "⍕",                  // '⏁'
+	0x23C2:  "⍎",                  // '⏂'
+	0x23C3:  "⍋",                  // '⏃'
+	0x23C6:  "⍭",                  // '⏆'
+	0x23E8:  "₁₀",                 // '⏨'
+	0x23FC:  "⏻",                  // '⏼'
+	0x23FD:  "l",                  // '⏽'
+	0x23FE:  "☾",                  // '⏾'
+	0x244A:  "\\\\",               // '⑊'
+	0x2460:  "➀",                  // '①'
+	0x2461:  "➁",                  // '②'
+	0x2462:  "➂",                  // '③'
+	0x2463:  "➃",                  // '④'
+	0x2464:  "➄",                  // '⑤'
+	0x2465:  "➅",                  // '⑥'
+	0x2466:  "➆",                  // '⑦'
+	0x2467:  "➇",                  // '⑧'
+	0x2468:  "➈",                  // '⑨'
+	0x2469:  "➉",                  // '⑩'
+	0x2474:  "(l)",                // '⑴'
+	0x2475:  "(2)",                // '⑵'
+	0x2476:  "(3)",                // '⑶'
+	0x2477:  "(4)",                // '⑷'
+	0x2478:  "(5)",                // '⑸'
+	0x2479:  "(6)",                // '⑹'
+	0x247A:  "(7)",                // '⑺'
+	0x247B:  "(8)",                // '⑻'
+	0x247C:  "(9)",                // '⑼'
+	0x247D:  "(lO)",               // '⑽'
+	0x247E:  "(ll)",               // '⑾'
+	0x247F:  "(l2)",               // '⑿'
+	0x2480:  "(l3)",               // '⒀'
+	0x2481:  "(l4)",               // '⒁'
+	0x2482:  "(l5)",               // '⒂'
+	0x2483:  "(l6)",               // '⒃'
+	0x2484:  "(l7)",               // '⒄'
+	0x2485:  "(l8)",               // '⒅'
+	0x2486:  "(l9)",               // '⒆'
+	0x2487:  "(2O)",               // '⒇'
+	0x2488:  "l.",                 // '⒈'
+	0x2489:  "2.",                 // '⒉'
+	0x248A:  "3.",                 // '⒊'
+	0x248B:  "4.",                 // '⒋'
+	0x248C:  "5.",                 // '⒌'
+	0x248D:  "6.",                 // '⒍'
+	0x248E:  "7.",                 // '⒎'
+	0x248F:  "8.",                 // '⒏'
+	0x2490:  "9.",                 // '⒐'
+	0x2491:  "lO.",                // '⒑'
+	0x2492:  "ll.",                // '⒒'
+	0x2493:  "l2.",                // '⒓'
+	0x2494:  "l3.",                // '⒔'
+	0x2495:  "l4.",                // '⒕'
+	0x2496:  "l5.",                // '⒖'
+	0x2497:  "l6.",                // '⒗'
+	0x2498:  "l7.",                // '⒘'
+	0x2499:  "l8.",                // '⒙'
+	0x249A:  "l9.",                // '⒚'
+	0x249B:  "2O.",                // '⒛'
+	0x249C:  "(a)",                // '⒜'
+	0x249D:  "(b)",                // '⒝'
+	0x249E:  "(c)",                // '⒞'
+	0x249F:  "(d)",                // '⒟'
+	0x24A0:  "(e)",                // '⒠'
+	0x24A1:  "(f)",                // '⒡'
+	0x24A2:  "(g)",                // '⒢'
+	0x24A3:  "(h)",                // '⒣'
+	0x24A4:  "(i)",                // '⒤'
+	0x24A5:  "(j)",                // '⒥'
+	0x24A6:  "(k)",                // '⒦'
+	0x24A7:  "(l)",                // '⒧'
+	0x24A8:  "(rn)",               // '⒨'
+	0x24A9:  "(n)",                // '⒩'
+	0x24AA:  "(o)",                // '⒪'
+	0x24AB:  "(p)",                // '⒫'
+	0x24AC:  "(q)",                // '⒬'
+	0x24AD:  "(r)",                // '⒭'
+	0x24AE:  "(s)",                // '⒮'
+	0x24AF:  "(t)",                // '⒯'
+	0x24B0:  "(u)",                // '⒰'
+	0x24B1:  "(v)",                // '⒱'
+	0x24B2:  "(w)",                // '⒲'
+	0x24B3:  "(x)",                // '⒳'
+	0x24B4:  "(y)",                // '⒴'
+	0x24B5:  "(z)",                // '⒵'
+	0x24B8:  "©",                  // 'Ⓒ'
+	0x24C5:  "℗",                  // 'Ⓟ'
+	0x24C7:  "®",                  // 'Ⓡ'
+	0x24DB:  "Ⓘ",                  // 'ⓛ'
+	0x24EA:  "🄍",                  // '⓪'
+	0x2500:  "ー",                  // '─'
+	0x2501:  "ー",                  // '━'
+	0x2503:  "│",                  // '┃'
+	0x250F:  "┌",                  // '┏'
+	0x2523:  "├",                  // '┣'
+	0x2571:  "/",                  // '╱'
+	0x2573:  "X",                  // '╳'
+	0x2588:  "∎",                  // '█'
+	0x2590:  "▌",                  // '▐'
+	0x2594:  "ˉ",                  // '▔'
+	0x2597:  "▖",                  // '▗'
+	0x259D:  "▘",                  // '▝'
+	0x25A0:  "∎",                  // '■'
+	0x25B1:  "⏥",                  // '▱'
+	0x25B3:  "Δ",                  // '△'
+	0x25B7:  "⊳",                  // '▷'
+	0x25B8:  "▶",                  // '▸'
+	0x25BA:  "▶",                  // '►'
+	0x25BD:  "𐊼",                  // '▽'
+	0x25C1:  "⊲",                  // '◁'
+	0x25C7:  "ᛜ",                  // '◇'
+	0x25CA:  "ᛜ",                  // '◊'
+	0x25CB:  "°",                  // '○'
+	0x25CE:  "⌾",                  // '◎'
+	0x25E0:  "⌒",                  // '◠'
+	0x25E6:  "°",                  // '◦'
+	0x2609:  "ʘ",                  // '☉'
+	0x2610:  "□",                  // '☐'
+	0x2625:  "𐦞",                  // '☥'
+	0x2630:  "Ⲷ",                  // '☰'
+	0x2638:  "⎈",                  // '☸'
+	0x264E:  "≏",                  // '♎'
+	0x2662:  "ᛜ",                  // '♢'
+	0x2669:  "𝅘𝅥",                 // '♩'
+	0x266A:  "𝅘𝅥𝅮",                // '♪'
+	0x26AC:  "॰",                  // '⚬'
+	0x2768:  "(",                  // '❨'
+	0x2769:  ")",                  // '❩'
+	0x276E:  "<",                  // '❮'
+	0x276F:  ">",                  // '❯'
+	0x2772:  "(",                  // '❲'
+	0x2773:  ")",                  // '❳'
+	0x2774:  "{",                  // '❴'
+	0x2775:  "}",                  // '❵'
+	0x2795:  "+",                  // '➕'
+	0x2796:  "-",                  // '➖'
+	0x2797:  "÷",                  // '➗'
+	0x27C2:  "ꓕ",                  // '⟂'
+	0x27C8:  "\\ᑕ",                // '⟈'
+	0x27C9:  "ᑐ/",                 // '⟉'
+	0x27CB:  "/",                  // '⟋'
+	0x27CD:  "\\",                 // '⟍'
+	0x27D9:  "T",                  // '⟙'
+	0x27E8:  "❬",                  // '⟨'
+	0x27E9:  "❭",                  // '⟩'
+	0x292B:  "x",                  // '⤫'
+	0x292C:  "x",                  // '⤬'
+	0x2963:  "ᛐᛚ",                 // '⥣'
+	0x2965:  "⇃⇂",                 // '⥥'
+	0x296E:  "ᛐ⇂",                 // '⥮'
+	0x296F:  "⇃ᛚ",                 // '⥯'
+	0x2999:  "ⵂ",                  // '⦙'
+	0x29B0:  "⍉",                  // '⦰'
+	0x29BE:  "⌾",                  // '⦾'
+	0x29C4:  "〼",                  // '⧄'
+	0x29C5:  "⍂",                  // '⧅'
+	0x29C7:  "⌻",                  // '⧇'
+	0x29D6:  "𐋀",                  // '⧖'
+	0x29D9:  "⦚",                  // '⧙'
+	0x29F4:  ":→",                 // '⧴'
+	0x29F5:  "\\",                 // '⧵'
+	0x29F6:  "/̄",                 // '⧶'
+	0x29F8:  "/",                  // '⧸'
+	0x29F9:  "\\",                 // '⧹'
+	0x2A00:  "ʘ",                  // '⨀'
+	0x2A01:  "𐊨",                  // '⨁'
+	0x2A02:  "⊗",                  // '⨂'
+	0x2A03:  "⊍",                  // '⨃'
+	0x2A04:  "⊎",                  // '⨄'
+	0x2A05:  "⊓",                  // '⨅'
+	0x2A06:  "⊔",                  // '⨆'
+	0x2A0C:  "ʃʃʃʃ",               // '⨌'
+	0x2A1D:  "ᛞ",                  // '⨝'
+	0x2A20:  ">>",                 // '⨠'
+	0x2A21:  "ᛚ",                  // '⨡'
+	0x2A22:  "+̊",                 // '⨢'
+	0x2A23:  "+̂",                 // '⨣'
+	0x2A24:  "+̃",                 // '⨤'
+	0x2A25:  "+̣",                 // '⨥'
+	0x2A26:  "+̰",                 // '⨦'
+	0x2A27:  "+₂",                 // '⨧'
+	0x2A29:  "-̓",                 // '⨩'
+	0x2A2A:  "-̣",                 // '⨪'
+	0x2A2F:  "x",                  // '⨯'
+	0x2A30:  "ẋ",                 // '⨰'
+	0x2A3D:  "⌙",                  // '⨽'
+	0x2A3E:  "⨟",                  // '⨾'
+	0x2A3F:  "∐",                  // '⨿'
+	0x2A6A:  "~̇",                 // '⩪'
+	0x2A6E:  "=⃰",                 // '⩮'
+	0x2A74:  "::=",                // '⩴'
+	0x2A75:  "==",                 // '⩵'
+	0x2A76:  "===",                // '⩶'
+	0x2AA5:  "><",                 // '⪥'
+	0x2AAA:  "ᗕ",                  // '⪪'
+	0x2AAB:  "ᗒ",                  // '⪫'
+	0x2AD7:  "ᑐᑕ",                 // '⫗'
+	0x2AFB:  "///",                // '⫻'
+	0x2AFD:  "//",                 // '⫽'
+	0x2BEC:  "↞",                  // '⯬'
+	0x2BED:  "↟",                  // '⯭'
+	0x2BEE:  "↠",                  // '⯮'
+	0x2BEF:  "↡",                  // '⯯'
+	0x2C67:  "H̩",                 // 'Ⱨ'
+	0x2C69:  "K̩",                 // 'Ⱪ'
+	0x2C84:  "Γ",                  // 'Ⲅ'
+	0x2C85:  "r",                  // 'ⲅ'
+	0x2C86:  "Δ",                  // 'Ⲇ'
+	0x2C88:  "Ꞓ",                  // 'Ⲉ'
+	0x2C89:  "ꞓ",                  // 'ⲉ'
+	0x2C8E:  "H",                  // 'Ⲏ'
+	0x2C92:  "l",                  // 'Ⲓ'
+	0x2C94:  "K",                  // 'Ⲕ'
+	0x2C95:  "ĸ",                  // 'ⲕ'
+	0x2C96:  "λ",                  // 'Ⲗ'
+	0x2C98:  "M",                  // 'Ⲙ'
+	0x2C9A:  "N",                  // 'Ⲛ'
+	0x2C9E:  "O",                  // 'Ⲟ'
+	0x2C9F:  "o",                  // 'ⲟ'
+	0x2CA0:  "Π",                  // 'Ⲡ'
+	0x2CA2:  "P",                  // 'Ⲣ'
+	0x2CA3:  "p",                  // 'ⲣ'
+	0x2CA4:  "C",                  // 'Ⲥ'
+	0x2CA5:  "c",                  // 'ⲥ'
+	0x2CA6:  "T",                  // 'Ⲧ'
+	0x2CA8:  "Y",                  // 'Ⲩ'
+	0x2CAA:  "Φ",                  // 'Ⲫ'
+	0x2CAB:  "ɸ",                  // 'ⲫ'
+	0x2CAC:  "X",                  // 'Ⲭ'
+	0x2CAD:  "χ",                  // 'ⲭ'
+	0x2CAE:  "Ψ",                  // 'Ⲯ'
+	0x2CB1:  "ω",                  // 'ⲱ'
+	0x2CB4:  "<·",                 // 'Ⲵ'
+	0x2CBA:  "-",                  // 'Ⲻ'
+	0x2CBC:  "Ш",                  // 'Ⲽ'
+	0x2CBD:  "ш",                  // 'ⲽ'
+	0x2CC6:  "/",                  // 'Ⳇ'
+	0x2CCA:  "9",                  // 'Ⳋ'
+	0x2CCC:  "3",                  // 'Ⳍ'
+	0x2CCD:  "ȝ",                  // 'ⳍ'
+	0x2CD0:  "L",                  // 'Ⳑ'
+	0x2CD1:  "ʟ",                  // 'ⳑ'
+	0x2CD2:  "6",                  // 'Ⳓ'
+	0x2CDC:  "Ϭ",                  // 'Ⳝ'
+	0x2CE4:  "ϗ",                  // 'ⳤ'
+	0x2CE9:  "☧",                  // '⳩'
+	0x2CF9:  "\\\\",               // '⳹'
+	0x2D31:  "O̵",                 // 'ⴱ'
+	0x2D37:  "Ʌ",                  // 'ⴷ'
+	0x2D38:  "V",                  // 'ⴸ'
+	0x2D39:  "E",                  // 'ⴹ'
+	0x2D3A:  "Ǝ",                  // 'ⴺ'
+	0x2D41:  "O̸",                 // 'ⵁ'
+	0x2D48:  "···",                // 'ⵈ'
+	0x2D49:  "Ʃ",                  // 'ⵉ'
+	0x2D4F:  "l",                  // 'ⵏ'
+	0x2D51:  "!",                  // 'ⵑ'
+	0x2D54:  "O",                  // 'ⵔ'
+	0x2D55:  "Q",                  // 'ⵕ'
+	0x2D59:  "ʘ",                  // 'ⵙ'
+	0x2D5D:  "X",                  // 'ⵝ'
+	0x2D60:  "Δ",                  // 'ⵠ'
+	0x2D63:  "ᛯ",                  // 'ⵣ'
+	0x2DE8:  "ᷟ",                  // 'ⷨ'
+	0x2DEA:  "̊",                  // 'ⷪ'
+	0x2DED:  "ͨ",                  // 'ⷭ'
+	0x2DEF:  "ͯ",                  // 'ⷯ'
+	0x2DF6:  "ͣ",                  // 'ⷶ'
+	0x2DF7:  "ͤ",                  // 'ⷷ'
+	0x2E1A:  "-̈",                 // '⸚'
+	0x2E1E:  "~̇",                 // '⸞'
+	0x2E1F:  "~̣",                 // '⸟'
+	0x2E26:  "ᑕ",                  // '⸦'
+	0x2E27:  "ᑐ",                  // '⸧'
+	0x2E28:  "((",                 // '⸨'
+	0x2E29:  "))",                 // '⸩'
+	0x2E2A:  "∵",                  // '⸪'
+	0x2E2B:  "∴",                  // '⸫'
+	0x2E2C:  "∷",                  // '⸬'
+	0x2E2E:  "؟",                  // '⸮'
+	0x2E30:  "°",                  // '⸰'
+	0x2E31:  "·",                  // '⸱'
+	0x2E32:  "،",                  // '⸲'
+	0x2E35:  "؛",                  // '⸵'
+	0x2E39:  "ẟ",                  // '⸹'
+	0x2E3D:  "ⵂ",                  // '⸽'
+	0x2E3F:  "¶",                  // '⸿'
+	0x2E40:  "=",                  // '⹀'
+	0x2E82:  "乛",                  // '⺂'
+	0x2E83:  "乚",                  // '⺃'
+	0x2E85:  "亻",                  // '⺅'
+	0x2E89:  "刂",                  // '⺉'
+	0x2E8B:  "㔾",                  // '⺋'
+	0x2E8E:  "兀",                  // '⺎'
+	0x2E8F:  "尣",                  // '⺏'
+	0x2E90:  "尢",                  // '⺐'
+	0x2E92:  "巳",                  // '⺒'
+	0x2E93:  "幺",                  // '⺓'
+	0x2E94:  "彑",                  // '⺔'
+	0x2E96:  "忄",                  // '⺖'
+	0x2E97:  "㣺",                  // '⺗'
+	0x2E98:  "扌",                  // '⺘'
+	0x2E99:  "攵",                  // '⺙'
+	0x2E9B:  "旡",                  // '⺛'
+	0x2E9E:  "歺",                  // '⺞'
+	0x2E9F:  "母",                  // '⺟'
+	0x2EA0:  "民",                  // '⺠'
+	0x2EA1:  "氵",                  // '⺡'
+	0x2EA2:  "氺",                  // '⺢'
+	0x2EA3:  "灬",                  // '⺣'
+	0x2EA4:  "爫",                  // '⺤'
+	0x2EA6:  "丬",                  // '⺦'
+	0x2EA8:  "犭",                  // '⺨'
+	0x2EAB:  "罒",                  // '⺫'
+	0x2EAD:  "礻",                  // '⺭'
+	0x2EAF:  "糹",                  // '⺯'
+	0x2EB1:  "罓",                  // '⺱'
+	0x2EB2:  "罒",                  // '⺲'
+	0x2EB9:  "耂",                  // '⺹'
+	0x2EBA:  "肀",                  // '⺺'
+	0x2EBE:  "艹",                  // '⺾'
+	0x2EBF:  "艹",                  // '⺿'
+	0x2EC0:  "艹",                  // '⻀'
+	0x2EC1:  "虎",                  // '⻁'
+	0x2EC2:  "衤",                  // '⻂'
+	0x2EC3:  "覀",                  // '⻃'
+	0x2EC4:  "西",                  // '⻄'
+	0x2EC5:  "见",                  // '⻅'
+	0x2EC8:  "讠",                  // '⻈'
+	0x2EC9:  "贝",                  // '⻉'
+	0x2ECB:  "车",                  // '⻋'
+	0x2ECC:  "辶",                  // '⻌'
+	0x2ECD:  "辶",                  // '⻍'
+	0x2ECF:  "阝",                  // '⻏'
+	0x2ED0:  "钅",                  // '⻐'
+	0x2ED1:  "長",                  // '⻑'
+	0x2ED2:  "镸",                  // '⻒'
+	0x2ED3:  "长",                  // '⻓'
+	0x2ED4:  "门",                  // '⻔'
+	0x2ED6:  "阝",                  // '⻖'
+	0x2ED8:  "青",                  // '⻘'
+	0x2ED9:  "韦",                  // '⻙'
+	0x2EDA:  "页",                  // '⻚'
+	0x2EDB:  "风",                  // '⻛'
+	0x2EDC:  "飞",                  // '⻜'
+	0x2EDD:  "食",                  // '⻝'
+	0x2EDF:  "飠",                  // '⻟'
+	0x2EE0:  "饣",                  // '⻠'
+	0x2EE2:  "马",                  // '⻢'
+	0x2EE4:  "鬼",                  // '⻤'
+	0x2EE5:  "鱼",                  // '⻥'
+	0x2EE8:  "麦",                  // '⻨'
+	0x2EE9:  "黄",                  // '⻩'
+	0x2EEB:  "斉",                  // '⻫'
+	0x2EEC:  "齐",                  // '⻬'
+	0x2EED:  "歯",                  // '⻭'
+	0x2EEE:  "齿",                  // '⻮'
+	0x2EEF:  "竜",                  // '⻯'
+	0x2EF0:  "龙",                  // '⻰'
+	0x2EF2:  "亀",                  // '⻲'
+	0x2EF3:  "龟",                  // '⻳'
+	0x2F00:  "ー",                  // '⼀'
+	0x2F01:  "丨",                  // '⼁'
+	0x2F02:  "\\",                 // '⼂'
+	0x2F03:  "/",                  // '⼃'
+	0x2F04:  "乙",                  // '⼄'
+	0x2F05:  "亅",                  // '⼅'
+	0x2F06:  "二",                  // '⼆'
+	0x2F07:  "亠",                  // '⼇'
+	0x2F08:  "人",                  // '⼈'
+	0x2F09:  "儿",                  // '⼉'
+	0x2F0A:  "入",                  // '⼊'
+	0x2F0B:  "八",                  // '⼋'
+	0x2F0C:  "冂",                  // '⼌'
+	0x2F0D:  "冖",                  // '⼍'
+	0x2F0E:  "冫",                  // '⼎'
+	0x2F0F:  "几",                  // '⼏'
+	0x2F10:  "凵",                  // '⼐'
+	0x2F11:  "刀",                  // '⼑'
+	0x2F12:  "力",                  // '⼒'
+	0x2F13:  "勹",                  // '⼓'
+	0x2F14:  "匕",                  // '⼔'
+	0x2F15:  "匚",                  // '⼕'
+	0x2F16:  "匸",                  // '⼖'
+	0x2F17:  "十",                  // '⼗'
+	0x2F18:  "卜",                  // '⼘'
+	0x2F19:  "卩",                  // '⼙'
+	0x2F1A:  "厂",                  // '⼚'
+	0x2F1B:  "厶",                  // '⼛'
+	0x2F1C:  "又",                  // '⼜'
+	0x2F1D:  "口",                  // '⼝'
+	0x2F1E:  "口",                  // '⼞'
+	0x2F1F:  "土",                  // '⼟'
+	0x2F20:  "土",                  // '⼠'
+	0x2F21:  "夂",                  // '⼡'
+	0x2F22:  "夊",                  // '⼢'
+	0x2F23:  "夕",                  // '⼣'
+	0x2F24:  "大",                  // '⼤'
+	0x2F25:  "女",                  // '⼥'
+	0x2F26:  "子",                  // '⼦'
+	0x2F27:  "宀",                  // '⼧'
+	0x2F28:  "寸",                  // '⼨'
+	0x2F29:  "小",                  // '⼩'
+	0x2F2A:  "尢",                  // '⼪'
+	0x2F2B:  "尸",                  // '⼫'
+	0x2F2C:  "屮",                  // '⼬'
+	0x2F2D:  "山",                  // '⼭'
+	0x2F2E:  "巛",                  // '⼮'
+	0x2F2F:  "工",                  // '⼯'
+	0x2F30:  "己",                  // '⼰'
+	0x2F31:  "巾",                  // '⼱'
+	0x2F32:  "干",                  // '⼲'
+	0x2F33:  "幺",                  // '⼳'
+	0x2F34:  "广",                  // '⼴'
+	0x2F35:  "廴",                  // '⼵'
+	0x2F36:  "廾",                  // '⼶'
+	0x2F37:  "弋",                  // '⼷'
+	0x2F38:  "弓",                  // '⼸'
+	0x2F39:  "彐",                  // '⼹'
+	0x2F3A:  "彡",                  // '⼺'
+	0x2F3B:  "彳",                  // '⼻'
+	0x2F3C:  "心",                  // '⼼'
+	0x2F3D:  "戈",                  // '⼽'
+	0x2F3E:  "戶",                  // '⼾'
+	0x2F3F:  "手",                  // '⼿'
+	0x2F40:  "支",                  // '⽀'
+	0x2F41:  "攴",                  // '⽁'
+	0x2F42:  "文",                  // '⽂'
+	0x2F43:  "斗",                  // '⽃'
+	0x2F44:  "斤",                  // '⽄'
+	0x2F45:  "方",                  // '⽅'
+	0x2F46:  "无",                  // '⽆'
+	0x2F47:  "日",                  // '⽇'
+	0x2F48:  "曰",                  // '⽈'
+	0x2F49:  "月",                  // '⽉'
+	0x2F4A:  "木",                  // '⽊'
+	0x2F4B:  "欠",                  // '⽋'
+	0x2F4C:  "止",                  // '⽌'
+	0x2F4D:  "歹",                  // '⽍'
+	0x2F4E:  "殳",                  // '⽎'
+	0x2F4F:  "毋",                  // '⽏'
+	0x2F50:  "比",                  // '⽐'
+	0x2F51:  "毛",                  // '⽑'
+	0x2F52:  "氏",                  // '⽒'
+	0x2F53:  "气",                  // '⽓'
+	0x2F54:  "水",                  // '⽔'
+	0x2F55:  "火",                  // '⽕'
+	0x2F56:  "爪",                  // '⽖'
+	0x2F57:  "父",                  // '⽗'
+	0x2F58:  "爻",                  // '⽘'
+	0x2F59:  "爿",                  // '⽙'
+	0x2F5A:  "片",                  // '⽚'
+	0x2F5B:  "牙",                  // '⽛'
+	0x2F5C:  "牛",                  // '⽜'
+	0x2F5D:  "犬",                  // '⽝'
+	0x2F5E:  "玄",                  // '⽞'
+	0x2F5F:  "玉",                  // '⽟'
+	0x2F60:  "瓜",                  // '⽠'
+	0x2F61:  "瓦",                  // '⽡'
+	0x2F62:  "甘",                  // '⽢'
+	0x2F63:  "生",                  // '⽣'
+	0x2F64:  "用",                  // '⽤'
+	0x2F65:  "田",                  // '⽥'
+	0x2F66:  "疋",                  // '⽦'
+	0x2F67:  "疒",                  // '⽧'
+	0x2F68:  "癶",                  // '⽨'
+	0x2F69:  "白",                  // '⽩'
+	0x2F6A:  "皮",                  // '⽪'
+	0x2F6B:  "皿",                  // '⽫'
+	0x2F6C:  "目",                  // '⽬'
+	0x2F6D:  "矛",                  // '⽭'
+	0x2F6E:  "矢",                  // '⽮'
+	0x2F6F:  "石",                  // '⽯'
+	0x2F70:  "示",                  // '⽰'
+	0x2F71:  "禸",                  // '⽱'
+	0x2F72:  "禾",                  // '⽲'
+	0x2F73:  "穴",                  // '⽳'
+	0x2F74:  "立",                  // '⽴'
+	0x2F75:  "竹",                  // '⽵'
+	0x2F76:  "米",                  // '⽶'
+	0x2F77:  "糸",                  // '⽷'
+	0x2F78:  "缶",                  // '⽸'
+	0x2F79:  "网",                  // '⽹'
+	0x2F7A:  "羊",                  // '⽺'
+	0x2F7B:  "羽",                  // '⽻'
+	0x2F7C:  "老",                  // '⽼'
+	0x2F7D:  "而",                  // '⽽'
+	0x2F7E:  "耒",                  // '⽾'
+	0x2F7F:  "耳",                  // '⽿'
+	0x2F80:  "聿",                  // '⾀'
+	0x2F81:  "肉",                  // '⾁'
+	0x2F82:  "臣",                  // '⾂'
+	0x2F83:  "自",                  // '⾃'
+	0x2F84:  "至",                  // '⾄'
+	0x2F85:  "臼",                  // '⾅'
+	0x2F86:  "舌",                  // '⾆'
+	0x2F87:  "舛",                  // '⾇'
+	0x2F88:  "舟",                  // '⾈'
+	0x2F89:  "艮",                  // '⾉'
+	0x2F8A:  "色",                  // '⾊'
+	0x2F8B:  "艸",                  // '⾋'
+	0x2F8C:  "虍",                  // '⾌'
+	0x2F8D:  "虫",                  // '⾍'
+	0x2F8E:  "血",                  // '⾎'
+	0x2F8F:  "行",                  // '⾏'
+	0x2F90:  "衣",                  // '⾐'
+	0x2F91:  "襾",                  // '⾑'
+	0x2F92:  "見",                  // '⾒'
+	0x2F93:  "角",                  // '⾓'
+	0x2F94:  "言",                  // '⾔'
+	0x2F95:  "谷",                  // '⾕'
+	0x2F96:  "豆",                  // '⾖'
+	0x2F97:  "豕",                  // '⾗'
+	0x2F98:  "豸",                  // '⾘'
+	0x2F99:  "貝",                  // '⾙'
+	0x2F9A:  "赤",                  // '⾚'
+	0x2F9B:  "走",                  // '⾛'
+	0x2F9C:  "足",                  // '⾜'
+	0x2F9D:  "身",                  // '⾝'
+	0x2F9E:  "車",                  // '⾞'
+	0x2F9F:  "辛",                  // '⾟'
+	0x2FA0:  "辰",                  // '⾠'
+	0x2FA1:  "辵",                  // '⾡'
+	0x2FA2:  "邑",                  // '⾢'
+	0x2FA3:  "酉",                  // '⾣'
+	0x2FA4:  "釆",                  // '⾤'
+	0x2FA5:  "里",                  // '⾥'
+	0x2FA6:  "金",                  // '⾦'
+	0x2FA7:  "長",                  // '⾧'
+	0x2FA8:  "門",                  // '⾨'
+	0x2FA9:  "阜",                  // '⾩'
+	0x2FAA:  "隶",                  // '⾪'
+	0x2FAB:  "隹",                  // '⾫'
+	0x2FAC:  "雨",                  // '⾬'
+	0x2FAD:  "靑",                  // '⾭'
+	0x2FAE:  "非",                  // '⾮'
+	0x2FAF:  "面",                  // '⾯'
+	0x2FB0:  "革",                  // '⾰'
+	0x2FB1:  "韋",                  // '⾱'
+	0x2FB2:  "韭",                  // '⾲'
+	0x2FB3:  "音",                  // '⾳'
+	0x2FB4:  "頁",                  // '⾴'
+	0x2FB5:  "風",                  // '⾵'
+	0x2FB6:  "飛",                  // '⾶'
+	0x2FB7:  "食",                  // '⾷'
+	0x2FB8:  "首",                  // '⾸'
+	0x2FB9:  "香",                  // '⾹'
+	0x2FBA:  "馬",                  // '⾺'
+	0x2FBB:  "骨",                  // '⾻'
+	0x2FBC:  "高",                  // '⾼'
+	0x2FBD:  "髟",                  // '⾽'
+	0x2FBE:  "鬥",                  // '⾾'
+	0x2FBF:  "鬯",                  // '⾿'
+	0x2FC0:  "鬲",                  // '⿀'
+	0x2FC1:  "鬼",                  // '⿁'
+	0x2FC2:  "魚",                  // '⿂'
+	0x2FC3:  "鳥",                  // '⿃'
+	0x2FC4:  "鹵",                  // '⿄'
+	0x2FC5:  "鹿",                  // '⿅'
+	0x2FC6:  "麥",                  // '⿆'
+	0x2FC7:  "麻",                  // '⿇'
+	0x2FC8:  "黃",                  // '⿈'
+	0x2FC9:  "黍",                  // '⿉'
+	0x2FCA:  "黑",                  // '⿊'
+	0x2FCB:  "黹",                  // '⿋'
+	0x2FCC:  "黽",                  // '⿌'
+	0x2FCD:  "鼎",                  // '⿍'
+	0x2FCE:  "鼓",                  // '⿎'
+	0x2FCF:  "鼠",                  // '⿏'
+	0x2FD0:  "鼻",                  // '⿐'
+	0x2FD1:  "齊",                  // '⿑'
+	0x2FD2:  "齒",                  // '⿒'
+	0x2FD3:  "龍",                  // '⿓'
+	0x2FD4:  "龜",                  // '⿔'
+	0x2FD5:  "龠",                  // '⿕'
+	0x3002:  "˳",                  // '。'
+	0x3003:  "''",                 // '〃'
+	0x3007:  "O",                  // '〇'
+	0x3008:  "❬",                  // '〈'
+	0x3009:  "❭",                  // '〉'
+	0x3012:  "₸",                  // '〒'
+	0x3014:  "(",                  // '〔'
+	0x3015:  ")",                  // '〕'
+	0x301A:  "⟦",                  // '〚'
+	0x301B:  "⟧",                  // '〛'
+	0x302C:  "̉",                  // '〬'
+	0x302D:  "̥",                  // '〭'
+	0x3033:  "/",                  // '〳'
+	0x3036:  "₸",                  // '〶'
+	0x3038:  "十",                  // '〸'
+	0x3039:  "卄",                  // '〹'
+	0x303A:  "卅",                  // '〺'
+	0x304F:  "❬",                  // 'く'
+	0x309A:  "̊",                  // '゚'
+	0x309B:  "ﾞ",                  // '゛'
+	0x309C:  "ﾟ",                  // '゜'
+	0x30A0:  "=",                  // '゠'
+	0x30A4:  "亻",                  // 'イ'
+	0x30A8:  "工",                  // 'エ'
+	0x30AB:  "力",                  // 'カ'
+	0x30BF:  "夕",                  // 'タ'
+	0x30C8:  "卜",                  // 'ト'
+	0x30CB:  "二",                  // 'ニ'
+	0x30CE:  "/",                  // 'ノ'
+	0x30CF:  "八",                  // 'ハ'
+	0x30D8:  "へ",                  // 'ヘ'
+	0x30ED:  "口",                  // 'ロ'
+	0x30FB:  "·",                  // '・'
+	0x3131:  "ᄀ",                  // 'ㄱ'
+	0x3132:  "ᄀᄀ",                 // 'ㄲ'
+	0x3133:  "ᄀᄉ",                 // 'ㄳ'
+	0x3134:  "ᄂ",                  // 'ㄴ'
+	0x3135:  "ᄂᄌ",                 // 'ㄵ'
+	0x3136:  "ᄂᄒ",                 // 'ㄶ'
+	0x3137:  "ᄃ",                  // 'ㄷ'
+	0x3138:  "ᄃᄃ",                 // 'ㄸ'
+	0x3139:  "ᄅ",                  // 'ㄹ'
+	0x313A:  "ᄅᄀ",                 // 'ㄺ'
+	0x313B:  "ᄅᄆ",                 // 'ㄻ'
+	0x313C:  "ᄅᄇ",                 // 'ㄼ'
+	0x313D:  "ᄅᄉ",                 // 'ㄽ'
+	0x313E:  "ᄅᄐ",                 // 'ㄾ'
+	0x313F:  "ᄅᄑ",                 // 'ㄿ'
+	0x3140:  "ᄅᄒ",                 // 'ㅀ'
+	0x3141:  "ᄆ",                  // 'ㅁ'
+	0x3142:  "ᄇ",                  // 'ㅂ'
+	0x3143:  "ᄇᄇ",                 // 'ㅃ'
+	0x3144:  "ᄇᄉ",                 // 'ㅄ'
+	0x3145:  "ᄉ",                  // 'ㅅ'
+	0x3146:  "ᄉᄉ",                 // 'ㅆ'
+	0x3147:  "ᄋ",                  // 'ㅇ'
+	0x3148:  "ᄌ",                  // 'ㅈ'
+	0x3149:  "ᄌᄌ",                 // 'ㅉ'
+	0x314A:  "ᄎ",                  // 'ㅊ'
+	0x314B:  "ᄏ",                  // 'ㅋ'
+	0x314C:  "ᄐ",                  // 'ㅌ'
+	0x314D:  "ᄑ",                  // 'ㅍ'
+	0x314E:  "ᄒ",                  // 'ㅎ'
+	0x314F:  "ᅡ",                  // 'ㅏ'
+	0x3150:  "ᅡ丨",                 // 'ㅐ'
+	0x3151:  "ᅣ",                  // 'ㅑ'
+	0x3152:  "ᅣ丨",                 // 'ㅒ'
+	0x3153:  "ᅥ",                  // 'ㅓ'
+	0x3154:  "ᅥ丨",                 // 'ㅔ'
+	0x3155:  "ᅧ",                  // 'ㅕ'
+	0x3156:  "ᅧ丨",                 // 'ㅖ'
+	0x3157:  "ᅩ",                  // 'ㅗ'
+	0x3158:  "ᅩᅡ",                 // 'ㅘ'
+	0x3159:  "ᅩᅡ丨",                // 'ㅙ'
+	0x315A:  "ᅩ丨",                 // 'ㅚ'
+	0x315B:  "ᅭ",                  // 'ㅛ'
+	0x315C:  "ᅮ",                  // 'ㅜ'
+	0x315D:  "ᅮᅥ",                 // 'ㅝ'
+	0x315E:  "ᅮᅥ丨",                // 'ㅞ'
+	0x315F:  "ᅮ丨",                 // 'ㅟ'
+	0x3160:  "ᅲ",                  // 'ㅠ'
+	0x3161:  "ー",                  // 'ㅡ'
+	0x3162:  "ー丨",                 // 'ㅢ'
+	0x3163:  "丨",                  // 'ㅣ'
+	0x3164:  "ᅠ",                  // 'ㅤ'
+	0x3165:  "ᄂᄂ",                 // 'ㅥ'
+	0x3166:  "ᄂᄃ",                 // 'ㅦ'
+	0x3167:  "ᄂᄉ",                 // 'ㅧ'
+	0x3168:  "ᄂᅀ",                 // 'ㅨ'
+	0x3169:  "ᄅᄀᄉ",                // 'ㅩ'
+	0x316A:  "ᄅᄃ",                 // 'ㅪ'
+	0x316B:  "ᄅᄇᄉ",                // 'ㅫ'
+	0x316C:  "ᄅᅀ",                 // 'ㅬ'
+	0x316D:  "ᄅᅙ",                 // 'ㅭ'
+	0x316E:  "ᄆᄇ",                 // 'ㅮ'
+	0x316F:  "ᄆᄉ",                 // 'ㅯ'
+	0x3170:  "ᄆᅀ",                 // 'ㅰ'
+	0x3171:  "ᄆᄋ",                 // 'ㅱ'
+	0x3172:  "ᄇᄀ",                 // 'ㅲ'
+	0x3173:  "ᄇᄃ",                 // 'ㅳ'
+	0x3174:  "ᄇᄉᄀ",                // 'ㅴ'
+	0x3175:  "ᄇᄉᄃ",                // 'ㅵ'
+	0x3176:  "ᄇᄌ",                 // 'ㅶ'
+	0x3177:  "ᄇᄐ",                 // 'ㅷ'
+	0x3178:  "ᄇᄋ",                 // 'ㅸ'
+	0x3179:  "ᄇᄇᄋ",                // 'ㅹ'
+	0x317A:  "ᄉᄀ",                 // 'ㅺ'
+	0x317B:  "ᄉᄂ",                 // 'ㅻ'
+	0x317C:  "ᄉᄃ",                 // 'ㅼ'
+	0x317D:  "ᄉᄇ",                 // 'ㅽ'
+	0x317E:  "ᄉᄌ",                 // 'ㅾ'
+	0x317F:  "ᅀ",                  // 'ㅿ'
+	0x3180:  "ᄋᄋ",                 // 'ㆀ'
+	0x3181:  "ᅌ",                  // 'ㆁ'
+	0x3182:  "ᄋᄉ",                 // 'ㆂ'
+	0x3183:  "ᄋᅀ",                 // 'ㆃ'
+	0x3184:  "ᄑᄋ",                 // 'ㆄ'
+	0x3185:  "ᄒᄒ",                 // 'ㆅ'
+	0x3186:  "ᅙ",                  // 'ㆆ'
+	0x3187:  "ᅭᅣ",                 // 'ㆇ'
+	0x3188:  "ᅭᅣ丨",                // 'ㆈ'
+	0x3189:  "ᅭ丨",                 // 'ㆉ'
+	0x318A:  "ᅲᅧ",                 // 'ㆊ'
+	0x318B:  "ᅲᅧ丨",                // 'ㆋ'
+	0x318C:  "ᅲ丨",                 // 'ㆌ'
+	0x318D:  "ᆞ",                  // 'ㆍ'
+	0x318E:  "ᆞ丨",                 // 'ㆎ'
+	0x31D0:  "ー",                  // '㇐'
+	0x31D1:  "丨",                  // '㇑'
+	0x31D3:  "/",                  // '㇓'
+	0x31D4:  "\\",                 // '㇔'
+	0x31D6:  "乛",                  // '㇖'
+	0x31DA:  "亅",                  // '㇚'
+	0x31DB:  "❬",                  // '㇛'
+	0x31DF:  "乚",                  // '㇟'
+	0x31E0:  "乙",                  // '㇠'
+	0x3200:  "(ᄀ)",                // '㈀'
+	0x3201:  "(ᄂ)",                // '㈁'
+	0x3202:  "(ᄃ)",                // '㈂'
+	0x3203:  "(ᄅ)",                // '㈃'
+	0x3204:  "(ᄆ)",                // '㈄'
+	0x3205:  "(ᄇ)",                // '㈅'
+	0x3206:  "(ᄉ)",                // '㈆'
+	0x3207:  "(ᄋ)",                // '㈇'
+	0x3208:  "(ᄌ)",                // '㈈'
+	0x3209:  "(ᄎ)",                // '㈉'
+	0x320A:  "(ᄏ)",                // '㈊'
+	0x320B:  "(ᄐ)",                // '㈋'
+	0x320C:  "(ᄑ)",                // '㈌'
+	0x320D:  "(ᄒ)",                // '㈍'
+	0x320E:  "(가)",                // '㈎'
+	0x320F:  "(나)",                // '㈏'
+	0x3210:  "(다)",                // '㈐'
+	0x3211:  "(라)",                // '㈑'
+	0x3212:  "(마)",                // '㈒'
+	0x3213:  "(바)",                // '㈓'
+	0x3214:  "(사)",                // '㈔'
+	0x3215:  "(아)",                // '㈕'
+	0x3216:  "(자)",                // '㈖'
+	0x3217:  "(차)",                // '㈗'
+	0x3218:  "(카)",                // '㈘'
+	0x3219:  "(타)",                // '㈙'
+	0x321A:  "(파)",                // '㈚'
+	0x321B:  "(하)",                // '㈛'
+	0x321C:  "(주)",                // '㈜'
+	0x321D:  "(오전)",               // '㈝'
+	0x321E:  "(오후)",               // '㈞'
+	0x3220:  "(ー)",                // '㈠'
+	0x3221:  "(二)",                // '㈡'
+	0x3222:  "(三)",                // '㈢'
+	0x3223:  "(四)",                // '㈣'
+	0x3224:  "(五)",                // '㈤'
+	0x3225:  "(六)",                // '㈥'
+	0x3226:  "(七)",                // '㈦'
+	0x3227:  "(八)",                // '㈧'
+	0x3228:  "(九)",                // '㈨'
+	0x3229:  "(十)",                // '㈩'
+	0x322A:  "(月)",                // '㈪'
+	0x322B:  "(火)",                // '㈫'
+	0x322C:  "(水)",                // '㈬'
+	0x322D:  "(木)",                // '㈭'
+	0x322E:  "(金)",                // '㈮'
+	0x322F:  "(土)",                // '㈯'
+	0x3230:  "(日)",                // '㈰'
+	0x3231:  "(株)",                // '㈱'
+	0x3232:  "(有)",                // '㈲'
+	0x3233:  "(社)",                // '㈳'
+	0x3234:  "(名)",                // '㈴'
+	0x3235:  "(特)",                // '㈵'
+	0x3236:  "(財)",                // '㈶'
+	0x3237:  "(祝)",                // '㈷'
+	0x3238:  "(労)",                // '㈸'
+	0x3239:  "(代)",                // '㈹'
+	0x323A:  "(呼)",                // '㈺'
+	0x323B:  "(学)",                // '㈻'
+	0x323C:  "(監)",                // '㈼'
+	0x323D:  "(企)",                // '㈽'
+	0x323E:  "(資)",                // '㈾'
+	0x323F:  "(協)",                // '㈿'
+	0x3240:  "(祭)",                // '㉀'
+	0x3241:  "(休)",                // '㉁'
+	0x3242:  "(自)",                // '㉂'
+	0x3243:  "(至)",                // '㉃'
+	0x32C0:  "l月",                 // '㋀'
+	0x32C1:  "2月",                 // '㋁'
+	0x32C2:  "3月",                 // '㋂'
+	0x32C3:  "4月",                 // '㋃'
+	0x32C4:  "5月",                 // '㋄'
+	0x32C5:  "6月",                 // '㋅'
+	0x32C6:  "7月",                 // '㋆'
+	0x32C7:  "8月",                 // '㋇'
+	0x32C8:  "9月",                 // '㋈'
+	0x32C9:  "lO月",                // '㋉'
+	0x32CA:  "ll月",                // '㋊'
+	0x32CB:  "l2月",                // '㋋'
+	0x3358:  "O点",                 // '㍘'
+	0x3359:  "l点",                 // '㍙'
+	0x335A:  "2点",                 // '㍚'
+	0x335B:  "3点",                 // '㍛'
+	0x335C:  "4点",                 // '㍜'
+	0x335D:  "5点",                 // '㍝'
+	0x335E:  "6点",                 // '㍞'
+	0x335F:  "7点",                 // '㍟'
+	0x3360:  "8点",                 // '㍠'
+	0x3361:  "9点",                 // '㍡'
+	0x3362:  "lO点",                // '㍢'
+	0x3363:  "ll点",                // '㍣'
+	0x3364:  "l2点",                // '㍤'
+	0x3365:  "l3点",                // '㍥'
+	0x3366:  "l4点",                // '㍦'
+	0x3367:  "l5点",                // '㍧'
+	0x3368:  "l6点",                // '㍨'
+	0x3369:  "l7点",                // '㍩'
+	0x336A:  "l8点",                // '㍪'
+	0x336B:  "l9点",                // '㍫'
+	0x336C:  "2O点",                // '㍬'
+	0x336D:  "2l点",                // '㍭'
+	0x336E:  "22点",                // '㍮'
+	0x336F:  "23点",                // '㍯'
+	0x3370:  "24点",                // '㍰'
+	0x33E0:  "l日",                 // '㏠'
+	0x33E1:  "2日",                 // '㏡'
+	0x33E2:  "3日",                 // '㏢'
+	0x33E3:  "4日",                 // '㏣'
+	0x33E4:  "5日",                 // '㏤'
+	0x33E5:  "6日",                 // '㏥'
+	0x33E6:  "7日",                 // '㏦'
+	0x33E7:  "8日",                 // '㏧'
+	0x33E8:  "9日",                 // '㏨'
+	0x33E9:  "lO日",                // '㏩'
+	0x33EA:  "ll日",                // '㏪'
+	0x33EB:  "l2日",                // '㏫'
+	0x33EC:  "l3日",                // '㏬'
+	0x33ED:  "l4日",                // '㏭'
+	0x33EE:  "l5日",                // '㏮'
+	0x33EF:  "l6日",                // '㏯'
+	0x33F0:  "l7日",                // '㏰'
+	0x33F1:  "l8日",                // '㏱'
+	0x33F2:  "l9日",                // '㏲'
+	0x33F3:  "2O日",                // '㏳'
+	0x33F4:  "2l日",                // '㏴'
+	0x33F5:  "22日",                // '㏵'
+	0x33F6:  "23日",                // '㏶'
+	0x33F7:  "24日",                // '㏷'
+	0x33F8:  "25日",                // '㏸'
+	0x33F9:  "26日",                // '㏹'
+	0x33FA:  "27日",                // '㏺'
+	0x33FB:  "28日",                // '㏻'
+	0x33FC:  "29日",                // '㏼'
+	0x33FD:  "3O日",                // '㏽'
+	0x33FE:  "3l日",                // '㏾'
+	0x39B3:  "㘽",                  // '㦳'
+	0x439B:  "㖈",                  // '䎛'
+	0x4420:  "㬻",                  // '䐠'
+	0x4E00:  "ー",                  // '一'
+	0x4E36:  "\\",                 // '丶'
+	0x4E3F:  "/",                  // '丿'
+	0x5002:  "併",                  // '倂'
+	0x503C:  "値",                  // '值'
+	0x555F:  "啓",                  // '啟'
+	0x56D7:  "口",                  // '囗'
+	0x586B:  "塡",                  // '填'
+	0x58EB:  "土",                  // '士'
+	0x58FF:  "墫",                  // '壿'
+	0x5B00:  "媯",                  // '嬀'
+	0x5E32:  "帡",                  // '帲'
+	0x5E50:  "㬺",                  // '幐'
+	0x6238:  "戶",                  // '戸'
+	0x6409:  "㩁",                  // '搉'
+	0x6663:  "䀿",                  // '晣'
+	0x6669:  "晚",                  // '晩'
+	0x66F6:  "㫚",                  // '曶'
+	0x6726:  "䑃",                  // '朦'
+	0x67FF:  "杮",                  // '柿'
+	0x69E9:  "㮣",                  // '槩'
+	0x6A27:  "榝",                  // '樧'
+	0x6F59:  "溈",                  // '潙'
+	0x784F:  "研",                  // '硏'
+	0x7D76:  "絕",                  // '絶'
+	0x80A6:  "朌",                  // '肦'
+	0x80CA:  "朐",                  // '胊'
+	0x80D0:  "朏",                  // '胐'
+	0x80F6:  "㬵",                  // '胶'
+	0x8101:  "朓",                  // '脁'
+	0x8127:  "朘",                  // '脧'
+	0x8141:  "胼",                  // '腁'
+	0x81A7:  "朣",                  // '膧'
+	0x853F:  "蒍",                  // '蔿'
+	0x8641:  "蘷",                  // '虁'
+	0x8A1E:  "䚶",                  // '訞'
+	0x8A7D:  "訮",                  // '詽'
+	0x8B8F:  "讆",                  // '讏'
+	0x8C63:  "豜",                  // '豣'
+	0x8D86:  "赿",                  // '趆'
+	0x8DFA:  "跥",                  // '跺'
+	0x8E9B:  "躗",                  // '躛'
+	0x8F27:  "軿",                  // '輧'
+	0x90DE:  "郎",                  // '郞'
+	0x93AE:  "鎭",                  // '鎮'
+	0x96B8:  "隷",                  // '隸'
+	0x9E43:  "鹂",                  // '鹃'
+	0x9ED2:  "黑",                  // '黒'
+	0x9FC3:  "䀹",                  // '鿃'
+	0xA494:  "ꋍ",                  // '꒔'
+	0xA49C:  "ꃀ",                  // '꒜'
+	0xA49E:  "ꁊ",                  // '꒞'
+	0xA4A7:  "ꑘ",                  // '꒧'
+	0xA4A8:  "ꄲ",                  // '꒨'
+	0xA4AC:  "ꁐ",                  // '꒬'
+	0xA4B0:  "ꏂ",                  // '꒰'
+	0xA4BA:  "ꎿ",                  // '꒺'
+	0xA4BE:  "ꊱ",                  // '꒾'
+	0xA4BF:  "ꉙ",                  // '꒿'
+	0xA4C0:  "ꎫ",                  // '꓀'
+	0xA4C2:  "ꎵ",                  // '꓂'
+	0xA4D0:  "B",                  // 'ꓐ'
+	0xA4D1:  "P",                  // 'ꓑ'
+	0xA4D2:  "d",                  // 'ꓒ'
+	0xA4D3:  "D",                  // 'ꓓ'
+	0xA4D4:  "T",                  // 'ꓔ'
+	0xA4D6:  "G",                  // 'ꓖ'
+	0xA4D7:  "K",                  // 'ꓗ'
+	0xA4D9:  "J",                  // 'ꓙ'
+	0xA4DA:  "C",                  // 'ꓚ'
+	0xA4DB:  "Ɔ",                  // 'ꓛ'
+	0xA4DC:  "Z",                  // 'ꓜ'
+	0xA4DD:  "F",                  // 'ꓝ'
+	0xA4DE:  "Ⅎ",                  // 'ꓞ'
+	0xA4DF:  "M",                  // 'ꓟ'
+	0xA4E0:  "N",                  // 'ꓠ'
+	0xA4E1:  "L",                  // 'ꓡ'
+	0xA4E2:  "S",                  // 'ꓢ'
+	0xA4E3:  "R",                  // 'ꓣ'
+	0xA4E5:  "Ʌ",                  // 'ꓥ'
+	0xA4E6:  "V",                  // 'ꓦ'
+	0xA4E7:  "H",                  // 'ꓧ'
+	0xA4EA:  "W",                  // 'ꓪ'
+	0xA4EB:  "X",                  // 'ꓫ'
+	0xA4EC:  "Y",                  // 'ꓬ'
+	0xA4ED:  "ᙠ",                  // 'ꓭ'
+	0xA4EE:  "A",                  // 'ꓮ'
+	0xA4EF:  "Ɐ",                  // 'ꓯ'
+	0xA4F0:  "E",                  // 'ꓰ'
+	0xA4F1:  "Ǝ",                  // 'ꓱ'
+	0xA4F2:  "l",                  // 'ꓲ'
+	0xA4F3:  "O",                  // 'ꓳ'
+	0xA4F4:  "U",                  // 'ꓴ'
+	0xA4F5:  "Ո",                  // 'ꓵ'
+	0xA4F7:  "ᗡ",                  // 'ꓷ'
+	0xA4F8:  ".",                  // 'ꓸ'
+	0xA4F9:  ",",                  // 'ꓹ'
+	0xA4FA:  "..",                 // 'ꓺ'
+	0xA4FB:  ".,",                 // 'ꓻ'
+	0xA4FD:  ":",                  // 'ꓽ'
+	0xA4FE:  "-.",                 // '꓾'
+	0xA4FF:  "=",                  // '꓿'
+	0xA60E:  ".",                  // '꘎'
+	0xA644:  "2",                  // 'Ꙅ'
+	0xA645:  "ƨ",                  // 'ꙅ'
+	0xA647:  "i",                  // 'ꙇ'
+	0xA64D:  "ω",                  // 'ꙍ'
+	0xA650:  "Ъl",                 // 'Ꙑ'
+	0xA651:  "ˉbi",                // 'ꙑ'
+	0xA668:  "ʘ",                  // 'Ꙩ'
+	0xA66F:  "⃩",                  // '꙯'
+	0xA67C:  "̆",                  // '꙼'
+	0xA67E:  "ˇ",                  // '꙾'
+	0xA695:  "h̔",                 // 'ꚕ'
+	0xA698:  "OO",                 // 'Ꚙ'
+	0xA699:  "oo",                 // 'ꚙ'
+	0xA69A:  "𐊨",                  // 'Ꚛ'
+	0xA6A1:  "И",                  // 'ꚡ'
+	0xA6B0:  "ᚹ",                  // 'ꚰ'
+	0xA6B1:  "Ⱶ",                  // 'ꚱ'
+	0xA6CD:  "ʡ",                  // 'ꛍ'
+	0xA6CE:  "Ʌ",                  // 'ꛎ'
+	0xA6DB:  "Π",                  // 'ꛛ'
+	0xA6DF:  "V",                  // 'ꛟ'
+	0xA6EB:  "?",                  // 'ꛫ'
+	0xA6EF:  "2",                  // 'ꛯ'
+	0xA6F0:  "̂",                  // '꛰'
+	0xA6F1:  "̄",                  // '꛱'
+	0xA6F4:  "꛳꛳",                 // '꛴'
+	0xA714:  "˫",                  // '꜔'
+	0xA716:  "˪",                  // '꜖'
+	0xA728:  "T3",                 // 'Ꜩ'
+	0xA729:  "tȝ",                 // 'ꜩ'
+	0xA731:  "s",                  // 'ꜱ'
+	0xA732:  "AA",                 // 'Ꜳ'
+	0xA733:  "aa",                 // 'ꜳ'
+	0xA734:  "AO",                 // 'Ꜵ'
+	0xA735:  "ao",                 // 'ꜵ'
+	0xA736:  "AU",                 // 'Ꜷ'
+	0xA737:  "au",                 // 'ꜷ'
+	0xA738:  "AV",                 // 'Ꜹ'
+	0xA739:  "av",                 // 'ꜹ'
+	0xA73A:  "AV",                 // 'Ꜻ'
+	0xA73B:  "av",                 // 'ꜻ'
+	0xA73C:  "AY",                 // 'Ꜽ'
+	0xA73D:  "ay",                 // 'ꜽ'
+	0xA740:  "K̵",                 // 'Ꝁ'
+	0xA74A:  "O̵",                 // 'Ꝋ'
+	0xA74B:  "o̵",                 // 'ꝋ'
+	0xA74E:  "OO",                 // 'Ꝏ'
+	0xA74F:  "oo",                 // 'ꝏ'
+	0xA75A:  "2",                  // 'Ꝛ'
+	0xA761:  "w̦",                 // 'ꝡ'
+	0xA76A:  "3",                  // 'Ꝫ'
+	0xA76B:  "ȝ",                  // 'ꝫ'
+	0xA76E:  "9",                  // 'Ꝯ'
+	0xA777:  "tf",                 // 'ꝷ'
+	0xA778:  "&",                  // 'ꝸ'
+	0xA77A:  "Ꝺ",                  // 'ꝺ'
+	0xA789:  ":",                  // '꞉'
+	0xA78C:  "'",                  // 'ꞌ'
+	0xA78F:  "·",                  // 'ꞏ'
+	0xA795:  "ꜧ",                  // 'ꞕ'
+	0xA798:  "F",                  // 'Ꞙ'
+	0xA799:  "f",                  // 'ꞙ'
+	0xA79A:  "𐐒",                  // 'Ꞛ'
+	0xA79B:  "𐐺",                  // 'ꞛ'
+	0xA79D:  "ʚ",                  // 'ꞝ'
+	0xA79E:  "ꓤ",                  // 'Ꞟ'
+	0xA79F:  "u",                  // 'ꞟ'
+	0xA7AB:  "3",                  // 'Ɜ'
+	0xA7B1:  "ꓕ",                  // 'Ʇ'
+	0xA7B2:  "J",                  // 'Ʝ'
+	0xA7B3:  "X",                  // 'Ꭓ'
+	0xA7B4:  "B",                  // 'Ꞵ'
+	0xA7B5:  "ß",                  // 'ꞵ'
+	0xA7B6:  "Ꙍ",                  // 'Ꞷ'
+	0xA7B7:  "ω",                  // 'ꞷ'
+	0xA7F7:  "ー",                  // 'ꟷ'
+	0xA830:  "।",                  // '꠰'
+	0xA960:  "ᄃᄆ",                 // 'ꥠ'
+	0xA961:  "ᄃᄇ",                 // 'ꥡ'
+	0xA962:  "ᄃᄉ",                 // 'ꥢ'
+	0xA963:  "ᄃᄌ",                 // 'ꥣ'
+	0xA964:  "ᄅᄀ",                 // 'ꥤ'
+	0xA965:  "ᄅᄀᄀ",                // 'ꥥ'
+	0xA966:  "ᄅᄃ",                 // 'ꥦ'
+	0xA967:  "ᄅᄃᄃ",                // 'ꥧ'
+	0xA968:  "ᄅᄆ",                 // 'ꥨ'
+	0xA969:  "ᄅᄇ",                 // 'ꥩ'
+	0xA96A:  "ᄅᄇᄇ",                // 'ꥪ'
+	0xA96B:  "ᄅᄇᄋ",                // 'ꥫ'
+	0xA96C:  "ᄅᄉ",                 // 'ꥬ'
+	0xA96D:  "ᄅᄌ",                 // 'ꥭ'
+	0xA96E:  "ᄅᄏ",                 // 'ꥮ'
+	0xA96F:  "ᄆᄀ",                 // 'ꥯ'
+	0xA970:  "ᄆᄃ",                 // 'ꥰ'
+	0xA971:  "ᄆᄉ",                 // 'ꥱ'
+	0xA972:  "ᄇᄉᄐ",                // 'ꥲ'
+	0xA973:  "ᄇᄏ",                 // 'ꥳ'
+	0xA974:  "ᄇᄒ",                 // 'ꥴ'
+	0xA975:  "ᄉᄉᄇ",                // 'ꥵ'
+	0xA976:  "ᄋᄅ",                 // 'ꥶ'
+	0xA977:  "ᄋᄒ",                 // 'ꥷ'
+	0xA978:  "ᄌᄌᄒ",                // 'ꥸ'
+	0xA979:  "ᄐᄐ",                 // 'ꥹ'
+	0xA97A:  "ᄑᄒ",                 // 'ꥺ'
+	0xA97B:  "ᄒᄉ",                 // 'ꥻ'
+	0xA97C:  "ᅙᅙ",                 // 'ꥼ'
+	0xA992:  "ⰿ",                  // 'ꦒ'
+	0xA9A3:  "ꦝ",                  // 'ꦣ'
+	0xA9C6:  "꧐",                  // '꧆'
+	0xA9CF:  "٢",                  // 'ꧏ'
+	0xAA53:  "ꨁ",                  // '꩓'
+	0xAA56:  "ꨣ",                  // '꩖'
+	0xAB32:  "e",                  // 'ꬲ'
+	0xAB35:  "f",                  // 'ꬵ'
+	0xAB3D:  "o",                  // 'ꬽ'
+	0xAB3E:  "o̸",                 // 'ꬾ'
+	0xAB3F:  "ɔ̸",                 // 'ꬿ'
+	0xAB41:  "ǝo̸",                // 'ꭁ'
+	0xAB42:  "ǝo̵",                // 'ꭂ'
+	0xAB47:  "r",                  // 'ꭇ'
+	0xAB48:  "r",                  // 'ꭈ'
+	0xAB4D:  "ʃ",                  // 'ꭍ'
+	0xAB4E:  "u",                  // 'ꭎ'
+	0xAB52:  "u",                  // 'ꭒ'
+	0xAB53:  "χ",                  // 'ꭓ'
+	0xAB55:  "χ",                  // 'ꭕ'
+	0xAB5A:  "y",                  // 'ꭚ'
+	0xAB60:  "љ",                  // 'ꭠ'
+	0xAB62:  "ɔe",                 // 'ꭢ'
+	0xAB63:  "uo",                 // 'ꭣ'
+	0xAB70:  "ᴅ",                  // 'ꭰ'
+	0xAB71:  "ʀ",                  // 'ꭱ'
+	0xAB72:  "ᴛ",                  // 'ꭲ'
+	0xAB74:  "ơ",                 // 'ꭴ'
+	0xAB75:  "i",                  // 'ꭵ'
+	0xAB7A:  "ᴀ",                  // 'ꭺ'
+	0xAB7B:  "ᴊ",                  // 'ꭻ'
+	0xAB7C:  "ᴇ",                  // 'ꭼ'
+	0xAB7E:  "ɂ",                  // 'ꭾ'
+	0xAB80:  "ⱶ",                  // 'ꮀ'
+	0xAB81:  "r",                  // 'ꮁ'
+	0xAB83:  "w",                  // 'ꮃ'
+	0xAB87:  "ʍ",                  // 'ꮇ'
+	0xAB8B:  "ʜ",                  // 'ꮋ'
+	0xAB8E:  "o̵",                 // 'ꮎ'
+	0xAB90:  "ɢ",                  // 'ꮐ'
+	0xAB93:  "z",                  // 'ꮓ'
+	0xAB9B:  "ꞓ",                  // 'ꮛ'
+	0xAB9C:  "u̵",                 // 'ꮜ'
+	0xAB9F:  "ƅ",                  // 'ꮟ'
+	0xABA2:  "ʀ",                  // 'ꮢ'
+	0xABA9:  "v",                  // 'ꮩ'
+	0xABAA:  "s",                  // 'ꮪ'
+	0xABAE:  "ʟ",                  // 'ꮮ'
+	0xABAF:  "c",                  // 'ꮯ'
+	0xABB2:  "ᴘ",                  // 'ꮲ'
+	0xABB6:  "ĸ",                  // 'ꮶ'
+	0xABBB:  "o̵",                 // 'ꮻ'
+	0xD7B0:  "ᅩᅧ",                 // 'ힰ'
+	0xD7B1:  "ᅩᅩ丨",                // 'ힱ'
+	0xD7B2:  "ᅭᅡ",                 // 'ힲ'
+	0xD7B3:  "ᅭᅡ丨",                // 'ힳ'
+	0xD7B4:  "ᅭᅥ",                 // 'ힴ'
+	0xD7B5:  "ᅮᅧ",                 // 'ힵ'
+	0xD7B6:  "ᅮ丨丨",                // 'ힶ'
+	0xD7B7:  "ᅲᅡ丨",                // 'ힷ'
+	0xD7B8:  "ᅲᅩ",                 // 'ힸ'
+	0xD7B9:  "ーᅡ",                 // 'ힹ'
+	0xD7BA:  "ーᅥ",                 // 'ힺ'
+	0xD7BB:  "ーᅥ丨",                // 'ힻ'
+	0xD7BC:  "ーᅩ",                 // 'ힼ'
+	0xD7BD:  "丨ᅣᅩ",                // 'ힽ'
+	0xD7BE:  "丨ᅣ丨",                // 'ힾ'
+	0xD7BF:  "丨ᅧ",                 // 'ힿ'
+	0xD7C0:  "丨ᅧ丨",                // 'ퟀ'
+	0xD7C1:  "丨ᅩ丨",                // 'ퟁ'
+	0xD7C2:  "丨ᅭ",                 // 'ퟂ'
+	0xD7C3:  "丨ᅲ",                 // 'ퟃ'
+	0xD7C4:  "丨丨",                 // 'ퟄ'
+	0xD7C5:  "ᆞᅡ",                 // 'ퟅ'
+	0xD7C6:  "ᆞᅥ丨",                // 'ퟆ'
+	0xD7CB:  "ᄂᄅ",                 // 'ퟋ'
+	0xD7CC:  "ᄂᄎ",                 // 'ퟌ'
+	0xD7CD:  "ᄃᄃ",                 // 'ퟍ'
+	0xD7CE:  "ᄃᄃᄇ",                // 'ퟎ'
+	0xD7CF:  "ᄃᄇ",                 // 'ퟏ'
+	0xD7D0:  "ᄃᄉ",                 // 'ퟐ'
+	0xD7D1:  "ᄃᄉᄀ",                // 'ퟑ'
+	0xD7D2:  "ᄃᄌ",                 // 'ퟒ'
+	0xD7D3:  "ᄃᄎ",                 // 'ퟓ'
+	0xD7D4:  "ᄃᄐ",                 // 'ퟔ'
+	0xD7D5:  "ᄅᄀᄀ",                // 'ퟕ'
+	0xD7D6:  "ᄅᄀᄒ",                // 'ퟖ'
+	0xD7D7:  "ᄅᄅᄏ",                // 'ퟗ'
+	0xD7D8:  "ᄅᄆᄒ",                // 'ퟘ'
+	0xD7D9:  "ᄅᄇᄃ",                // 'ퟙ'
+	0xD7DA:  "ᄅᄇᄑ",                // 'ퟚ'
+	0xD7DB:  "ᄅᅌ",                 // 'ퟛ'
+	0xD7DC:  "ᄅᅙᄒ",                // 'ퟜ'
+	0xD7DD:  "ᄅᄋ",                 // 'ퟝ'
+	0xD7DE:  "ᄆᄂ",                 // 'ퟞ'
+	0xD7DF:  "ᄆᄂᄂ",                // 'ퟟ'
+	0xD7E0:  "ᄆᄆ",                 // 'ퟠ'
+	0xD7E1:  "ᄆᄇᄉ",                // 'ퟡ'
+	0xD7E2:  "ᄆᄌ",                 // 'ퟢ'
+	0xD7E3:  "ᄇᄃ",                 // 'ퟣ'
+	0xD7E4:  "ᄇᄅᄑ",                // 'ퟤ'
+	0xD7E5:  "ᄇᄆ",                 // 'ퟥ'
+	0xD7E6:  "ᄇᄇ",                 // 'ퟦ'
+	0xD7E7:  "ᄇᄉᄃ",                // 'ퟧ'
+	0xD7E8:  "ᄇᄌ",                 // 'ퟨ'
+	0xD7E9:  "ᄇᄎ",                 // 'ퟩ'
+	0xD7EA:  "ᄉᄆ",                 // 'ퟪ'
+	0xD7EB:  "ᄉᄇᄋ",                // 'ퟫ'
+	0xD7EC:  "ᄉᄉᄀ",                // 'ퟬ'
+	0xD7ED:  "ᄉᄉᄃ",                // 'ퟭ'
+	0xD7EE:  "ᄉᅀ",                 // 'ퟮ'
+	0xD7EF:  "ᄉᄌ",                 // 'ퟯ'
+	0xD7F0:  "ᄉᄎ",                 // 'ퟰ'
+	0xD7F1:  "ᄉᄐ",                 // 'ퟱ'
+	0xD7F2:  "ᄅᄒ",                 // 'ퟲ'
+	0xD7F3:  "ᅀᄇ",                 // 'ퟳ'
+	0xD7F4:  "ᅀᄇᄋ",                // 'ퟴ'
+	0xD7F5:  "ᅌᄆ",                 // 'ퟵ'
+	0xD7F6:  "ᅌᄒ",                 // 'ퟶ'
+	0xD7F7:  "ᄌᄇ",                 // 'ퟷ'
+	0xD7F8:  "ᄌᄇᄇ",                // 'ퟸ'
+	0xD7F9:  "ᄌᄌ",                 // 'ퟹ'
+	0xD7FA:  "ᄑᄉ",                 // 'ퟺ'
+	0xD7FB:  "ᄑᄐ",                 // 'ퟻ'
+	0xF900:  "豈",                  // '豈'
+	0xF901:  "更",                  // '更'
+	0xF902:  "車",                  // '車'
+	0xF903:  "賈",                  // '賈'
+	0xF904:  "滑",                  // '滑'
+	0xF905:  "串",                  // '串'
+	0xF906:  "句",                  // '句'
+	0xF907:  "龜",                  // '龜'
+	0xF908:  "龜",                  // '龜'
+	0xF909:  "契",                  // '契'
+	0xF90A:  "金",                  // '金'
+	0xF90B:  "喇",                  // '喇'
+	0xF90C:  "奈",                  // '奈'
+	0xF90D:  "懶",                  // '懶'
+	0xF90E:  "癩",                  // '癩'
+	0xF90F:  "羅",                  // '羅'
+	0xF910:  "蘿",                  // '蘿'
+	0xF911:  "螺",                  // '螺'
+	0xF912:  "裸",                  // '裸'
+	0xF913:  "邏",                  // '邏'
+	0xF914:  "樂",                  // '樂'
+	0xF915:  "洛",                  // '洛'
+	0xF916:  "烙",                  // '烙'
+	0xF917:  "珞",                  // '珞'
+	0xF918:  "落",                  // '落'
+	0xF919:  "酪",                  // '酪'
+	0xF91A:  "駱",                  // '駱'
+	0xF91B:  "亂",                  // '亂'
+	0xF91C:  "卵",                  // '卵'
+	0xF91D:  "欄",                  // '欄'
+	0xF91E:  "爛",                  // '爛'
+	0xF91F:  "蘭",                  // '蘭'
+	0xF920:  "鸞",                  // '鸞'
+	0xF921:  "嵐",                  // '嵐'
+	0xF922:  "濫",                  // '濫'
+	0xF923:  "藍",                  // '藍'
+	0xF924:  "襤",                  // '襤'
+	0xF925:  "拉",                  // '拉'
+	0xF926:  "臘",                  // '臘'
+	0xF927:  "蠟",                  // '蠟'
+	0xF928:  "廊",                  // '廊'
+	0xF929:  "朗",                  // '朗'
+	0xF92A:  "浪",                  // '浪'
+	0xF92B:  "狼",                  // '狼'
+	0xF92C:  "郎",                  // '郎'
+	0xF92D:  "來",                  // '來'
+	0xF92E:  "冷",                  // '冷'
+	0xF92F:  "勞",                  // '勞'
+	0xF930:  "擄",                  // '擄'
+	0xF931:  "櫓",                  // '櫓'
+	0xF932:  "爐",                  // '爐'
+	0xF933:  "盧",                  // '盧'
+	0xF934:  "老",                  // '老'
+	0xF935:  "蘆",                  // '蘆'
+	0xF936:  "虜",                  // '虜'
+	0xF937:  "路",                  // '路'
+	0xF938:  "露",                  // '露'
+	0xF939:  "魯",                  // '魯'
+	0xF93A:  "鷺",                  // '鷺'
+	0xF93B:  "碌",                  // '碌'
+	0xF93C:  "祿",                  // '祿'
+	0xF93D:  "綠",                  // '綠'
+	0xF93E:  "菉",                  // '菉'
+	0xF93F:  "錄",                  // '錄'
+	0xF940:  "鹿",                  // '鹿'
+	0xF941:  "論",                  // '論'
+	0xF942:  "壟",                  // '壟'
+	0xF943:  "弄",                  // '弄'
+	0xF944:  "籠",                  // '籠'
+	0xF945:  "聾",                  // '聾'
+	0xF946:  "牢",                  // '牢'
+	0xF947:  "磊",                  // '磊'
+	0xF948:  "賂",                  // '賂'
+	0xF949:  "雷",                  // '雷'
+	0xF94A:  "壘",                  // '壘'
+	0xF94B:  "屢",                  // '屢'
+	0xF94C:  "樓",                  // '樓'
+	0xF94D:  "淚",                  // '淚'
+	0xF94E:  "漏",                  // '漏'
+	0xF94F:  "累",                  // '累'
+	0xF950:  "縷",                  // '縷'
+	0xF951:  "陋",                  // '陋'
+	0xF952:  "勒",                  // '勒'
+	0xF953:  "肋",                  // '肋'
+	0xF954:  "凜",                  // '凜'
+	0xF955:  "凌",                  // '凌'
+	0xF956:  "稜",                  // '稜'
+	0xF957:  "綾",                  // '綾'
+	0xF958:  "菱",                  // '菱'
+	0xF959:  "陵",                  // '陵'
+	0xF95A:  "讀",                  // '讀'
+	0xF95B:  "拏",                  // '拏'
+	0xF95C:  "樂",                  // '樂'
+	0xF95D:  "諾",                  // '諾'
+	0xF95E:  "丹",                  // '丹'
+	0xF95F:  "寧",                  // '寧'
+	0xF960:  "怒",                  // '怒'
+	0xF961:  "率",                  // '率'
+	0xF962:  "異",                  // '異'
+	0xF963:  "北",                  // '北'
+	0xF964:  "磻",                  // '磻'
+	0xF965:  "便",                  // '便'
+	0xF966:  "復",                  // '復'
+	0xF967:  "不",                  // '不'
+	0xF968:  "泌",                  // '泌'
+	0xF969:  "數",                  // '數'
+	0xF96A:  "索",                  // '索'
+	0xF96B:  "參",                  // '參'
+	0xF96C:  "塞",                  // '塞'
+	0xF96D:  "省",                  // '省'
+	0xF96E:  "葉",                  // '葉'
+	0xF96F:  "說",                  // '說'
+	0xF970:  "殺",                  // '殺'
+	0xF971:  "辰",                  // '辰'
+	0xF972:  "沈",                  // '沈'
+	0xF973:  "拾",                  // '拾'
+	0xF974:  "若",                  // '若'
+	0xF975:  "掠",                  // '掠'
+	0xF976:  "略",                  // '略'
+	0xF977:  "亮",                  // '亮'
+	0xF978:  "兩",                  // '兩'
+	0xF979:  "凉",                  // '凉'
+	0xF97A:  "梁",                  // '梁'
+	0xF97B:  "糧",                  // '糧'
+	0xF97C:  "良",                  // '良'
+	0xF97D:  "諒",                  // '諒'
+	0xF97E:  "量",                  // '量'
+	0xF97F:  "勵",                  // '勵'
+	0xF980:  "呂",                  // '呂'
+	0xF981:  "女",                  // '女'
+	0xF982:  "廬",                  // '廬'
+	0xF983:  "旅",                  // '旅'
+	0xF984:  "濾",                  // '濾'
+	0xF985:  "礪",                  // '礪'
+	0xF986:  "閭",                  // '閭'
+	0xF987:  "驪",                  // '驪'
+	0xF988:  "麗",                  // '麗'
+	0xF989:  "黎",                  // '黎'
+	0xF98A:  "力",                  // '力'
+	0xF98B:  "曆",                  // '曆'
+	0xF98C:  "歷",                  // '歷'
+	0xF98D:  "轢",                  // '轢'
+	0xF98E:  "年",                  // '年'
+	0xF98F:  "憐",                  // '憐'
+	0xF990:  "戀",                  // '戀'
+	0xF991:  "撚",                  // '撚'
+	0xF992:  "漣",                  // '漣'
+	0xF993:  "煉",                  // '煉'
+	0xF994:  "璉",                  // '璉'
+	0xF995:  "秊",                  // '秊'
+	0xF996:  "練",                  // '練'
+	0xF997:  "聯",                  // '聯'
+	0xF998:  "輦",                  // '輦'
+	0xF999:  "蓮",                  // '蓮'
+	0xF99A:  "連",                  // '連'
+	0xF99B:  "鍊",                  // '鍊'
+	0xF99C:  "列",                  // '列'
+	0xF99D:  "劣",                  // '劣'
+	0xF99E:  "咽",                  // '咽'
+	0xF99F:  "烈",                  // '烈'
+	0xF9A0:  "裂",                  // '裂'
+	0xF9A1:  "說",                  // '說'
+	0xF9A2:  "廉",                  // '廉'
+	0xF9A3:  "念",                  // '念'
+	0xF9A4:  "捻",                  // '捻'
+	0xF9A5:  "殮",                  // '殮'
+	0xF9A6:  "簾",                  // '簾'
+	0xF9A7:  "獵",                  // '獵'
+	0xF9A8:  "令",                  // '令'
+	0xF9A9:  "囹",                  // '囹'
+	0xF9AA:  "寧",                  // '寧'
+	0xF9AB:  "嶺",                  // '嶺'
+	0xF9AC:  "怜",                  // '怜'
+	0xF9AD:  "玲",                  // '玲'
+	0xF9AE:  "瑩",                  // '瑩'
+	0xF9AF:  "羚",                  // '羚'
+	0xF9B0:  "聆",                  // '聆'
+	0xF9B1:  "鈴",                  // '鈴'
+	0xF9B2:  "零",                  // '零'
+	0xF9B3:  "靈",                  // '靈'
+	0xF9B4:  "領",                  // '領'
+	0xF9B5:  "例",                  // '例'
+	0xF9B6:  "禮",                  // '禮'
+	0xF9B7:  "醴",                  // '醴'
+	0xF9B8:  "隷",                  // '隸'
+	0xF9B9:  "惡",                  // '惡'
+	0xF9BA:  "了",                  // '了'
+	0xF9BB:  "僚",                  // '僚'
+	0xF9BC:  "寮",                  // '寮'
+	0xF9BD:  "尿",                  // '尿'
+	0xF9BE:  "料",                  // '料'
+	0xF9BF:  "樂",                  // '樂'
+	0xF9C0:  "燎",                  // '燎'
+	0xF9C1:  "療",                  // '療'
+	0xF9C2:  "蓼",                  // '蓼'
+	0xF9C3:  "遼",                  // '遼'
+	0xF9C4:  "龍",                  // '龍'
+	0xF9C5:  "暈",                  // '暈'
+	0xF9C6:  "阮",                  // '阮'
+	0xF9C7:  "劉",                  // '劉'
+	0xF9C8:  "杻",                  // '杻'
+	0xF9C9:  "柳",                  // '柳'
+	0xF9CA:  "流",                  // '流'
+	0xF9CB:  "溜",                  // '溜'
+	0xF9CC:  "琉",                  // '琉'
+	0xF9CD:  "留",                  // '留'
+	0xF9CE:  "硫",                  // '硫'
+	0xF9CF:  "紐",                  // '紐'
+	0xF9D0:  "類",                  // '類'
+	0xF9D1:  "六",                  // '六'
+	0xF9D2:  "戮",                  // '戮'
+	0xF9D3:  "陸",                  // '陸'
+	0xF9D4:  "倫",                  // '倫'
+	0xF9D5:  "崙",                  // '崙'
+	0xF9D6:  "淪",                  // '淪'
+	0xF9D7:  "輪",                  // '輪'
+	0xF9D8:  "律",                  // '律'
+	0xF9D9:  "慄",                  // '慄'
+	0xF9DA:  "栗",                  // '栗'
+	0xF9DB:  "率",                  // '率'
+	0xF9DC:  "隆",                  // '隆'
+	0xF9DD:  "利",                  // '利'
+	0xF9DE:  "吏",                  // '吏'
+	0xF9DF:  "履",                  // '履'
+	0xF9E0:  "易",                  // '易'
+	0xF9E1:  "李",                  // '李'
+	0xF9E2:  "梨",                  // '梨'
+	0xF9E3:  "泥",                  // '泥'
+	0xF9E4:  "理",                  // '理'
+	0xF9E5:  "痢",                  // '痢'
+	0xF9E6:  "罹",                  // '罹'
+	0xF9E7:  "裏",                  // '裏'
+	0xF9E8:  "裡",                  // '裡'
+	0xF9E9:  "里",                  // '里'
+	0xF9EA:  "離",                  // '離'
+	0xF9EB:  "匿",                  // '匿'
+	0xF9EC:  "溺",                  // '溺'
+	0xF9ED:  "吝",                  // '吝'
+	0xF9EE:  "燐",                  // '燐'
+	0xF9EF:  "璘",                  // '璘'
+	0xF9F0:  "藺",                  // '藺'
+	0xF9F1:  "隣",                  // '隣'
+	0xF9F2:  "鱗",                  // '鱗'
+	0xF9F3:  "麟",                  // '麟'
+	0xF9F4:  "林",                  // '林'
+	0xF9F5:  "淋",                  // '淋'
+	0xF9F6:  "臨",                  // '臨'
+	0xF9F7:  "立",                  // '立'
+	0xF9F8:  "笠",                  // '笠'
+	0xF9F9:  "粒",                  // '粒'
+	0xF9FA:  "狀",                  // '狀'
+	0xF9FB:  "炙",                  // '炙'
+	0xF9FC:  "識",                  // '識'
+	0xF9FD:  "什",                  // '什'
+	0xF9FE:  "茶",                  // '茶'
+	0xF9FF:  "刺",                  // '刺'
+	0xFA00:  "切",                  // '切'
+	0xFA01:  "度",                  // '度'
+	0xFA02:  "拓",                  // '拓'
+	0xFA03:  "糖",                  // '糖'
+	0xFA04:  "宅",                  // '宅'
+	0xFA05:  "洞",                  // '洞'
+	0xFA06:  "暴",                  // '暴'
+	0xFA07:  "輻",                  // '輻'
+	0xFA08:  "行",                  // '行'
+	0xFA09:  "降",                  // '降'
+	0xFA0A:  "見",                  // '見'
+	0xFA0B:  "廓",                  // '廓'
+	0xFA0C:  "兀",                  // '兀'
+	0xFA0D:  "嗀",                  // '嗀'
+	0xFA10:  "塚",                  // '塚'
+	0xFA12:  "晴",                  // '晴'
+	0xFA15:  "凞",                  // '凞'
+	0xFA16:  "猪",                  // '猪'
+	0xFA17:  "益",                  // '益'
+	0xFA18:  "礼",                  // '礼'
+	0xFA19:  "神",                  // '神'
+	0xFA1A:  "祥",                  // '祥'
+	0xFA1B:  "福",                  // '福'
+	0xFA1C:  "靖",                  // '靖'
+	0xFA1D:  "精",                  // '精'
+	0xFA1E:  "羽",                  // '羽'
+	0xFA20:  "蘒",                  // '蘒'
+	0xFA22:  "諸",                  // '諸'
+	0xFA25:  "逸",                  // '逸'
+	0xFA26:  "都",                  // '都'
+	0xFA2A:  "飯",                  // '飯'
+	0xFA2B:  "飼",                  // '飼'
+	0xFA2C:  "館",                  // '館'
+	0xFA2D:  "鶴",                  // '鶴'
+	0xFA2E:  "郎",                  // '郞'
+	0xFA2F:  "隷",                  // '隷'
+	0xFA30:  "侮",                  // '侮'
+	0xFA31:  "僧",                  // '僧'
+	0xFA32:  "免",                  // '免'
+	0xFA33:  "勉",                  // '勉'
+	0xFA34:  "勤",                  // '勤'
+	0xFA35:  "卑",                  // '卑'
+	0xFA36:  "喝",                  // '喝'
+	0xFA37:  "嘆",                  // '嘆'
+	0xFA38:  "器",                  // '器'
+	0xFA39:  "塀",                  // '塀'
+	0xFA3A:  "墨",                  // '墨'
+	0xFA3B:  "層",                  // '層'
+	0xFA3C:  "屮",                  // '屮'
+	0xFA3D:  "悔",                  // '悔'
+	0xFA3E:  "慨",                  // '慨'
+	0xFA3F:  "憎",                  // '憎'
+	0xFA40:  "懲",                  // '懲'
+	0xFA41:  "敏",                  // '敏'
+	0xFA42:  "既",                  // '既'
+	0xFA43:  "暑",                  // '暑'
+	0xFA44:  "梅",                  // '梅'
+	0xFA45:  "海",                  // '海'
+	0xFA46:  "渚",                  // '渚'
+	0xFA47:  "漢",                  // '漢'
+	0xFA48:  "煮",                  // '煮'
+	0xFA49:  "爫",                  // '爫'
+	0xFA4A:  "琢",                  // '琢'
+	0xFA4B:  "碑",                  // '碑'
+	0xFA4C:  "社",                  // '社'
+	0xFA4D:  "祉",                  // '祉'
+	0xFA4E:  "祈",                  // '祈'
+	0xFA4F:  "祐",                  // '祐'
+	0xFA50:  "祖",                  // '祖'
+	0xFA51:  "祝",                  // '祝'
+	0xFA52:  "禍",                  // '禍'
+	0xFA53:  "禎",                  // '禎'
+	0xFA54:  "穀",                  // '穀'
+	0xFA55:  "突",                  // '突'
+	0xFA56:  "節",                  // '節'
+	0xFA57:  "練",                  // '練'
+	0xFA58:  "縉",                  // '縉'
+	0xFA59:  "繁",                  // '繁'
+	0xFA5A:  "署",                  // '署'
+	0xFA5B:  "者",                  // '者'
+	0xFA5C:  "臭",                  // '臭'
+	0xFA5D:  "艹",                  // '艹'
+	0xFA5E:  "艹",                  // '艹'
+	0xFA5F:  "著",                  // '著'
+	0xFA60:  "褐",                  // '褐'
+	0xFA61:  "視",                  // '視'
+	0xFA62:  "謁",                  // '謁'
+	0xFA63:  "謹",                  // '謹'
+	0xFA64:  "賓",                  // '賓'
+	0xFA65:  "贈",                  // '贈'
+	0xFA66:  "辶",                  // '辶'
+	0xFA67:  "逸",                  // '逸'
+	0xFA68:  "難",                  // '難'
+	0xFA69:  "響",                  // '響'
+	0xFA6A:  "頻",                  // '頻'
+	0xFA6B:  "恵",                  // '恵'
+	0xFA6C:  "𤋮",                  // '𤋮'
+	0xFA6D:  "舘",                  // '舘'
+	0xFA70:  "並",                  // '並'
+	0xFA71:  "况",                  // '况'
+	0xFA72:  "全",                  // '全'
+	0xFA73:  "侀",                  // '侀'
+	0xFA74:  "充",                  // '充'
+	0xFA75:  "冀",                  // '冀'
+	0xFA76:  "勇",                  // '勇'
+	0xFA77:  "勺",                  // '勺'
+	0xFA78:  "喝",                  // '喝'
+	0xFA79:  "啕",                  // '啕'
+	0xFA7A:  "喙",                  // '喙'
+	0xFA7B:  "嗢",                  // '嗢'
+	0xFA7C:  "塚",                  // '塚'
+	0xFA7D:  "墳",                  // '墳'
+	0xFA7E:  "奄",                  // '奄'
+	0xFA7F:  "奔",                  // '奔'
+	0xFA80:  "婢",                  // '婢'
+	0xFA81:  "嬨",                  // '嬨'
+	0xFA82:  "廒",                  // '廒'
+	0xFA83:  "廙",                  // '廙'
+	0xFA84:  "彩",                  // '彩'
+	0xFA85:  "徭",                  // '徭'
+	0xFA86:  "惘",                  // '惘'
+	0xFA87:  "慎",                  // '慎'
+	0xFA88:  "愈",                  // '愈'
+	0xFA89:  "憎",                  // '憎'
+	0xFA8A:  "慠",                  // '慠'
+	0xFA8B:  "懲",                  // '懲'
+	0xFA8C:  "戴",                  // '戴'
+	0xFA8D:  "揄",                  // '揄'
+	0xFA8E:  "搜",                  // '搜'
+	0xFA8F:  "摒",                  // '摒'
+	0xFA90:  "敖",                  // '敖'
+	0xFA91:  "晴",                  // '晴'
+	0xFA92:  "朗",                  // '朗'
+	0xFA93:  "望",                  // '望'
+	0xFA94:  "杖",                  // '杖'
+	0xFA95:  "歹",                  // '歹'
+	0xFA96:  "殺",                  // '殺'
+	0xFA97:  "流",                  // '流'
+	0xFA98:  "滛",                  // '滛'
+	0xFA99:  "滋",                  // '滋'
+	0xFA9A:  "漢",                  // '漢'
+	0xFA9B:  "瀞",                  // '瀞'
+	0xFA9C:  "煮",                  // '煮'
+	0xFA9D:  "瞧",                  // '瞧'
+	0xFA9E:  "爵",                  // '爵'
+	0xFA9F:  "犯",                  // '犯'
+	0xFAA0:  "猪",                  // '猪'
+	0xFAA1:  "瑱",                  // '瑱'
+	0xFAA2:  "甆",                  // '甆'
+	0xFAA3:  "画",                  // '画'
+	0xFAA4:  "瘝",                  // '瘝'
+	0xFAA5:  "瘟",                  // '瘟'
+	0xFAA6:  "益",                  // '益'
+	0xFAA7:  "盛",                  // '盛'
+	0xFAA8:  "直",                  // '直'
+	0xFAA9:  "睊",                  // '睊'
+	0xFAAA:  "着",                  // '着'
+	0xFAAB:  "磌",                  // '磌'
+	0xFAAC:  "窱",                  // '窱'
+	0xFAAD:  "節",                  // '節'
+	0xFAAE:  "类",                  // '类'
+	0xFAAF:  "絛",                  // '絛'
+	0xFAB0:  "練",                  // '練'
+	0xFAB1:  "缾",                  // '缾'
+	0xFAB2:  "者",                  // '者'
+	0xFAB3:  "荒",                  // '荒'
+	0xFAB4:  "華",                  // '華'
+	0xFAB5:  "蝹",                  // '蝹'
+	0xFAB6:  "襁",                  // '襁'
+	0xFAB7:  "覆",                  // '覆'
+	0xFAB8:  "視",                  // '視'
+	0xFAB9:  "調",                  // '調'
+	0xFABA:  "諸",                  // '諸'
+	0xFABB:  "請",                  // '請'
+	0xFABC:  "謁",                  // '謁'
+	0xFABD:  "諾",                  // '諾'
+	0xFABE:  "諭",                  // '諭'
+	0xFABF:  "謹",                  // '謹'
+	0xFAC0:  "變",                  // '變'
+	0xFAC1:  "贈",                  // '贈'
+	0xFAC2:  "輸",                  // '輸'
+	0xFAC3:  "遲",                  // '遲'
+	0xFAC4:  "醙",                  // '醙'
+	0xFAC5:  "鉶",                  // '鉶'
+	0xFAC6:  "陼",                  // '陼'
+	0xFAC7:  "難",                  // '難'
+	0xFAC8:  "靖",                  // '靖'
+	0xFAC9:  "韛",                  // '韛'
+	0xFACA:  "響",                  // '響'
+	0xFACB:  "頋",                  // '頋'
+	0xFACC:  "頻",                  // '頻'
+	0xFACD:  "鬒",                  // '鬒'
+	0xFACE:  "龜",                  // '龜'
+	0xFACF:  "𢡊",                  // '𢡊'
+	0xFAD0:  "𢡄",                  // '𢡄'
+	0xFAD1:  "𣏕",                  // '𣏕'
+	0xFAD2:  "㮝",                  // '㮝'
+	0xFAD3:  "䀘",                  // '䀘'
+	0xFAD4:  "䀹",                  // '䀹'
+	0xFAD5:  "𥉉",                  // '𥉉'
+	0xFAD6:  "𥳐",                  // '𥳐'
+	0xFAD7:  "𧻓",                  // '𧻓'
+	0xFAD8:  "齃",                  // '齃'
+	0xFAD9:  "龎",                  // '龎'
+	0xFB00:  "ff",                 // 'ﬀ'
+	0xFB01:  "fi",                 // 'ﬁ'
+	0xFB02:  "fl",                 // 'ﬂ'
+	0xFB03:  "ffi",                // 'ﬃ'
+	0xFB04:  "ffl",                // 'ﬄ'
+	0xFB06:  "st",                 // 'ﬆ'
+	0xFB13:  "մն",                 // 'ﬓ'
+	0xFB14:  "մե",                 // 'ﬔ'
+	0xFB15:  "մի",                 // 'ﬕ'
+	0xFB16:  "վն",                 // 'ﬖ'
+	0xFB17:  "մխ",                 // 'ﬗ'
+	0xFB20:  "ע",                  // 'ﬠ'
+	0xFB21:  "א",                  // 'ﬡ'
+	0xFB22:  "ד",                  // 'ﬢ'
+	0xFB23:  "ה",                  // 'ﬣ'
+	0xFB24:  "כ",                  // 'ﬤ'
+	0xFB25:  "ל",                  // 'ﬥ'
+	0xFB26:  "ם",                  // 'ﬦ'
+	0xFB27:  "ר",                  // 'ﬧ'
+	0xFB28:  "ת",                  // 'ﬨ'
+	0xFB29:  "-̇",                 // '﬩'
+	0xFB2B:  "שׁ",                  // 'שׂ'
+	0xFB2D:  "שּׁ",                  // 'שּׂ'
+	0xFB2F:  "אַ",                  // 'אָ'
+	0xFB30:  "אַ",                  // 'אּ'
+	0xFB39:  "יִ",                  // 'יּ'
+	0xFB49:  "שׁ",                  // 'שּ'
+	0xFB4F:  "אל",                 // 'ﭏ'
+	0xFB50:  "ٱ",                  // 'ﭐ'
+	0xFB51:  "ٱ",                  // 'ﭑ'
+	0xFB52:  "ٻ",                  // 'ﭒ'
+	0xFB53:  "ٻ",                  // 'ﭓ'
+	0xFB54:  "ٻ",                  // 'ﭔ'
+	0xFB55:  "ٻ",                  // 'ﭕ'
+	0xFB56:  "ىۛ",                 // 'ﭖ'
+	0xFB57:  "ىۛ",                 // 'ﭗ'
+	0xFB58:  "ىۛ",                 // 'ﭘ'
+	0xFB59:  "ىۛ",                 // 'ﭙ'
+	0xFB5A:  "ڀ",                  // 'ﭚ'
+	0xFB5B:  "ڀ",                  // 'ﭛ'
+	0xFB5C:  "ڀ",                  // 'ﭜ'
+	0xFB5D:  "ڀ",                  // 'ﭝ'
+	0xFB5E:  "ٺ",                  // 'ﭞ'
+	0xFB5F:  "ٺ",                  // 'ﭟ'
+	0xFB60:  "ٺ",                  // 'ﭠ'
+	0xFB61:  "ٺ",                  // 'ﭡ'
+	0xFB62:  "ٿ",                  // 'ﭢ'
+	0xFB63:  "ٿ",                  // 'ﭣ'
+	0xFB64:  "ٿ",                  // 'ﭤ'
+	0xFB65:  "ٿ",                  // 'ﭥ'
+	0xFB66:  "ىؕ",                 // 'ﭦ'
+	0xFB67:  "ىؕ",                 // 'ﭧ'
+	0xFB68:  "ىؕ",                 // 'ﭨ'
+	0xFB69:  "ىؕ",                 // 'ﭩ'
+	0xFB6A:  "ڡۛ",                 // 'ﭪ'
+	0xFB6B:  "ڡۛ",                 // 'ﭫ'
+	0xFB6C:  "ڡۛ",                 // 'ﭬ'
+	0xFB6D:  "ڡۛ",                 // 'ﭭ'
+	0xFB6E:  "ڦ",                  // 'ﭮ'
+	0xFB6F:  "ڦ",                  // 'ﭯ'
+	0xFB70:  "ڦ",                  // 'ﭰ'
+	0xFB71:  "ڦ",                  // 'ﭱ'
+	0xFB72:  "ڄ",                  // 'ﭲ'
+	0xFB73:  "ڄ",                  // 'ﭳ'
+	0xFB74:  "ڄ",                  // 'ﭴ'
+	0xFB75:  "ڄ",                  // 'ﭵ'
+	0xFB76:  "ڃ",                  // 'ﭶ'
+	0xFB77:  "ڃ",                  // 'ﭷ'
+	0xFB78:  "ڃ",                  // 'ﭸ'
+	0xFB79:  "ڃ",                  // 'ﭹ'
+	0xFB7A:  "چ",                  // 'ﭺ'
+	0xFB7B:  "چ",                  // 'ﭻ'
+	0xFB7C:  "چ",                  // 'ﭼ'
+	0xFB7D:  "چ",                  // 'ﭽ'
+	0xFB7E:  "ڇ",                  // 'ﭾ'
+	0xFB7F:  "ڇ",                  // 'ﭿ'
+	0xFB80:  "ڇ",                  // 'ﮀ'
+	0xFB81:  "ڇ",                  // 'ﮁ'
+	0xFB82:  "ڍ",                  // 'ﮂ'
+	0xFB83:  "ڍ",                  // 'ﮃ'
+	0xFB84:  "ڌ",                  // 'ﮄ'
+	0xFB85:  "ڌ",                  // 'ﮅ'
+	0xFB86:  "دۛ",                 // 'ﮆ'
+	0xFB87:  "دۛ",                 // 'ﮇ'
+	0xFB88:  "دؕ",                 // 'ﮈ'
+	0xFB89:  "دؕ",                 // 'ﮉ'
+	0xFB8A:  "رۛ",                 // 'ﮊ'
+	0xFB8B:  "رۛ",                 // 'ﮋ'
+	0xFB8C:  "رؕ",                 // 'ﮌ'
+	0xFB8D:  "رؕ",                 // 'ﮍ'
+	0xFB8E:  "ك",                  // 'ﮎ'
+	0xFB8F:  "ك",                  // 'ﮏ'
+	0xFB90:  "ك",                  // 'ﮐ'
+	0xFB91:  "ك",                  // 'ﮑ'
+	0xFB92:  "گ",                  // 'ﮒ'
+	0xFB93:  "گ",                  // 'ﮓ'
+	0xFB94:  "گ",                  // 'ﮔ'
+	0xFB95:  "گ",                  // 'ﮕ'
+	0xFB96:  "ڳ",                  // 'ﮖ'
+	0xFB97:  "ڳ",                  // 'ﮗ'
+	0xFB98:  "ڳ",                  // 'ﮘ'
+	0xFB99:  "ڳ",                  // 'ﮙ'
+	0xFB9A:  "ڱ",                  // 'ﮚ'
+	0xFB9B:  "ڱ",                  // 'ﮛ'
+	0xFB9C:  "ڱ",                  // 'ﮜ'
+	0xFB9D:  "ڱ",                  // 'ﮝ'
+	0xFB9E:  "ى",                  // 'ﮞ'
+	0xFB9F:  "ى",                  // 'ﮟ'
+	0xFBA0:  "ىؕ",                 // 'ﮠ'
+	0xFBA1:  "ىؕ",                 // 'ﮡ'
+	0xFBA2:  "ىؕ",                 // 'ﮢ'
+	0xFBA3:  "ىؕ",                 // 'ﮣ'
+	0xFBA4:  "ۀ",                  // 'ﮤ'
+	0xFBA5:  "ۀ",                  // 'ﮥ'
+	0xFBA6:  "o",                  // 'ﮦ'
+	0xFBA7:  "o",                  // 'ﮧ'
+	0xFBA8:  "o",                  // 'ﮨ'
+	0xFBA9:  "o",                  // 'ﮩ'
+	0xFBAA:  "o",                  // 'ﮪ'
+	0xFBAB:  "o",                  // 'ﮫ'
+	0xFBAC:  "o",                  // 'ﮬ'
+	0xFBAD:  "o",                  // 'ﮭ'
+	0xFBAE:  "ى",                  // 'ﮮ'
+	0xFBAF:  "ى",                  // 'ﮯ'
+	0xFBB0:  "ۓ",                  // 'ﮰ'
+	0xFBB1:  "ۓ",                  // 'ﮱ'
+	0xFBD3:  "كۛ",                 // 'ﯓ'
+	0xFBD4:  "كۛ",                 // 'ﯔ'
+	0xFBD5:  "كۛ",                 // 'ﯕ'
+	0xFBD6:  "كۛ",                 // 'ﯖ'
+	0xFBD7:  "و̓",                 // 'ﯗ'
+	0xFBD8:  "و̓",                 // 'ﯘ'
+	0xFBD9:  "و̆",                 // 'ﯙ'
+	0xFBDA:  "و̆",                 // 'ﯚ'
+	0xFBDB:  "وٰ",                 // 'ﯛ'
+	0xFBDC:  "وٰ",                 // 'ﯜ'
+	0xFBDD:  "و̓ٴ",                // 'ﯝ'
+	0xFBDE:  "وۛ",                 // 'ﯞ'
+	0xFBDF:  "وۛ",                 // 'ﯟ'
+	0xFBE0:  "ۅ",                  // 'ﯠ'
+	0xFBE1:  "ۅ",                  // 'ﯡ'
+	0xFBE2:  "و̂",                 // 'ﯢ'
+	0xFBE3:  "و̂",                 // 'ﯣ'
+	0xFBE4:  "ٻ",                  // 'ﯤ'
+	0xFBE5:  "ٻ",                  // 'ﯥ'
+	0xFBE6:  "ٻ",                  // 'ﯦ'
+	0xFBE7:  "ٻ",                  // 'ﯧ'
+	0xFBE8:  "ى",                  // 'ﯨ'
+	0xFBE9:  "ى",                  // 'ﯩ'
+	0xFBEA:  "ىٴl",                // 'ﯪ'
+	0xFBEB:  "ىٴl",                // 'ﯫ'
+	0xFBEC:  "ىٴo",                // 'ﯬ'
+	0xFBED:  "ىٴo",                // 'ﯭ'
+	0xFBEE:  "ىٴو",                // 'ﯮ'
+	0xFBEF:  "ىٴو",                // 'ﯯ'
+	0xFBF0:  "ىٴو̓",               // 'ﯰ'
+	0xFBF1:  "ىٴو̓",               // 'ﯱ'
+	0xFBF2:  "ىٴو̆",               // 'ﯲ'
+	0xFBF3:  "ىٴو̆",               // 'ﯳ'
+	0xFBF4:  "ىٴوٰ",               // 'ﯴ'
+	0xFBF5:  "ىٴوٰ",               // 'ﯵ'
+	0xFBF6:  "ىٴٻ",                // 'ﯶ'
+	0xFBF7:  "ىٴٻ",                // 'ﯷ'
+	0xFBF8:  "ىٴٻ",                // 'ﯸ'
+	0xFBF9:  "ىٴى",                // 'ﯹ'
+	0xFBFA:  "ىٴى",                // 'ﯺ'
+	0xFBFB:  "ىٴى",                // 'ﯻ'
+	0xFBFC:  "ى",                  // 'ﯼ'
+	0xFBFD:  "ى",                  // 'ﯽ'
+	0xFBFE:  "ى",                  // 'ﯾ'
+	0xFBFF:  "ى",                  // 'ﯿ'
+	0xFC00:  "ىٴج",                // 'ﰀ'
+	0xFC01:  "ىٴح",                // 'ﰁ'
+	0xFC02:  "ىٴم",                // 'ﰂ'
+	0xFC03:  "ىٴى",                // 'ﰃ'
+	0xFC04:  "ىٴى",                // 'ﰄ'
+	0xFC05:  "بج",                 // 'ﰅ'
+	0xFC06:  "بح",                 // 'ﰆ'
+	0xFC07:  "بخ",                 // 'ﰇ'
+	0xFC08:  "بم",                 // 'ﰈ'
+	0xFC09:  "بى",                 // 'ﰉ'
+	0xFC0A:  "بى",                 // 'ﰊ'
+	0xFC0B:  "تج",                 // 'ﰋ'
+	0xFC0C:  "تح",                 // 'ﰌ'
+	0xFC0D:  "تخ",                 // 'ﰍ'
+	0xFC0E:  "تم",                 // 'ﰎ'
+	0xFC0F:  "تى",                 // 'ﰏ'
+	0xFC10:  "تى",                 // 'ﰐ'
+	0xFC11:  "ىۛج",                // 'ﰑ'
+	0xFC12:  "ىۛم",                // 'ﰒ'
+	0xFC13:  "ىۛى",                // 'ﰓ'
+	0xFC14:  "ىۛى",                // 'ﰔ'
+	0xFC15:  "جح",                 // 'ﰕ'
+	0xFC16:  "جم",                 // 'ﰖ'
+	0xFC17:  "حج",                 // 'ﰗ'
+	0xFC18:  "حم",                 // 'ﰘ'
+	0xFC19:  "خج",                 // 'ﰙ'
+	0xFC1A:  "خح",                 // 'ﰚ'
+	0xFC1B:  "خم",                 // 'ﰛ'
+	0xFC1C:  "سج",                 // 'ﰜ'
+	0xFC1D:  "سح",                 // 'ﰝ'
+	0xFC1E:  "سخ",                 // 'ﰞ'
+	0xFC1F:  "سم",                 // 'ﰟ'
+	0xFC20:  "صح",                 // 'ﰠ'
+	0xFC21:  "صم",                 // 'ﰡ'
+	0xFC22:  "ضج",                 // 'ﰢ'
+	0xFC23:  "ضح",                 // 'ﰣ'
+	0xFC24:  "ضخ",                 // 'ﰤ'
+	0xFC25:  "ضم",                 // 'ﰥ'
+	0xFC26:  "طح",                 // 'ﰦ'
+	0xFC27:  "طم",                 // 'ﰧ'
+	0xFC28:  "ظم",                 // 'ﰨ'
+	0xFC29:  "عج",                 // 'ﰩ'
+	0xFC2A:  "عم",                 // 'ﰪ'
+	0xFC2B:  "غج",                 // 'ﰫ'
+	0xFC2C:  "غم",                 // 'ﰬ'
+	0xFC2D:  "فج",                 // 'ﰭ'
+	0xFC2E:  "فح",                 // 'ﰮ'
+	0xFC2F:  "فخ",                 // 'ﰯ'
+	0xFC30:  "فم",                 // 'ﰰ'
+	0xFC31:  "فى",                 // 'ﰱ'
+	0xFC32:  "فى",                 // 'ﰲ'
+	0xFC33:  "قح",                 // 'ﰳ'
+	0xFC34:  "قم",                 // 'ﰴ'
+	0xFC35:  "قى",                 // 'ﰵ'
+	0xFC36:  "قى",                 // 'ﰶ'
+	0xFC37:  "كl",                 // 'ﰷ'
+	0xFC38:  "كج",                 // 'ﰸ'
+	0xFC39:  "كح",                 // 'ﰹ'
+	0xFC3A:  "كخ",                 // 'ﰺ'
+	0xFC3B:  "كل",                 // 'ﰻ'
+	0xFC3C:  "كم",                 // 'ﰼ'
+	0xFC3D:  "كى",                 // 'ﰽ'
+	0xFC3E:  "كى",                 // 'ﰾ'
+	0xFC3F:  "لج",                 // 'ﰿ'
+	0xFC40:  "لح",                 // 'ﱀ'
+	0xFC41:  "لخ",                 // 'ﱁ'
+	0xFC42:  "لم",                 // 'ﱂ'
+	0xFC43:  "لى",                 // 'ﱃ'
+	0xFC44:  "لى",                 // 'ﱄ'
+	0xFC45:  "مج",                 // 'ﱅ'
+	0xFC46:  "مح",                 // 'ﱆ'
+	0xFC47:  "مخ",                 // 'ﱇ'
+	0xFC48:  "مم",                 // 'ﱈ'
+	0xFC49:  "مى",                 // 'ﱉ'
+	0xFC4A:  "مى",                 // 'ﱊ'
+	0xFC4B:  "بخ",                 // 'ﱋ'
+	0xFC4C:  "نح",                 // 'ﱌ'
+	0xFC4D:  "نخ",                 // 'ﱍ'
+	0xFC4E:  "نم",                 // 'ﱎ'
+	0xFC4F:  "نى",                 // 'ﱏ'
+	0xFC50:  "نى",                 // 'ﱐ'
+	0xFC51:  "oج",                 // 'ﱑ'
+	0xFC52:  "oم",                 // 'ﱒ'
+	0xFC53:  "oى",                 // 'ﱓ'
+	0xFC54:  "oى",                 // 'ﱔ'
+	0xFC55:  "ىج",                 // 'ﱕ'
+	0xFC56:  "ىح",                 // 'ﱖ'
+	0xFC57:  "ىخ",                 // 'ﱗ'
+	0xFC58:  "ىم",                 // 'ﱘ'
+	0xFC59:  "ىى",                 // 'ﱙ'
+	0xFC5A:  "ىى",                 // 'ﱚ'
+	0xFC5B:  "ذٰ",                 // 'ﱛ'
+	0xFC5C:  "رٰ",                 // 'ﱜ'
+	0xFC5D:  "ىٰ",                 // 'ﱝ'
+	0xFC5E:  "ﹲّ",                 // 'ﱞ'
+	0xFC5F:  "ﹴّ",                 // 'ﱟ'
+	0xFC60:  "ﹶّ",                 // 'ﱠ'
+	0xFC61:  "ﹸّ",                 // 'ﱡ'
+	0xFC62:  "ﹺّ",                 // 'ﱢ'
+	0xFC63:  "ﹼٰ",                 // 'ﱣ'
+	0xFC64:  "ىٴر",                // 'ﱤ'
+	0xFC65:  "ىٴز",                // 'ﱥ'
+	0xFC66:  "ىٴم",                // 'ﱦ'
+	0xFC67:  "ىٴن",                // 'ﱧ'
+	0xFC68:  "ىٴى",                // 'ﱨ'
+	0xFC69:  "ىٴى",                // 'ﱩ'
+	0xFC6A:  "بر",                 // 'ﱪ'
+	0xFC6B:  "بز",                 // 'ﱫ'
+	0xFC6C:  "بم",                 // 'ﱬ'
+	0xFC6D:  "بن",                 // 'ﱭ'
+	0xFC6E:  "بى",                 // 'ﱮ'
+	0xFC6F:  "بى",                 // 'ﱯ'
+	0xFC70:  "تر",                 // 'ﱰ'
+	0xFC71:  "تز",                 // 'ﱱ'
+	0xFC72:  "تم",                 // 'ﱲ'
+	0xFC73:  "تن",                 // 'ﱳ'
+	0xFC74:  "تى",                 // 'ﱴ'
+	0xFC75:  "تى",                 // 'ﱵ'
+	0xFC76:  "ىۛر",                // 'ﱶ'
+	0xFC77:  "ىۛز",                // 'ﱷ'
+	0xFC78:  "ىۛم",                // 'ﱸ'
+	0xFC79:  "ىۛن",                // 'ﱹ'
+	0xFC7A:  "ىۛى",                // 'ﱺ'
+	0xFC7B:  "ىۛى",                // 'ﱻ'
+	0xFC7C:  "فى",                 // 'ﱼ'
+	0xFC7D:  "فى",                 // 'ﱽ'
+	0xFC7E:  "قى",                 // 'ﱾ'
+	0xFC7F:  "قى",                 // 'ﱿ'
+	0xFC80:  "كl",                 // 'ﲀ'
+	0xFC81:  "كل",                 // 'ﲁ'
+	0xFC82:  "كم",                 // 'ﲂ'
+	0xFC83:  "كى",                 // 'ﲃ'
+	0xFC84:  "كى",                 // 'ﲄ'
+	0xFC85:  "لم",                 // 'ﲅ'
+	0xFC86:  "لى",                 // 'ﲆ'
+	0xFC87:  "لى",                 // 'ﲇ'
+	0xFC88:  "مl",                 // 'ﲈ'
+	0xFC89:  "مم",                 // 'ﲉ'
+	0xFC8A:  "نر",                 // 'ﲊ'
+	0xFC8B:  "نز",                 // 'ﲋ'
+	0xFC8C:  "نم",                 // 'ﲌ'
+	0xFC8D:  "نن",                 // 'ﲍ'
+	0xFC8E:  "نى",                 // 'ﲎ'
+	0xFC8F:  "نى",                 // 'ﲏ'
+	0xFC90:  "ىٰ",                 // 'ﲐ'
+	0xFC91:  "ىر",                 // 'ﲑ'
+	0xFC92:  "ىز",                 // 'ﲒ'
+	0xFC93:  "ىم",                 // 'ﲓ'
+	0xFC94:  "ىن",                 // 'ﲔ'
+	0xFC95:  "ىى",                 // 'ﲕ'
+	0xFC96:  "ىى",                 // 'ﲖ'
+	0xFC97:  "ىٴج",                // 'ﲗ'
+	0xFC98:  "ىٴح",                // 'ﲘ'
+	0xFC99:  "ىٴخ",                // 'ﲙ'
+	0xFC9A:  "ىٴم",                // 'ﲚ'
+	0xFC9B:  "ىٴo",                // 'ﲛ'
+	0xFC9C:  "بج",                 // 'ﲜ'
+	0xFC9D:  "بح",                 // 'ﲝ'
+	0xFC9E:  "بخ",                 // 'ﲞ'
+	0xFC9F:  "بم",                 // 'ﲟ'
+	0xFCA0:  "بo",                 // 'ﲠ'
+	0xFCA1:  "تج",                 // 'ﲡ'
+	0xFCA2:  "تح",                 // 'ﲢ'
+	0xFCA3:  "تخ",                 // 'ﲣ'
+	0xFCA4:  "تم",                 // 'ﲤ'
+	0xFCA5:  "تo",                 // 'ﲥ'
+	0xFCA6:  "ىۛم",                // 'ﲦ'
+	0xFCA7:  "جح",                 // 'ﲧ'
+	0xFCA8:  "جم",                 // 'ﲨ'
+	0xFCA9:  "حج",                 // 'ﲩ'
+	0xFCAA:  "حم",                 // 'ﲪ'
+	0xFCAB:  "خج",                 // 'ﲫ'
+	0xFCAC:  "خم",                 // 'ﲬ'
+	0xFCAD:  "سج",                 // 'ﲭ'
+	0xFCAE:  "سح",                 // 'ﲮ'
+	0xFCAF:  "سخ",                 // 'ﲯ'
+	0xFCB0:  "سم",                 // 'ﲰ'
+	0xFCB1:  "صح",                 // 'ﲱ'
+	0xFCB2:  "صخ",                 // 'ﲲ'
+	0xFCB3:  "صم",                 // 'ﲳ'
+	0xFCB4:  "ضج",                 // 'ﲴ'
+	0xFCB5:  "ضح",                 // 'ﲵ'
+	0xFCB6:  "ضخ",                 // 'ﲶ'
+	0xFCB7:  "ضم",                 // 'ﲷ'
+	0xFCB8:  "طح",                 // 'ﲸ'
+	0xFCB9:  "ظم",                 // 'ﲹ'
+	0xFCBA:  "عج",                 // 'ﲺ'
+	0xFCBB:  "عم",                 // 'ﲻ'
+	0xFCBC:  "غج",                 // 'ﲼ'
+	0xFCBD:  "غم",                 // 'ﲽ'
+	0xFCBE:  "فج",                 // 'ﲾ'
+	0xFCBF:  "فح",                 // 'ﲿ'
+	0xFCC0:  "فخ",                 // 'ﳀ'
+	0xFCC1:  "فم",                 // 'ﳁ'
+	0xFCC2:  "قح",                 // 'ﳂ'
+	0xFCC3:  "قم",                 // 'ﳃ'
+	0xFCC4:  "كج",                 // 'ﳄ'
+	0xFCC5:  "كح",                 // 'ﳅ'
+	0xFCC6:  "كخ",                 // 'ﳆ'
+	0xFCC7:  "كل",                 // 'ﳇ'
+	0xFCC8:  "كم",                 // 'ﳈ'
+	0xFCC9:  "لج",                 // 'ﳉ'
+	0xFCCA:  "لح",                 // 'ﳊ'
+	0xFCCB:  "لخ",                 // 'ﳋ'
+	0xFCCC:  "لم",                 // 'ﳌ'
+	0xFCCD:  "لo",                 // 'ﳍ'
+	0xFCCE:  "مج",                 // 'ﳎ'
+	0xFCCF:  "مح",                 // 'ﳏ'
+	0xFCD0:  "مخ",                 // 'ﳐ'
+	0xFCD1:  "مم",                 // 'ﳑ'
+	0xFCD2:  "بخ",                 // 'ﳒ'
+	0xFCD3:  "نح",                 // 'ﳓ'
+	0xFCD4:  "نخ",                 // 'ﳔ'
+	0xFCD5:  "نم",                 // 'ﳕ'
+	0xFCD6:  "نo",                 // 'ﳖ'
+	0xFCD7:  "oج",                 // 'ﳗ'
+	0xFCD8:  "oم",                 // 'ﳘ'
+	0xFCD9:  "oٰ",                 // 'ﳙ'
+	0xFCDA:  "ىج",                 // 'ﳚ'
+	0xFCDB:  "ىح",                 // 'ﳛ'
+	0xFCDC:  "ىخ",                 // 'ﳜ'
+	0xFCDD:  "ىم",                 // 'ﳝ'
+	0xFCDE:  "ىo",                 // 'ﳞ'
+	0xFCDF:  "ىٴم",                // 'ﳟ'
+	0xFCE0:  "ىٴo",                // 'ﳠ'
+	0xFCE1:  "بم",                 // 'ﳡ'
+	0xFCE2:  "بo",                 // 'ﳢ'
+	0xFCE3:  "تم",                 // 'ﳣ'
+	0xFCE4:  "تo",                 // 'ﳤ'
+	0xFCE5:  "ىۛم",                // 'ﳥ'
+	0xFCE6:  "ىۛo",                // 'ﳦ'
+	0xFCE7:  "سم",                 // 'ﳧ'
+	0xFCE8:  "سo",                 // 'ﳨ'
+	0xFCE9:  "سۛم",                // 'ﳩ'
+	0xFCEA:  "سۛo",                // 'ﳪ'
+	0xFCEB:  "كل",                 // 'ﳫ'
+	0xFCEC:  "كم",                 // 'ﳬ'
+	0xFCED:  "لم",                 // 'ﳭ'
+	0xFCEE:  "نم",                 // 'ﳮ'
+	0xFCEF:  "نo",                 // 'ﳯ'
+	0xFCF0:  "ىم",                 // 'ﳰ'
+	0xFCF1:  "ىo",                 // 'ﳱ'
+	0xFCF2:  "ﹷّ",                 // 'ﳲ'
+	0xFCF3:  "ﹹّ",                 // 'ﳳ'
+	0xFCF4:  "ﹻّ",                 // 'ﳴ'
+	0xFCF5:  "طى",                 // 'ﳵ'
+	0xFCF6:  "طى",                 // 'ﳶ'
+	0xFCF7:  "عى",                 // 'ﳷ'
+	0xFCF8:  "عى",                 // 'ﳸ'
+	0xFCF9:  "غى",                 // 'ﳹ'
+	0xFCFA:  "غى",                 // 'ﳺ'
+	0xFCFB:  "سى",                 // 'ﳻ'
+	0xFCFC:  "سى",                 // 'ﳼ'
+	0xFCFD:  "سۛى",                // 'ﳽ'
+	0xFCFE:  "سۛى",                // 'ﳾ'
+	0xFCFF:  "حى",                 // 'ﳿ'
+	0xFD00:  "حى",                 // 'ﴀ'
+	0xFD01:  "جى",                 // 'ﴁ'
+	0xFD02:  "جى",                 // 'ﴂ'
+	0xFD03:  "خى",                 // 'ﴃ'
+	0xFD04:  "خى",                 // 'ﴄ'
+	0xFD05:  "صى",                 // 'ﴅ'
+	0xFD06:  "صى",                 // 'ﴆ'
+	0xFD07:  "ضى",                 // 'ﴇ'
+	0xFD08:  "ضى",                 // 'ﴈ'
+	0xFD09:  "سۛج",                // 'ﴉ'
+	0xFD0A:  "سۛح",                // 'ﴊ'
+	0xFD0B:  "سۛخ",                // 'ﴋ'
+	0xFD0C:  "سۛم",                // 'ﴌ'
+	0xFD0D:  "سۛر",                // 'ﴍ'
+	0xFD0E:  "سر",                 // 'ﴎ'
+	0xFD0F:  "صر",                 // 'ﴏ'
+	0xFD10:  "ضر",                 // 'ﴐ'
+	0xFD11:  "طى",                 // 'ﴑ'
+	0xFD12:  "طى",                 // 'ﴒ'
+	0xFD13:  "عى",                 // 'ﴓ'
+	0xFD14:  "عى",                 // 'ﴔ'
+	0xFD15:  "غى",                 // 'ﴕ'
+	0xFD16:  "غى",                 // 'ﴖ'
+	0xFD17:  "سى",                 // 'ﴗ'
+	0xFD18:  "سى",                 // 'ﴘ'
+	0xFD19:  "سۛى",                // 'ﴙ'
+	0xFD1A:  "سۛى",                // 'ﴚ'
+	0xFD1B:  "حى",                 // 'ﴛ'
+	0xFD1C:  "حى",                 // 'ﴜ'
+	0xFD1D:  "جى",                 // 'ﴝ'
+	0xFD1E:  "جى",                 // 'ﴞ'
+	0xFD1F:  "خى",                 // 'ﴟ'
+	0xFD20:  "خى",                 // 'ﴠ'
+	0xFD21:  "صى",                 // 'ﴡ'
+	0xFD22:  "صى",                 // 'ﴢ'
+	0xFD23:  "ضى",                 // 'ﴣ'
+	0xFD24:  "ضى",                 // 'ﴤ'
+	0xFD25:  "سۛج",                // 'ﴥ'
+	0xFD26:  "سۛح",                // 'ﴦ'
+	0xFD27:  "سۛخ",                // 'ﴧ'
+	0xFD28:  "سۛم",                // 'ﴨ'
+	0xFD29:  "سۛر",                // 'ﴩ'
+	0xFD2A:  "سر",                 // 'ﴪ'
+	0xFD2B:  "صر",                 // 'ﴫ'
+	0xFD2C:  "ضر",                 // 'ﴬ'
+	0xFD2D:  "سۛج",                // 'ﴭ'
+	0xFD2E:  "سۛح",                // 'ﴮ'
+	0xFD2F:  "سۛخ",                // 'ﴯ'
+	0xFD30:  "سۛم",                // 'ﴰ'
+	0xFD31:  "سo",                 // 'ﴱ'
+	0xFD32:  "سۛo",                // 'ﴲ'
+	0xFD33:  "طم",                 // 'ﴳ'
+	0xFD34:  "سج",                 // 'ﴴ'
+	0xFD35:  "سح",                 // 'ﴵ'
+	0xFD36:  "سخ",                 // 'ﴶ'
+	0xFD37:  "سۛج",                // 'ﴷ'
+	0xFD38:  "سۛح",                // 'ﴸ'
+	0xFD39:  "سۛخ",                // 'ﴹ'
+	0xFD3A:  "طم",                 // 'ﴺ'
+	0xFD3B:  "ظم",                 // 'ﴻ'
+	0xFD3C:  "l̋",                 // 'ﴼ'
+	0xFD3D:  "l̋",                 // 'ﴽ'
+	0xFD3E:  "(",                  // '﴾'
+	0xFD3F:  ")",                  // '﴿'
+	0xFD50:  "تجم",                // 'ﵐ'
+	0xFD51:  "تحج",                // 'ﵑ'
+	0xFD52:  "تحج",                // 'ﵒ'
+	0xFD53:  "تحم",                // 'ﵓ'
+	0xFD54:  "تخم",                // 'ﵔ'
+	0xFD55:  "تمج",                // 'ﵕ'
+	0xFD56:  "تمح",                // 'ﵖ'
+	0xFD57:  "تمخ",                // 'ﵗ'
+	0xFD58:  "جمح",                // 'ﵘ'
+	0xFD59:  "جمح",                // 'ﵙ'
+	0xFD5A:  "حمى",                // 'ﵚ'
+	0xFD5B:  "حمى",                // 'ﵛ'
+	0xFD5C:  "سحج",                // 'ﵜ'
+	0xFD5D:  "سجح",                // 'ﵝ'
+	0xFD5E:  "سجى",                // 'ﵞ'
+	0xFD5F:  "سمح",                // 'ﵟ'
+	0xFD60:  "سمح",                // 'ﵠ'
+	0xFD61:  "سمج",                // 'ﵡ'
+	0xFD62:  "سمم",                // 'ﵢ'
+	0xFD63:  "سمم",                // 'ﵣ'
+	0xFD64:  "صحح",                // 'ﵤ'
+	0xFD65:  "صحح",                // 'ﵥ'
+	0xFD66:  "صمم",                // 'ﵦ'
+	0xFD67:  "سۛحم",               // 'ﵧ'
+	0xFD68:  "سۛحم",               // 'ﵨ'
+	0xFD69:  "سۛجى",               // 'ﵩ'
+	0xFD6A:  "سۛمخ",               // 'ﵪ'
+	0xFD6B:  "سۛمخ",               // 'ﵫ'
+	0xFD6C:  "سۛمم",               // 'ﵬ'
+	0xFD6D:  "سۛمم",               // 'ﵭ'
+	0xFD6E:  "ضحى",                // 'ﵮ'
+	0xFD6F:  "ضخم",                // 'ﵯ'
+	0xFD70:  "ضخم",                // 'ﵰ'
+	0xFD71:  "طمح",                // 'ﵱ'
+	0xFD72:  "طمح",                // 'ﵲ'
+	0xFD73:  "طمم",                // 'ﵳ'
+	0xFD74:  "طمى",                // 'ﵴ'
+	0xFD75:  "عجم",                // 'ﵵ'
+	0xFD76:  "عمم",                // 'ﵶ'
+	0xFD77:  "عمم",                // 'ﵷ'
+	0xFD78:  "عمى",                // 'ﵸ'
+	0xFD79:  "غمم",                // 'ﵹ'
+	0xFD7A:  "غمى",                // 'ﵺ'
+	0xFD7B:  "غمى",                // 'ﵻ'
+	0xFD7C:  "فخم",                // 'ﵼ'
+	0xFD7D:  "فخم",                // 'ﵽ'
+	0xFD7E:  "قمح",                // 'ﵾ'
+	0xFD7F:  "قمم",                // 'ﵿ'
+	0xFD80:  "لحم",                // 'ﶀ'
+	0xFD81:  "لحى",                // 'ﶁ'
+	0xFD82:  "لحى",                // 'ﶂ'
+	0xFD83:  "لجج",                // 'ﶃ'
+	0xFD84:  "لجج",                // 'ﶄ'
+	0xFD85:  "لخم",                // 'ﶅ'
+	0xFD86:  "لخم",                // 'ﶆ'
+	0xFD87:  "لمح",                // 'ﶇ'
+	0xFD88:  "لمح",                // 'ﶈ'
+	0xFD89:  "محج",                // 'ﶉ'
+	0xFD8A:  "محم",                // 'ﶊ'
+	0xFD8B:  "محى",                // 'ﶋ'
+	0xFD8C:  "مجح",                // 'ﶌ'
+	0xFD8D:  "مجم",                // 'ﶍ'
+	0xFD8E:  "مخج",                // 'ﶎ'
+	0xFD8F:  "مخم",                // 'ﶏ'
+	0xFD92:  "مجخ",                // 'ﶒ'
+	0xFD93:  "oمج",                // 'ﶓ'
+	0xFD94:  "oمم",                // 'ﶔ'
+	0xFD95:  "نحم",                // 'ﶕ'
+	0xFD96:  "نحى",                // 'ﶖ'
+	0xFD97:  "نجم",                // 'ﶗ'
+	0xFD98:  "نجم",                // 'ﶘ'
+	0xFD99:  "نجى",                // 'ﶙ'
+	0xFD9A:  "نمى",                // 'ﶚ'
+	0xFD9B:  "نمى",                // 'ﶛ'
+	0xFD9C:  "ىمم",                // 'ﶜ'
+	0xFD9D:  "ىمم",                // 'ﶝ'
+	0xFD9E:  "بخى",                // 'ﶞ'
+	0xFD9F:  "تجى",                // 'ﶟ'
+	0xFDA0:  "تجى",                // 'ﶠ'
+	0xFDA1:  "تخى",                // 'ﶡ'
+	0xFDA2:  "تخى",                // 'ﶢ'
+	0xFDA3:  "تمى",                // 'ﶣ'
+	0xFDA4:  "تمى",                // 'ﶤ'
+	0xFDA5:  "جمى",                // 'ﶥ'
+	0xFDA6:  "جحى",                // 'ﶦ'
+	0xFDA7:  "جمى",                // 'ﶧ'
+	0xFDA8:  "سخى",                // 'ﶨ'
+	0xFDA9:  "صحى",                // 'ﶩ'
+	0xFDAA:  "سۛحى",               // 'ﶪ'
+	0xFDAB:  "ضحى",                // 'ﶫ'
+	0xFDAC:  "لجى",                // 'ﶬ'
+	0xFDAD:  "لمى",                // 'ﶭ'
+	0xFDAE:  "ىحى",                // 'ﶮ'
+	0xFDAF:  "ىجى",                // 'ﶯ'
+	0xFDB0:  "ىمى",                // 'ﶰ'
+	0xFDB1:  "ممى",                // 'ﶱ'
+	0xFDB2:  "قمى",                // 'ﶲ'
+	0xFDB3:  "نحى",                // 'ﶳ'
+	0xFDB4:  "قمح",                // 'ﶴ'
+	0xFDB5:  "لحم",                // 'ﶵ'
+	0xFDB6:  "عمى",                // 'ﶶ'
+	0xFDB7:  "كمى",                // 'ﶷ'
+	0xFDB8:  "نجح",                // 'ﶸ'
+	0xFDB9:  "مخى",                // 'ﶹ'
+	0xFDBA:  "لجم",                // 'ﶺ'
+	0xFDBB:  "كمم",                // 'ﶻ'
+	0xFDBC:  "لجم",                // 'ﶼ'
+	0xFDBD:  "نجح",                // 'ﶽ'
+	0xFDBE:  "جحى",                // 'ﶾ'
+	0xFDBF:  "حجى",                // 'ﶿ'
+	0xFDC0:  "مجى",                // 'ﷀ'
+	0xFDC1:  "فمى",                // 'ﷁ'
+	0xFDC2:  "بحى",                // 'ﷂ'
+	0xFDC3:  "كمم",                // 'ﷃ'
+	0xFDC4:  "عجم",                // 'ﷄ'
+	0xFDC5:  "صمم",                // 'ﷅ'
+	0xFDC6:  "سخى",                // 'ﷆ'
+	0xFDC7:  "نجى",                // 'ﷇ'
+	0xFDF0:  "صلى",                // 'ﷰ'
+	0xFDF1:  "قلى",                // 'ﷱ'
+	0xFDF2:  "lللّٰo",             // 'ﷲ'
+	0xFDF3:  "lكبر",               // 'ﷳ'
+	0xFDF4:  "محمد",               // 'ﷴ'
+	0xFDF5:  "صلعم",               // 'ﷵ'
+	0xFDF6:  "رسول",               // 'ﷶ'
+	0xFDF7:  "علىo",               // 'ﷷ'
+	0xFDF8:  "وسلم",               // 'ﷸ'
+	0xFDF9:  "صلى",                // 'ﷹ'
+	0xFDFA:  "صلى lللo علىo وسلم", // 'ﷺ'
+	0xFDFB:  "جل جلlلo",           // 'ﷻ'
+	0xFDFC:  "رىlل",               // '﷼'
+	0xFE19:  "ⵗ",                  // '︙'
+	0xFE30:  ":",                  // '︰'
+	0xFE31:  "│",                  // '︱'
+	0xFE34:  "⌇",                  // '︴'
+	0xFE35:  "⏜",                  // '︵'
+	0xFE36:  "⏝",                  // '︶'
+	0xFE37:  "⏞",                  // '︷'
+	0xFE38:  "⏟",                  // '︸'
+	0xFE39:  "⏠",                  // '︹'
+	0xFE3A:  "⏡",                  // '︺'
+	0xFE49:  "ˉ",                  // '﹉'
+	0xFE4A:  "ˉ",                  // '﹊'
+	0xFE4B:  "ˉ",                  // '﹋'
+	0xFE4C:  "ˉ",                  // '﹌'
+	0xFE4D:  "_",                  // '﹍'
+	0xFE4E:  "_",                  // '﹎'
+	0xFE4F:  "_",                  // '﹏'
+	0xFE58:  "-",                  // '﹘'
+	0xFE68:  "\\",                 // '﹨'
+	0xFE80:  "ء",                  // 'ﺀ'
+	0xFE81:  "آ",                  // 'ﺁ'
+	0xFE82:  "آ",                  // 'ﺂ'
+	0xFE83:  "lٴ",                 // 'ﺃ'
+	0xFE84:  "lٴ",                 // 'ﺄ'
+	0xFE85:  "وٴ",                 // 'ﺅ'
+	0xFE86:  "وٴ",                 // 'ﺆ'
+	0xFE87:  "lٕ",                 // 'ﺇ'
+	0xFE88:  "lٕ",                 // 'ﺈ'
+	0xFE89:  "ىٴ",                 // 'ﺉ'
+	0xFE8A:  "ىٴ",                 // 'ﺊ'
+	0xFE8B:  "ىٴ",                 // 'ﺋ'
+	0xFE8C:  "ىٴ",                 // 'ﺌ'
+	0xFE8D:  "l",                  // 'ﺍ'
+	0xFE8E:  "l",                  // 'ﺎ'
+	0xFE8F:  "ب",                  // 'ﺏ'
+	0xFE90:  "ب",                  // 'ﺐ'
+	0xFE91:  "ب",                  // 'ﺑ'
+	0xFE92:  "ب",                  // 'ﺒ'
+	0xFE93:  "ة",                  // 'ﺓ'
+	0xFE94:  "ة",                  // 'ﺔ'
+	0xFE95:  "ت",                  // 'ﺕ'
+	0xFE96:  "ت",                  // 'ﺖ'
+	0xFE97:  "ت",                  // 'ﺗ'
+	0xFE98:  "ت",                  // 'ﺘ'
+	0xFE99:  "ىۛ",                 // 'ﺙ'
+	0xFE9A:  "ىۛ",                 // 'ﺚ'
+	0xFE9B:  "ىۛ",                 // 'ﺛ'
+	0xFE9C:  "ىۛ",                 // 'ﺜ'
+	0xFE9D:  "ج",                  // 'ﺝ'
+	0xFE9E:  "ج",                  // 'ﺞ'
+	0xFE9F:  "ج",                  // 'ﺟ'
+	0xFEA0:  "ج",                  // 'ﺠ'
+	0xFEA1:  "ح",                  // 'ﺡ'
+	0xFEA2:  "ح",                  // 'ﺢ'
+	0xFEA3:  "ح",                  // 'ﺣ'
+	0xFEA4:  "ح",                  // 'ﺤ'
+	0xFEA5:  "خ",                  // 'ﺥ'
+	0xFEA6:  "خ",                  // 'ﺦ'
+	0xFEA7:  "خ",                  // 'ﺧ'
+	0xFEA8:  "خ",                  // 'ﺨ'
+	0xFEA9:  "د",                  // 'ﺩ'
+	0xFEAA:  "د",                  // 'ﺪ'
+	0xFEAB:  "ذ",                  // 'ﺫ'
+	0xFEAC:  "ذ",                  // 'ﺬ'
+	0xFEAD:  "ر",                  // 'ﺭ'
+	0xFEAE:  "ر",                  // 'ﺮ'
+	0xFEAF:  "ز",                  // 'ﺯ'
+	0xFEB0:  "ز",                  // 'ﺰ'
+	0xFEB1:  "س",                  // 'ﺱ'
+	0xFEB2:  "س",                  // 'ﺲ'
+	0xFEB3:  "س",                  // 'ﺳ'
+	0xFEB4:  "س",                  // 'ﺴ'
+	0xFEB5:  "سۛ",                 // 'ﺵ'
+	0xFEB6:  "سۛ",                 // 'ﺶ'
+	0xFEB7:  "سۛ",                 // 'ﺷ'
+	0xFEB8:  "سۛ",                 // 'ﺸ'
+	0xFEB9:  "ص",                  // 'ﺹ'
+	0xFEBA:  "ص",                  // 'ﺺ'
+	0xFEBB:  "ص",                  // 'ﺻ'
+	0xFEBC:  "ص",                  // 'ﺼ'
+	0xFEBD:  "ض",                  // 'ﺽ'
+	0xFEBE:  "ض",                  // 'ﺾ'
+	0xFEBF:  "ض",                  // 'ﺿ'
+	0xFEC0:  "ض",                  // 'ﻀ'
+	0xFEC1:  "ط",                  // 'ﻁ'
+	0xFEC2:  "ط",                  // 'ﻂ'
+	0xFEC3:  "ط",                  // 'ﻃ'
+	0xFEC4:  "ط",                  // 'ﻄ'
+	0xFEC5:  "ظ",                  // 'ﻅ'
+	0xFEC6:  "ظ",                  // 'ﻆ'
+	0xFEC7:  "ظ",                  // 'ﻇ'
+	0xFEC8:  "ظ",                  // 'ﻈ'
+	0xFEC9:  "ع",                  // 'ﻉ'
+	0xFECA:  "ع",                  // 'ﻊ'
+	0xFECB:  "ع",                  // 'ﻋ'
+	0xFECC:  "ع",                  // 'ﻌ'
+	0xFECD:  "غ",                  // 'ﻍ'
+	0xFECE:  "غ",                  // 'ﻎ'
+	0xFECF:  "غ",                  // 'ﻏ'
+	0xFED0:  "غ",                  // 'ﻐ'
+	0xFED1:  "ف",                  // 'ﻑ'
+	0xFED2:  "ف",                  // 'ﻒ'
+	0xFED3:  "ف",                  // 'ﻓ'
+	0xFED4:  "ف",                  // 'ﻔ'
+	0xFED5:  "ق",                  // 'ﻕ'
+	0xFED6:  "ق",                  // 'ﻖ'
+	0xFED7:  "ق",                  // 'ﻗ'
+	0xFED8:  "ق",                  // 'ﻘ'
+	0xFED9:  "ك",                  // 'ﻙ'
+	0xFEDA:  "ك",                  // 'ﻚ'
+	0xFEDB:  "ك",                  // 'ﻛ'
+	0xFEDC:  "ك",                  // 'ﻜ'
+	0xFEDD:  "ل",                  // 'ﻝ'
+	0xFEDE:  "ل",                  // 'ﻞ'
+	0xFEDF:  "ل",                  // 'ﻟ'
+	0xFEE0:  "ل",                  // 'ﻠ'
+	0xFEE1:  "م",                  // 'ﻡ'
+	0xFEE2:  "م",                  // 'ﻢ'
+	0xFEE3:  "م",                  // 'ﻣ'
+	0xFEE4:  "م",                  // 'ﻤ'
+	0xFEE5:  "ن",                  // 'ﻥ'
+	0xFEE6:  "ن",                  // 'ﻦ'
+	0xFEE7:  "ن",                  // 'ﻧ'
+	0xFEE8:  "ن",                  // 'ﻨ'
+	0xFEE9:  "o",                  // 'ﻩ'
+	0xFEEA:  "o",                  // 'ﻪ'
+	0xFEEB:  "o",                  // 'ﻫ'
+	0xFEEC:  "o",                  // 'ﻬ'
+	0xFEED:  "و",                  // 'ﻭ'
+	0xFEEE:  "و",                  // 'ﻮ'
+	0xFEEF:  "ى",                  // 'ﻯ'
+	0xFEF0:  "ى",                  // 'ﻰ'
+	0xFEF1:  "ى",                  // 'ﻱ'
+	0xFEF2:  "ى",                  // 'ﻲ'
+	0xFEF3:  "ى",                  // 'ﻳ'
+	0xFEF4:  "ى",                  // 'ﻴ'
+	0xFEF5:  "لآ",                 // 'ﻵ'
+	0xFEF6:  "لآ",                 // 'ﻶ'
+	0xFEF7:  "لlٴ",                // 'ﻷ'
+	0xFEF8:  "لlٴ",                // 'ﻸ'
+	0xFEF9:  "لlٕ",                // 'ﻹ'
+	0xFEFA:  "لlٕ",                // 'ﻺ'
+	0xFEFB:  "لl",                 // 'ﻻ'
+	0xFEFC:  "لl",                 // 'ﻼ'
+	0xFF01:  "!",                  // '！'
+	0xFF02:  "''",                 // '＂'
+	0xFF07:  "'",                  // '＇'
+	0xFF0D:  "ー",                  // '－'
+	0xFF1A:  ":",                  // '：'
+	0xFF21:  "A",                  // 'Ａ'
+	0xFF22:  "B",                  // 'Ｂ'
+	0xFF23:  "C",                  // 'Ｃ'
+	0xFF25:  "E",                  // 'Ｅ'
+	0xFF28:  "H",                  // 'Ｈ'
+	0xFF29:  "l",                  // 'Ｉ'
+	0xFF2A:  "J",                  // 'Ｊ'
+	0xFF2B:  "K",                  // 'Ｋ'
+	0xFF2D:  "M",                  // 'Ｍ'
+	0xFF2E:  "N",                  // 'Ｎ'
+	0xFF2F:  "O",                  // 'Ｏ'
+	0xFF30:  "P",                  // 'Ｐ'
+	0xFF33:  "S",                  // 'Ｓ'
+	0xFF34:  "T",                  // 'Ｔ'
+	0xFF38:  "X",                  // 'Ｘ'
+	0xFF39:  "Y",                  // 'Ｙ'
+	0xFF3A:  "Z",                  // 'Ｚ'
+	0xFF3B:  "(",                  // '［'
+	0xFF3C:  "\\",                 // '＼'
+	0xFF3D:  ")",                  // '］'
+	0xFF3E:  "︿",                  // '＾'
+	0xFF40:  "'",                  // '｀'
+	0xFF41:  "a",                  // 'ａ'
+	0xFF43:  "c",                  // 'ｃ'
+	0xFF45:  "e",                  // 'ｅ'
+	0xFF47:  "g",                  // 'ｇ'
+	0xFF48:  "h",                  // 'ｈ'
+	0xFF49:  "i",                  // 'ｉ'
+	0xFF4A:  "j",                  // 'ｊ'
+	0xFF4C:  "l",                  // 'ｌ'
+	0xFF4F:  "o",                  // 'ｏ'
+	0xFF50:  "p",                  // 'ｐ'
+	0xFF53:  "s",                  // 'ｓ'
+	0xFF56:  "v",                  // 'ｖ'
+	0xFF58:  "x",                  // 'ｘ'
+	0xFF59:  "y",                  // 'ｙ'
+	0xFF5C:  "│",                  // '｜'
+	0xFF5E:  "〜",                  // '～'
+	0xFF65:  "·",                  // '･'
+	0xFFE3:  "ˉ",                  // '￣'
+	0xFFE8:  "l",                  // '￨'
+	0xFFED:  "▪",                  // '￭'
+	0x10101: "·",                  // '𐄁'
+	0x1018E: "N̊",                 // '𐆎'
+	0x10196: "X̵",                 // '𐆖'
+	0x10197: "V̵",                 // '𐆗'
+	0x10198: "l̵l̵S̵",             // '𐆘'
+	0x10199: "l̵l̵",               // '𐆙'
+	0x101A0: "⳨",                  // '𐆠'
+	0x10282: "B",                  // '𐊂'
+	0x10285: "Δ",                  // '𐊅'
+	0x10286: "E",                  // '𐊆'
+	0x10287: "F",                  // '𐊇'
+	0x1028A: "l",                  // '𐊊'
+	0x1028D: "Ʌ",                  // '𐊍'
+	0x10290: "X",                  // '𐊐'
+	0x10292: "O",                  // '𐊒'
+	0x10294: "ᛜ",                  // '𐊔'
+	0x10295: "P",                  // '𐊕'
+	0x10296: "S",                  // '𐊖'
+	0x10297: "T",                  // '𐊗'
+	0x1029B: "+",                  // '𐊛'
+	0x102A0: "A",                  // '𐊠'
+	0x102A1: "B",                  // '𐊡'
+	0x102A2: "C",                  // '𐊢'
+	0x102A3: "Δ",                  // '𐊣'
+	0x102A5: "F",                  // '𐊥'
+	0x102AB: "O",                  // '𐊫'
+	0x102AD: "Ϙ",                  // '𐊭'
+	0x102B0: "M",                  // '𐊰'
+	0x102B1: "T",                  // '𐊱'
+	0x102B2: "Y",                  // '𐊲'
+	0x102B3: "Φ",                  // '𐊳'
+	0x102B4: "X",                  // '𐊴'
+	0x102B5: "Ψ",                  // '𐊵'
+	0x102B6: "Ω",                  // '𐊶'
+	0x102B8: "ⵀ",                  // '𐊸'
+	0x102CF: "H",                  // '𐋏'
+	0x102E1: "د",                  // '𐋡'
+	0x102E4: "و",                  // '𐋤'
+	0x102E8: "ط",                  // '𐋨'
+	0x102F2: "ص",                  // '𐋲'
+	0x102F5: "Z",                  // '𐋵'
+	0x10301: "B",                  // '𐌁'
+	0x10302: "C",                  // '𐌂'
+	0x10309: "l",                  // '𐌉'
+	0x10311: "M",                  // '𐌑'
+	0x10312: "Ϙ",                  // '𐌒'
+	0x10315: "T",                  // '𐌕'
+	0x10317: "X",                  // '𐌗'
+	0x1031A: "8",                  // '𐌚'
+	0x1031F: "*",                  // '𐌟'
+	0x10320: "l",                  // '𐌠'
+	0x10322: "X",                  // '𐌢'
+	0x103D1: "𐎂",                  // '𐏑'
+	0x103D3: "𐎓",                  // '𐏓'
+	0x10401: "Ɛ",                  // '𐐁'
+	0x10404: "O",                  // '𐐄'
+	0x10411: "ꓶ",                  // '𐐑'
+	0x10415: "C",                  // '𐐕'
+	0x1041B: "L",                  // '𐐛'
+	0x1041F: "Ɒ",                  // '𐐟'
+	0x10420: "S",                  // '𐐠'
+	0x10423: "Ɔ",                  // '𐐣'
+	0x10425: "И",                  // '𐐥'
+	0x10429: "ꞓ",                  // '𐐩'
+	0x1042A: "ʚ",                  // '𐐪'
+	0x1042C: "o",                  // '𐐬'
+	0x1043D: "c",                  // '𐐽'
+	0x1043F: "ɷ",                  // '𐐿'
+	0x10442: "ɞ",                  // '𐑂'
+	0x10443: "ʟ",                  // '𐑃'
+	0x10448: "s",                  // '𐑈'
+	0x1044B: "ɔ",                  // '𐑋'
+	0x1044D: "ᴎ",                  // '𐑍'
+	0x104A0: "𐒆",                  // '𐒠'
+	0x104B0: "Ʌ",                  // '𐒰'
+	0x104B4: "R",                  // '𐒴'
+	0x104BC: "Ӄ",                  // '𐒼'
+	0x104C2: "O",                  // '𐓂'
+	0x104C3: "ʘ",                  // '𐓃'
+	0x104C4: "Þ",                  // '𐓄'
+	0x104CD: "Ћ",                  // '𐓍'
+	0x104CE: "U",                  // '𐓎'
+	0x104D0: "ᛦ",                  // '𐓐'
+	0x104D1: "Ψ",                  // '𐓑'
+	0x104D2: "7",                  // '𐓒'
+	0x104D8: "ʌ",                  // '𐓘'
+	0x104DB: "λ",                  // '𐓛'
+	0x104EA: "o",                  // '𐓪'
+	0x104EB: "ꙩ",                  // '𐓫'
+	0x104F6: "u",                  // '𐓶'
+	0x104F9: "ψ",                  // '𐓹'
+	0x10513: "N",                  // '𐔓'
+	0x10516: "O",                  // '𐔖'
+	0x10518: "K",                  // '𐔘'
+	0x1051C: "C",                  // '𐔜'
+	0x1051D: "V",                  // '𐔝'
+	0x10525: "F",                  // '𐔥'
+	0x10526: "L",                  // '𐔦'
+	0x10527: "X",                  // '𐔧'
+	0x10A3A: "̣",                  // '𐨺'
+	0x10A50: ".",                  // '𐩐'
+	0x10A57: "𐩖𐩖",                 // '𐩗'
+	0x10CFA: "𐲥",                  // '𐳺'
+	0x10CFC: "𐲂",                  // '𐳼'
+	0x110BB: "॰",                  // '𑂻'
+	0x111C7: "॰",                  // '𑇇'
+	0x111CA: "̣",                  // '𑇊'
+	0x111CB: "ऺ",                  // '𑇋'
+	0x111DB: "꣼",                  // '𑇛'
+	0x111DC: "ꣻ",                  // '𑇜'
+	0x111DE: "≈",                  // '𑇞'
+	0x11300: "̊",                  // '𑌀'
+	0x11413: "𑐴𑑂𑐒",                // '𑐓'
+	0x11419: "𑐴𑑂𑐘",                // '𑐙'
+	0x11424: "𑐴𑑂𑐣",                // '𑐤'
+	0x1142A: "𑐴𑑂𑐩",                // '𑐪'
+	0x1142D: "𑐴𑑂𑐬",                // '𑐭'
+	0x1142F: "𑐴𑑂𑐮",                // '𑐯'
+	0x1144C: "𑑋𑑋",                 // '𑑌'
+	0x11492: "ঘ",                  // '𑒒'
+	0x11494: "চ",                  // '𑒔'
+	0x11496: "জ",                  // '𑒖'
+	0x11498: "ঞ",                  // '𑒘'
+	0x11499: "ট",                  // '𑒙'
+	0x1149B: "ড",                  // '𑒛'
+	0x1149D: "ল",                  // '𑒝'
+	0x1149E: "ত",                  // '𑒞'
+	0x1149F: "থ",                  // '𑒟'
+	0x114A0: "দ",                  // '𑒠'
+	0x114A1: "ধ",                  // '𑒡'
+	0x114A2: "ন",                  // '𑒢'
+	0x114A3: "প",                  // '𑒣'
+	0x114A7: "ম",                  // '𑒧'
+	0x114A8: "য",                  // '𑒨'
+	0x114A9: "ব",                  // '𑒩'
+	0x114AA: "ণ",                  // '𑒪'
+	0x114AB: "র",                  // '𑒫'
+	0x114AD: "ষ",                  // '𑒭'
+	0x114AE: "স",                  // '𑒮'
+	0x114B0: "া",                  // '𑒰'
+	0x114B1: "ি",                  // '𑒱'
+	0x114B9: "ে",                  // '𑒹'
+	0x114BC: "ো",                  // '𑒼'
+	0x114BD: "ৗ",                  // '𑒽'
+	0x114BE: "ৌ",                  // '𑒾'
+	0x114BF: "̆̇",                 // '𑒿'
+	0x114C1: "ঃ",                  // '𑓁'
+	0x114C2: "্",                  // '𑓂'
+	0x114C3: "̣",                  // '𑓃'
+	0x114C4: "ঽ",                  // '𑓄'
+	0x114C5: "ẇ",                 // '𑓅'
+	0x114D0: "O",                  // '𑓐'
+	0x114D1: "১",                  // '𑓑'
+	0x114D2: "২",                  // '𑓒'
+	0x114D6: "৬",                  // '𑓖'
+	0x115D8: "𑖂",                  // '𑗘'
+	0x115D9: "𑖂",                  // '𑗙'
+	0x115DA: "𑖃",                  // '𑗚'
+	0x115DB: "𑖄",                  // '𑗛'
+	0x115DC: "𑖲",                  // '𑗜'
+	0x115DD: "𑖳",                  // '𑗝'
+	0x11642: "𑙁𑙁",                 // '𑙂'
+	0x11700: "rn",                 // '𑜀'
+	0x11706: "v",                  // '𑜆'
+	0x1170A: "w",                  // '𑜊'
+	0x1170E: "w",                  // '𑜎'
+	0x1170F: "w",                  // '𑜏'
+	0x118A0: "V",                  // '𑢠'
+	0x118A2: "F",                  // '𑢢'
+	0x118A3: "L",                  // '𑢣'
+	0x118A4: "Y",                  // '𑢤'
+	0x118A6: "E",                  // '𑢦'
+	0x118A8: "∇",                  // '𑢨'
+	0x118A9: "Z",                  // '𑢩'
+	0x118AC: "9",                  // '𑢬'
+	0x118AE: "E",                  // '𑢮'
+	0x118AF: "4",                  // '𑢯'
+	0x118B2: "L",                  // '𑢲'
+	0x118B5: "O",                  // '𑢵'
+	0x118B7: "ᛜ",                  // '𑢷'
+	0x118B8: "U",                  // '𑢸'
+	0x118BB: "5",                  // '𑢻'
+	0x118BC: "T",                  // '𑢼'
+	0x118C0: "v",                  // '𑣀'
+	0x118C1: "s",                  // '𑣁'
+	0x118C2: "F",                  // '𑣂'
+	0x118C3: "i",                  // '𑣃'
+	0x118C4: "z",                  // '𑣄'
+	0x118C6: "7",                  // '𑣆'
+	0x118C8: "o",                  // '𑣈'
+	0x118CA: "3",                  // '𑣊'
+	0x118CC: "9",                  // '𑣌'
+	0x118CE: "ꞓ",                  // '𑣎'
+	0x118D5: "6",                  // '𑣕'
+	0x118D6: "9",                  // '𑣖'
+	0x118D7: "o",                  // '𑣗'
+	0x118D8: "u",                  // '𑣘'
+	0x118DC: "y",                  // '𑣜'
+	0x118E0: "O",                  // '𑣠'
+	0x118E3: "rn",                 // '𑣣'
+	0x118E4: "٩",                  // '𑣤'
+	0x118E5: "Z",                  // '𑣥'
+	0x118E6: "W",                  // '𑣦'
+	0x118E9: "C",                  // '𑣩'
+	0x118EC: "X",                  // '𑣬'
+	0x118EF: "W",                  // '𑣯'
+	0x118F2: "C",                  // '𑣲'
+	0x11AE6: "𑫥𑫯",                 // '𑫦'
+	0x11AE7: "𑫥𑫰",                 // '𑫧'
+	0x11AE8: "𑫥𑫥",                 // '𑫨'
+	0x11AE9: "𑫥𑫥𑫯",                // '𑫩'
+	0x11AEA: "𑫥𑫥𑫰",                // '𑫪'
+	0x11AEC: "𑫫𑫯",                 // '𑫬'
+	0x11AED: "𑫫𑫫",                 // '𑫭'
+	0x11AEE: "𑫫𑫫𑫯",                // '𑫮'
+	0x11AF4: "𑫳𑫯",                 // '𑫴'
+	0x11AF5: "𑫳𑫰",                 // '𑫵'
+	0x11AF6: "𑫳𑫳",                 // '𑫶'
+	0x11AF7: "𑫳𑫳𑫯",                // '𑫷'
+	0x11AF8: "𑫳𑫳𑫰",                // '𑫸'
+	0x11C42: "𑱁𑱁",                 // '𑱂'
+	0x11CB2: "𑲪",                  // '𑲲'
+	0x12038: "𐎚",                  // '𒀸'
+	0x132F9: "𐦞",                  // '𓋹'
+	0x16F07: "Γ",                  // '𖼇'
+	0x16F08: "V",                  // '𖼈'
+	0x16F0A: "T",                  // '𖼊'
+	0x16F16: "L",                  // '𖼖'
+	0x16F1A: "Δ",                  // '𖼚'
+	0x16F1C: "Ꙙ",                  // '𖼜'
+	0x16F26: "ꓶ",                  // '𖼦'
+	0x16F28: "l",                  // '𖼨'
+	0x16F2D: "Ɛ",                  // '𖼭'
+	0x16F35: "R",                  // '𖼵'
+	0x16F3A: "S",                  // '𖼺'
+	0x16F3B: "3",                  // '𖼻'
+	0x16F3D: "Ʌ",                  // '𖼽'
+	0x16F3F: ">",                  // '𖼿'
+	0x16F40: "A",                  // '𖽀'
+	0x16F42: "U",                  // '𖽂'
+	0x16F43: "Y",                  // '𖽃'
+	0x16F51: "'",                  // '𖽑'
+	0x16F52: "'",                  // '𖽒'
+	0x1D114: "{",                  // '𝄔'
+	0x1D16D: ".",                  // '𝅭'
+	0x1D202: "Ӿ",                  // '𝈂'
+	0x1D206: "3",                  // '𝈆'
+	0x1D20B: "И",                  // '𝈋'
+	0x1D20D: "V",                  // '𝈍'
+	0x1D20F: "\\",                 // '𝈏'
+	0x1D212: "7",                  // '𝈒'
+	0x1D213: "F",                  // '𝈓'
+	0x1D214: "𐊼",                  // '𝈔'
+	0x1D215: "ꓶ",                  // '𝈕'
+	0x1D216: "R",                  // '𝈖'
+	0x1D217: "Ɐ",                  // '𝈗'
+	0x1D21A: "O̵",                 // '𝈚'
+	0x1D21B: "⅄",                  // '𝈛'
+	0x1D21C: "ꓕ",                  // '𝈜'
+	0x1D221: "Ɛ",                  // '𝈡'
+	0x1D222: "Ѡ",                  // '𝈢'
+	0x1D22A: "L",                  // '𝈪'
+	0x1D22B: "ꓶ",                  // '𝈫'
+	0x1D230: "ꟻ",                  // '𝈰'
+	0x1D236: "<",                  // '𝈶'
+	0x1D237: ">",                  // '𝈷'
+	0x1D238: "⊏",                  // '𝈸'
+	0x1D239: "⊐",                  // '𝈹'
+	0x1D23A: "/",                  // '𝈺'
+	0x1D23B: "\\",                 // '𝈻'
+	0x1D23F: "ᛋ",                  // '𝈿'
+	0x1D245: "Ո",                  // '𝉅'
+	0x1D400: "A",                  // '𝐀'
+	0x1D401: "B",                  // '𝐁'
+	0x1D402: "C",                  // '𝐂'
+	0x1D403: "D",                  // '𝐃'
+	0x1D404: "E",                  // '𝐄'
+	0x1D405: "F",                  // '𝐅'
+	0x1D406: "G",                  // '𝐆'
+	0x1D407: "H",                  // '𝐇'
+	0x1D408: "l",                  // '𝐈'
+	0x1D409: "J",                  // '𝐉'
+	0x1D40A: "K",                  // '𝐊'
+	0x1D40B: "L",                  // '𝐋'
+	0x1D40C: "M",                  // '𝐌'
+	0x1D40D: "N",                  // '𝐍'
+	0x1D40E: "O",                  // '𝐎'
+	0x1D40F: "P",                  // '𝐏'
+	0x1D410: "Q",                  // '𝐐'
+	0x1D411: "R",                  // '𝐑'
+	0x1D412: "S",                  // '𝐒'
+	0x1D413: "T",                  // '𝐓'
+	0x1D414: "U",                  // '𝐔'
+	0x1D415: "V",                  // '𝐕'
+	0x1D416: "W",                  // '𝐖'
+	0x1D417: "X",                  // '𝐗'
+	0x1D418: "Y",                  // '𝐘'
+	0x1D419: "Z",                  // '𝐙'
+	0x1D41A: "a",                  // '𝐚'
+	0x1D41B: "b",                  // '𝐛'
+	0x1D41C: "c",                  // '𝐜'
+	0x1D41D: "d",                  // '𝐝'
+	0x1D41E: "e",                  // '𝐞'
+	0x1D41F: "f",                  // '𝐟'
+	0x1D420: "g",                  // '𝐠'
+	0x1D421: "h",                  // '𝐡'
+	0x1D422: "i",                  // '𝐢'
+	0x1D423: "j",                  // '𝐣'
+	0x1D424: "k",                  // '𝐤'
+	0x1D425: "l",                  // '𝐥'
+	0x1D426: "rn",                 // '𝐦'
+	0x1D427: "n",                  // '𝐧'
+	0x1D428: "o",                  // '𝐨'
+	0x1D429: "p",                  // '𝐩'
+	0x1D42A: "q",                  // '𝐪'
+	0x1D42B: "r",                  // '𝐫'
+	0x1D42C: "s",                  // '𝐬'
+	0x1D42D: "t",                  // '𝐭'
+	0x1D42E: "u",                  // '𝐮'
+	0x1D42F: "v",                  // '𝐯'
+	0x1D430: "w",                  // '𝐰'
+	0x1D431: "x",                  // '𝐱'
+	0x1D432: "y",                  // '𝐲'
+	0x1D433: "z",                  // '𝐳'
+	0x1D434: "A",                  // '𝐴'
+	0x1D435: "B",                  // '𝐵'
+	0x1D436: "C",                  // '𝐶'
+	0x1D437: "D",                  // '𝐷'
+	0x1D438: "E",                  // '𝐸'
+	0x1D439: "F",                  // '𝐹'
+	0x1D43A: "G",                  // '𝐺'
+	0x1D43B: "H",                  // '𝐻'
+	0x1D43C: "l",                  // '𝐼'
+	0x1D43D: "J",                  // '𝐽'
+	0x1D43E: "K",                  // '𝐾'
+	0x1D43F: "L",                  // '𝐿'
+	0x1D440: "M",                  // '𝑀'
+	0x1D441: "N",                  // '𝑁'
+	0x1D442: "O",                  // '𝑂'
+	0x1D443: "P",                  // '𝑃'
+	0x1D444: "Q",                  // '𝑄'
+	0x1D445: "R",                  // '𝑅'
+	0x1D446: "S",                  // '𝑆'
+	0x1D447: "T",                  // '𝑇'
+	0x1D448: "U",                  // '𝑈'
+	0x1D449: "V",                  // '𝑉'
+	0x1D44A: "W",                  // '𝑊'
+	0x1D44B: "X",                  // '𝑋'
+	0x1D44C: "Y",                  // '𝑌'
+	0x1D44D: "Z",                  // '𝑍'
+	0x1D44E: "a",                  // '𝑎'
+	0x1D44F: "b",                  // '𝑏'
+	0x1D450: "c",                  // '𝑐'
+	0x1D451: "d",                  // '𝑑'
+	0x1D452: "e",                  // '𝑒'
+	0x1D453: "f",                  // '𝑓'
+	0x1D454: "g",                  // '𝑔'
+	0x1D456: "i",                  // '𝑖'
+	0x1D457: "j",                  // '𝑗'
+	0x1D458: "k",                  // '𝑘'
+	0x1D459: "l",                  // '𝑙'
+	0x1D45A: "rn",                 // '𝑚'
+	0x1D45B: "n",                  // '𝑛'
+	0x1D45C: "o",                  // '𝑜'
+	0x1D45D: "p",                  // '𝑝'
+	0x1D45E: "q",                  // '𝑞'
+	0x1D45F: "r",                  // '𝑟'
+	0x1D460: "s",                  // '𝑠'
+	0x1D461: "t",                  // '𝑡'
+	0x1D462: "u",                  // '𝑢'
+	0x1D463: "v",                  // '𝑣'
+	0x1D464: "w",                  // '𝑤'
+	0x1D465: "x",                  // '𝑥'
+	0x1D466: "y",                  // '𝑦'
+	0x1D467: "z",                  // '𝑧'
+	0x1D468: "A",                  // '𝑨'
+	0x1D469: "B",                  // '𝑩'
+	0x1D46A: "C",                  // '𝑪'
+	0x1D46B: "D",                  // '𝑫'
+	0x1D46C: "E",                  // '𝑬'
+	0x1D46D: "F",                  // '𝑭'
+	0x1D46E: "G",                  // '𝑮'
+	0x1D46F: "H",                  // '𝑯'
+	0x1D470: "l",                  // '𝑰'
+	0x1D471: "J",                  // '𝑱'
+	0x1D472: "K",                  // '𝑲'
+	0x1D473: "L",                  // '𝑳'
+	0x1D474: "M",                  // '𝑴'
+	0x1D475: "N",                  // '𝑵'
+	0x1D476: "O",                  // '𝑶'
+	0x1D477: "P",                  // '𝑷'
+	0x1D478: "Q",                  // '𝑸'
+	0x1D479: "R",                  // '𝑹'
+	0x1D47A: "S",                  // '𝑺'
+	0x1D47B: "T",                  // '𝑻'
+	0x1D47C: "U",                  // '𝑼'
+	0x1D47D: "V",                  // '𝑽'
+	0x1D47E: "W",                  // '𝑾'
+	0x1D47F: "X",                  // '𝑿'
+	0x1D480: "Y",                  // '𝒀'
+	0x1D481: "Z",                  // '𝒁'
+	0x1D482: "a",                  // '𝒂'
+	0x1D483: "b",                  // '𝒃'
+	0x1D484: "c",                  // '𝒄'
+	0x1D485: "d",                  // '𝒅'
+	0x1D486: "e",                  // '𝒆'
+	0x1D487: "f",                  // '𝒇'
+	0x1D488: "g",                  // '𝒈'
+	0x1D489: "h",                  // '𝒉'
+	0x1D48A: "i",                  // '𝒊'
+	0x1D48B: "j",                  // '𝒋'
+	0x1D48C: "k",                  // '𝒌'
+	0x1D48D: "l",                  // '𝒍'
+	0x1D48E: "rn",                 // '𝒎'
+	0x1D48F: "n",                  // '𝒏'
+	0x1D490: "o",                  // '𝒐'
+	0x1D491: "p",                  // '𝒑'
+	0x1D492: "q",                  // '𝒒'
+	0x1D493: "r",                  // '𝒓'
+	0x1D494: "s",                  // '𝒔'
+	0x1D495: "t",                  // '𝒕'
+	0x1D496: "u",                  // '𝒖'
+	0x1D497: "v",                  // '𝒗'
+	0x1D498: "w",                  // '𝒘'
+	0x1D499: "x",                  // '𝒙'
+	0x1D49A: "y",                  // '𝒚'
+	0x1D49B: "z",                  // '𝒛'
+	0x1D49C: "A",                  // '𝒜'
+	0x1D49E: "C",                  // '𝒞'
+	0x1D49F: "D",                  // '𝒟'
+	0x1D4A2: "G",                  // '𝒢'
+	0x1D4A5: "J",                  // '𝒥'
+	0x1D4A6: "K",                  // '𝒦'
+	0x1D4A9: "N",                  // '𝒩'
+	0x1D4AA: "O",                  // '𝒪'
+	0x1D4AB: "P",                  // '𝒫'
+	0x1D4AC: "Q",                  // '𝒬'
+	0x1D4AE: "S",                  // '𝒮'
+	0x1D4AF: "T",                  // '𝒯'
+	0x1D4B0: "U",                  // '𝒰'
+	0x1D4B1: "V",                  // '𝒱'
+	0x1D4B2: "W",                  // '𝒲'
+	0x1D4B3: "X",                  // '𝒳'
+	0x1D4B4: "Y",                  // '𝒴'
+	0x1D4B5: "Z",                  // '𝒵'
+	0x1D4B6: "a",                  // '𝒶'
+	0x1D4B7: "b",                  // '𝒷'
+	0x1D4B8: "c",                  // '𝒸'
+	0x1D4B9: "d",                  // '𝒹'
+	0x1D4BB: "f",                  // '𝒻'
+	0x1D4BD: "h",                  // '𝒽'
+	0x1D4BE: "i",                  // '𝒾'
+	0x1D4BF: "j",                  // '𝒿'
+	0x1D4C0: "k",                  // '𝓀'
+	0x1D4C1: "l",                  // '𝓁'
+	0x1D4C2: "rn",                 // '𝓂'
+	0x1D4C3: "n",                  // '𝓃'
+	0x1D4C5: "p",                  // '𝓅'
+	0x1D4C6: "q",                  // '𝓆'
+	0x1D4C7: "r",                  // '𝓇'
+	0x1D4C8: "s",                  // '𝓈'
+	0x1D4C9: "t",                  // '𝓉'
+	0x1D4CA: "u",                  // '𝓊'
+	0x1D4CB: "v",                  // '𝓋'
+	0x1D4CC: "w",                  // '𝓌'
+	0x1D4CD: "x",                  // '𝓍'
+	0x1D4CE: "y",                  // '𝓎'
+	0x1D4CF: "z",                  // '𝓏'
+	0x1D4D0: "A",                  // '𝓐'
+	0x1D4D1: "B",                  // '𝓑'
+	0x1D4D2: "C",                  // '𝓒'
+	0x1D4D3: "D",                  // '𝓓'
+	0x1D4D4: "E",                  // '𝓔'
+	0x1D4D5: "F",                  // '𝓕'
+	0x1D4D6: "G",                  // '𝓖'
+	0x1D4D7: "H",                  // '𝓗'
+	0x1D4D8: "l",                  // '𝓘'
+	0x1D4D9: "J",                  // '𝓙'
+	0x1D4DA: "K",                  // '𝓚'
+	0x1D4DB: "L",                  // '𝓛'
+	0x1D4DC: "M",                  // '𝓜'
+	0x1D4DD: "N",                  // '𝓝'
+	0x1D4DE: "O",                  // '𝓞'
+	0x1D4DF: "P",                  // '𝓟'
+	0x1D4E0: "Q",                  // '𝓠'
+	0x1D4E1: "R",                  // '𝓡'
+	0x1D4E2: "S",                  // '𝓢'
+	0x1D4E3: "T",                  // '𝓣'
+	0x1D4E4: "U",                  // '𝓤'
+	0x1D4E5: "V",                  // '𝓥'
+	0x1D4E6: "W",                  // '𝓦'
+	0x1D4E7: "X",                  // '𝓧'
+	0x1D4E8: "Y",                  // '𝓨'
+	0x1D4E9: "Z",                  // '𝓩'
+	0x1D4EA: "a",                  // '𝓪'
+	0x1D4EB: "b",                  // '𝓫'
+	0x1D4EC: "c",                  // '𝓬'
+	0x1D4ED: "d",                  // '𝓭'
+	0x1D4EE: "e",                  // '𝓮'
+	0x1D4EF: "f",                  // '𝓯'
+	0x1D4F0: "g",                  // '𝓰'
+	0x1D4F1: "h",                  // '𝓱'
+	0x1D4F2: "i",                  // '𝓲'
+	0x1D4F3: "j",                  // '𝓳'
+	0x1D4F4: "k",                  // '𝓴'
+	0x1D4F5: "l",                  // '𝓵'
+	0x1D4F6: "rn",                 // '𝓶'
+	0x1D4F7: "n",                  // '𝓷'
+	0x1D4F8: "o",                  // '𝓸'
+	0x1D4F9: "p",                  // '𝓹'
+	0x1D4FA: "q",                  // '𝓺'
+	0x1D4FB: "r",                  // '𝓻'
+	0x1D4FC: "s",                  // '𝓼'
+	0x1D4FD: "t",                  // '𝓽'
+	0x1D4FE: "u",                  // '𝓾'
+	0x1D4FF: "v",                  // '𝓿'
+	0x1D500: "w",                  // '𝔀'
+	0x1D501: "x",                  // '𝔁'
+	0x1D502: "y",                  // '𝔂'
+	0x1D503: "z",                  // '𝔃'
+	0x1D504: "A",                  // '𝔄'
+	0x1D505: "B",                  // '𝔅'
+	0x1D507: "D",                  // '𝔇'
+	0x1D508: "E",                  // '𝔈'
+	0x1D509: "F",                  // '𝔉'
+	0x1D50A: "G",                  // '𝔊'
+	0x1D50D: "J",                  // '𝔍'
+	0x1D50E: "K",                  // '𝔎'
+	0x1D50F: "L",                  // '𝔏'
+	0x1D510: "M",                  // '𝔐'
+	0x1D511: "N",                  // '𝔑'
+	0x1D512: "O",                  // '𝔒'
+	0x1D513: "P",                  // '𝔓'
+	0x1D514: "Q",                  // '𝔔'
+	0x1D516: "S",                  // '𝔖'
+	0x1D517: "T",                  // '𝔗'
+	0x1D518: "U",                  // '𝔘'
+	0x1D519: "V",                  // '𝔙'
+	0x1D51A: "W",                  // '𝔚'
+	0x1D51B: "X",                  // '𝔛'
+	0x1D51C: "Y",                  // '𝔜'
+	0x1D51E: "a",                  // '𝔞'
+	0x1D51F: "b",                  // '𝔟'
+	0x1D520: "c",                  // '𝔠'
+	0x1D521: "d",                  // '𝔡'
+	0x1D522: "e",                  // '𝔢'
+	0x1D523: "f",                  // '𝔣'
+	0x1D524: "g",                  // '𝔤'
+	0x1D525: "h",                  // '𝔥'
+	0x1D526: "i",                  // '𝔦'
+	0x1D527: "j",                  // '𝔧'
+	0x1D528: "k",                  // '𝔨'
+	0x1D529: "l",                  // '𝔩'
+	0x1D52A: "rn",                 // '𝔪'
+	0x1D52B: "n",                  // '𝔫'
+	0x1D52C: "o",                  // '𝔬'
+	0x1D52D: "p",                  // '𝔭'
+	0x1D52E: "q",                  // '𝔮'
+	0x1D52F: "r",                  // '𝔯'
+	0x1D530: "s",                  // '𝔰'
+	0x1D531: "t",                  // '𝔱'
+	0x1D532: "u",                  // '𝔲'
+	0x1D533: "v",                  // '𝔳'
+	0x1D534: "w",                  // '𝔴'
+	0x1D535: "x",                  // '𝔵'
+	0x1D536: "y",                  // '𝔶'
+	0x1D537: "z",                  // '𝔷'
+	0x1D538: "A",                  // '𝔸'
+	0x1D539: "B",                  // '𝔹'
+	0x1D53B: "D",                  // '𝔻'
+	0x1D53C: "E",                  // '𝔼'
+	0x1D53D: "F",                  // '𝔽'
+	0x1D53E: "G",                  // '𝔾'
+	0x1D540: "l",                  // '𝕀'
+	0x1D541: "J",                  // '𝕁'
+	0x1D542: "K",                  // '𝕂'
+	0x1D543: "L",                  // '𝕃'
+	0x1D544: "M",                  // '𝕄'
+	0x1D546: "O",                  // '𝕆'
+	0x1D54A: "S",                  // '𝕊'
+	0x1D54B: "T",                  // '𝕋'
+	0x1D54C: "U",                  // '𝕌'
+	0x1D54D: "V",                  // '𝕍'
+	0x1D54E: "W",                  // '𝕎'
+	0x1D54F: "X",                  // '𝕏'
+	0x1D550: "Y",                  // '𝕐'
+	0x1D552: "a",                  // '𝕒'
+	0x1D553: "b",                  // '𝕓'
+	0x1D554: "c",                  // '𝕔'
+	0x1D555: "d",                  // '𝕕'
+	0x1D556: "e",                  // '𝕖'
+	0x1D557: "f",                  // '𝕗'
+	0x1D558: "g",                  // '𝕘'
+	0x1D559: "h",                  // '𝕙'
+	0x1D55A: "i",                  // '𝕚'
+	0x1D55B: "j",                  // '𝕛'
+	0x1D55C: "k",                  // '𝕜'
+	0x1D55D: "l",                  // '𝕝'
+	0x1D55E: "rn",                 // '𝕞'
+	0x1D55F: "n",                  // '𝕟'
+	0x1D560: "o",                  // '𝕠'
+	0x1D561: "p",                  // '𝕡'
+	0x1D562: "q",                  // '𝕢'
+	0x1D563: "r",                  // '𝕣'
+	0x1D564: "s",                  // '𝕤'
+	0x1D565: "t",                  // '𝕥'
+	0x1D566: "u",                  // '𝕦'
+	0x1D567: "v",                  // '𝕧'
+	0x1D568: "w",                  // '𝕨'
+	0x1D569: "x",                  // '𝕩'
+	0x1D56A: "y",                  // '𝕪'
+	0x1D56B: "z",                  // '𝕫'
+	0x1D56C: "A",                  // '𝕬'
+	0x1D56D: "B",                  // '𝕭'
+	0x1D56E: "C",                  // '𝕮'
+	0x1D56F: "D",                  // '𝕯'
+	0x1D570: "E",                  // '𝕰'
+	0x1D571: "F",                  // '𝕱'
+	0x1D572: "G",                  // '𝕲'
+	0x1D573: "H",                  // '𝕳'
+	0x1D574: "l",                  // '𝕴'
+	0x1D575: "J",                  // '𝕵'
+	0x1D576: "K",                  // '𝕶'
+	0x1D577: "L",                  // '𝕷'
+	0x1D578: "M",                  // '𝕸'
+	0x1D579: "N",                  // '𝕹'
+	0x1D57A: "O",                  // '𝕺'
+	0x1D57B: "P",                  // '𝕻'
+	0x1D57C: "Q",                  // '𝕼'
+	0x1D57D: "R",                  // '𝕽'
+	0x1D57E: "S",                  // '𝕾'
+	0x1D57F: "T",                  // '𝕿'
+	0x1D580: "U",                  // '𝖀'
+	0x1D581: "V",                  // '𝖁'
+	0x1D582: "W",                  // '𝖂'
+	0x1D583: "X",                  // '𝖃'
+	0x1D584: "Y",                  // '𝖄'
+	0x1D585: "Z",                  // '𝖅'
+	0x1D586: "a",                  // '𝖆'
+	0x1D587: "b",                  // '𝖇'
+	0x1D588: "c",                  // '𝖈'
+	0x1D589: "d",                  // '𝖉'
+	0x1D58A: "e",                  // '𝖊'
+	0x1D58B: "f",                  // '𝖋'
+	0x1D58C: "g",                  // '𝖌'
+	0x1D58D: "h",                  // '𝖍'
+	0x1D58E: "i",                  // '𝖎'
+	0x1D58F: "j",                  // '𝖏'
+	0x1D590: "k",                  // '𝖐'
+	0x1D591: "l",                  // '𝖑'
+	0x1D592: "rn",                 // '𝖒'
+	0x1D593: "n",                  // '𝖓'
+	0x1D594: "o",                  // '𝖔'
+	0x1D595: "p",                  // '𝖕'
+	0x1D596: "q",                  // '𝖖'
+	0x1D597: "r",                  // '𝖗'
+	0x1D598: "s",                  // '𝖘'
+	0x1D599: "t",                  // '𝖙'
+	0x1D59A: "u",                  // '𝖚'
+	0x1D59B: "v",                  // '𝖛'
+	0x1D59C: "w",                  // '𝖜'
+	0x1D59D: "x",                  // '𝖝'
+	0x1D59E: "y",                  // '𝖞'
+	0x1D59F: "z",                  // '𝖟'
+	0x1D5A0: "A",                  // '𝖠'
+	0x1D5A1: "B",                  // '𝖡'
+	0x1D5A2: "C",                  // '𝖢'
+	0x1D5A3: "D",                  // '𝖣'
+	0x1D5A4: "E",                  // '𝖤'
+	0x1D5A5: "F",                  // '𝖥'
+	0x1D5A6: "G",                  // '𝖦'
+	0x1D5A7: "H",                  // '𝖧'
+	0x1D5A8: "l",                  // '𝖨'
+	0x1D5A9: "J",                  // '𝖩'
+	0x1D5AA: "K",                  // '𝖪'
+	0x1D5AB: "L",                  // '𝖫'
+	0x1D5AC: "M",                  // '𝖬'
+	0x1D5AD: "N",                  // '𝖭'
+	0x1D5AE: "O",                  // '𝖮'
+	0x1D5AF: "P",                  // '𝖯'
+	0x1D5B0: "Q",                  // '𝖰'
+	0x1D5B1: "R",                  // '𝖱'
+	0x1D5B2: "S",                  // '𝖲'
+	0x1D5B3: "T",                  // '𝖳'
+	0x1D5B4: "U",                  // '𝖴'
+	0x1D5B5: "V",                  // '𝖵'
+	0x1D5B6: "W",                  // '𝖶'
+	0x1D5B7: "X",                  // '𝖷'
+	0x1D5B8: "Y",                  // '𝖸'
+	0x1D5B9: "Z",                  // '𝖹'
+	0x1D5BA: "a",                  // '𝖺'
+	0x1D5BB: "b",                  // '𝖻'
+	0x1D5BC: "c",                  // '𝖼'
+	0x1D5BD: "d",                  // '𝖽'
+	0x1D5BE: "e",                  // '𝖾'
+	0x1D5BF: "f",                  // '𝖿'
+	0x1D5C0: "g",                  // '𝗀'
+	0x1D5C1: "h",                  // '𝗁'
+	0x1D5C2: "i",                  // '𝗂'
+	0x1D5C3: "j",                  // '𝗃'
+	0x1D5C4: "k",                  // '𝗄'
+	0x1D5C5: "l",                  // '𝗅'
+	0x1D5C6: "rn",                 // '𝗆'
+	0x1D5C7: "n",                  // '𝗇'
+	0x1D5C8: "o",                  // '𝗈'
+	0x1D5C9: "p",                  // '𝗉'
+	0x1D5CA: "q",                  // '𝗊'
+	0x1D5CB: "r",                  // '𝗋'
+	0x1D5CC: "s",                  // '𝗌'
+	0x1D5CD: "t",                  // '𝗍'
+	0x1D5CE: "u",                  // '𝗎'
+	0x1D5CF: "v",                  // '𝗏'
+	0x1D5D0: "w",                  // '𝗐'
+	0x1D5D1: "x",                  // '𝗑'
+	0x1D5D2: "y",                  // '𝗒'
+	0x1D5D3: "z",                  // '𝗓'
+	0x1D5D4: "A",                  // '𝗔'
+	0x1D5D5: "B",                  // '𝗕'
+	0x1D5D6: "C",                  // '𝗖'
+	0x1D5D7: "D",                  // '𝗗'
+	0x1D5D8: "E",                  // '𝗘'
+	0x1D5D9: "F",                  // '𝗙'
+	0x1D5DA: "G",                  // '𝗚'
+	0x1D5DB: "H",                  // '𝗛'
+	0x1D5DC: "l",                  // '𝗜'
+	0x1D5DD: "J",                  // '𝗝'
+	0x1D5DE: "K",                  // '𝗞'
+	0x1D5DF: "L",                  // '𝗟'
+	0x1D5E0: "M",                  // '𝗠'
+	0x1D5E1: "N",                  // '𝗡'
+	0x1D5E2: "O",                  // '𝗢'
+	0x1D5E3: "P",                  // '𝗣'
+	0x1D5E4: "Q",                  // '𝗤'
+	0x1D5E5: "R",                  // '𝗥'
+	0x1D5E6: "S",                  // '𝗦'
+	0x1D5E7: "T",                  // '𝗧'
+	0x1D5E8: "U",                  // '𝗨'
+	0x1D5E9: "V",                  // '𝗩'
+	0x1D5EA: "W",                  // '𝗪'
+	0x1D5EB: "X",                  // '𝗫'
+	0x1D5EC: "Y",                  // '𝗬'
+	0x1D5ED: "Z",                  // '𝗭'
+	0x1D5EE: "a",                  // '𝗮'
+	0x1D5EF: "b",                  // '𝗯'
+	0x1D5F0: "c",                  // '𝗰'
+	0x1D5F1: "d",                  // '𝗱'
+	0x1D5F2: "e",                  // '𝗲'
+	0x1D5F3: "f",                  // '𝗳'
+	0x1D5F4: "g",                  // '𝗴'
+	0x1D5F5: "h",                  // '𝗵'
+	0x1D5F6: "i",                  // '𝗶'
+	0x1D5F7: "j",                  // '𝗷'
+	0x1D5F8: "k",                  // '𝗸'
+	0x1D5F9: "l",                  // '𝗹'
+	0x1D5FA: "rn",                 // '𝗺'
+	0x1D5FB: "n",                  // '𝗻'
+	0x1D5FC: "o",                  // '𝗼'
+	0x1D5FD: "p",                  // '𝗽'
+	0x1D5FE: "q",                  // '𝗾'
+	0x1D5FF: "r",                  // '𝗿'
+	0x1D600: "s",                  // '𝘀'
+	0x1D601: "t",                  // '𝘁'
+	0x1D602: "u",                  // '𝘂'
+	0x1D603: "v",                  // '𝘃'
+	0x1D604: "w",                  // '𝘄'
+	0x1D605: "x",                  // '𝘅'
+	0x1D606: "y",                  // '𝘆'
+	0x1D607: "z",                  // '𝘇'
+	0x1D608: "A",                  // '𝘈'
+	0x1D609: "B",                  // '𝘉'
+	0x1D60A: "C",                  // '𝘊'
+	0x1D60B: "D",                  // '𝘋'
+	0x1D60C: "E",                  // '𝘌'
+	0x1D60D: "F",                  // '𝘍'
+	0x1D60E: "G",                  // '𝘎'
+	0x1D60F: "H",                  // '𝘏'
+	0x1D610: "l",                  // '𝘐'
+	0x1D611: "J",                  // '𝘑'
+	0x1D612: "K",                  // '𝘒'
+	0x1D613: "L",                  // '𝘓'
+	0x1D614: "M",                  // '𝘔'
+	0x1D615: "N",                  // '𝘕'
+	0x1D616: "O",                  // '𝘖'
+	0x1D617: "P",                  // '𝘗'
+	0x1D618: "Q",                  // '𝘘'
+	0x1D619: "R",                  // '𝘙'
+	0x1D61A: "S",                  // '𝘚'
+	0x1D61B: "T",                  // '𝘛'
+	0x1D61C: "U",                  // '𝘜'
+	0x1D61D: "V",                  // '𝘝'
+	0x1D61E: "W",                  // '𝘞'
+	0x1D61F: "X",                  // '𝘟'
+	0x1D620: "Y",                  // '𝘠'
+	0x1D621: "Z",                  // '𝘡'
+	0x1D622: "a",                  // '𝘢'
+	0x1D623: "b",                  // '𝘣'
+	0x1D624: "c",                  // '𝘤'
+	0x1D625: "d",                  // '𝘥'
+	0x1D626: "e",                  // '𝘦'
+	0x1D627: "f",                  // '𝘧'
+	0x1D628: "g",                  // '𝘨'
+	0x1D629: "h",                  // '𝘩'
+	0x1D62A: "i",                  // '𝘪'
+	0x1D62B: "j",                  // '𝘫'
+	0x1D62C: "k",                  // '𝘬'
+	0x1D62D: "l",                  // '𝘭'
+	0x1D62E: "rn",                 // '𝘮'
+	0x1D62F: "n",                  // '𝘯'
+	0x1D630: "o",                  // '𝘰'
+	0x1D631: "p",                  // '𝘱'
+	0x1D632: "q",                  // '𝘲'
+	0x1D633: "r",                  // '𝘳'
+	0x1D634: "s",                  // '𝘴'
+	0x1D635: "t",                  // '𝘵'
+	0x1D636: "u",                  // '𝘶'
+	0x1D637: "v",                  // '𝘷'
+	0x1D638: "w",                  // '𝘸'
+	0x1D639: "x",                  // '𝘹'
+	0x1D63A: "y",                  // '𝘺'
+	0x1D63B: "z",                  // '𝘻'
+	0x1D63C: "A",                  // '𝘼'
+	0x1D63D: "B",                  // '𝘽'
+	0x1D63E: "C",                  // '𝘾'
+	0x1D63F: "D",                  // '𝘿'
+	0x1D640: "E",                  // '𝙀'
+	0x1D641: "F",                  // '𝙁'
+	0x1D642: "G",                  // '𝙂'
+	0x1D643: "H",                  // '𝙃'
+	0x1D644: "l",                  // '𝙄'
+	0x1D645: "J",                  // '𝙅'
+	0x1D646: "K",                  // '𝙆'
+	0x1D647: "L",                  // '𝙇'
+	0x1D648: "M",                  // '𝙈'
+	0x1D649: "N",                  // '𝙉'
+	0x1D64A: "O",                  // '𝙊'
+	0x1D64B: "P",                  // '𝙋'
+	0x1D64C: "Q",                  // '𝙌'
+	0x1D64D: "R",                  // '𝙍'
+	0x1D64E: "S",                  // '𝙎'
+	0x1D64F: "T",                  // '𝙏'
+	0x1D650: "U",                  // '𝙐'
+	0x1D651: "V",                  // '𝙑'
+	0x1D652: "W",                  // '𝙒'
+	0x1D653: "X",                  // '𝙓'
+	0x1D654: "Y",                  // '𝙔'
+	0x1D655: "Z",                  // '𝙕'
+	0x1D656: "a",                  // '𝙖'
+	0x1D657: "b",                  // '𝙗'
+	0x1D658: "c",                  // '𝙘'
+	0x1D659: "d",                  // '𝙙'
+	0x1D65A: "e",                  // '𝙚'
+	0x1D65B: "f",                  // '𝙛'
+	0x1D65C: "g",                  // '𝙜'
+	0x1D65D: "h",                  // '𝙝'
+	0x1D65E: "i",                  // '𝙞'
+	0x1D65F: "j",                  // '𝙟'
+	0x1D660: "k",                  // '𝙠'
+	0x1D661: "l",                  // '𝙡'
+	0x1D662: "rn",                 // '𝙢'
+	0x1D663: "n",                  // '𝙣'
+	0x1D664: "o",                  // '𝙤'
+	0x1D665: "p",                  // '𝙥'
+	0x1D666: "q",                  // '𝙦'
+	0x1D667: "r",                  // '𝙧'
+	0x1D668: "s",                  // '𝙨'
+	0x1D669: "t",                  // '𝙩'
+	0x1D66A: "u",                  // '𝙪'
+	0x1D66B: "v",                  // '𝙫'
+	0x1D66C: "w",                  // '𝙬'
+	0x1D66D: "x",                  // '𝙭'
+	0x1D66E: "y",                  // '𝙮'
+	0x1D66F: "z",                  // '𝙯'
+	0x1D670: "A",                  // '𝙰'
+	0x1D671: "B",                  // '𝙱'
+	0x1D672: "C",                  // '𝙲'
+	0x1D673: "D",                  // '𝙳'
+	0x1D674: "E",                  // '𝙴'
+	0x1D675: "F",                  // '𝙵'
+	0x1D676: "G",                  // '𝙶'
+	0x1D677: "H",                  // '𝙷'
+	0x1D678: "l",                  // '𝙸'
+	0x1D679: "J",                  // '𝙹'
+	0x1D67A: "K",                  // '𝙺'
+	0x1D67B: "L",                  // '𝙻'
+	0x1D67C: "M",                  // '𝙼'
+	0x1D67D: "N",                  // '𝙽'
+	0x1D67E: "O",                  // '𝙾'
+	0x1D67F: "P",                  // '𝙿'
+	0x1D680: "Q",                  // '𝚀'
+	0x1D681: "R",                  // '𝚁'
+	0x1D682: "S",                  // '𝚂'
+	0x1D683: "T",                  // '𝚃'
+	0x1D684: "U",                  // '𝚄'
+	0x1D685: "V",                  // '𝚅'
+	0x1D686: "W",                  // '𝚆'
+	0x1D687: "X",                  // '𝚇'
+	0x1D688: "Y",                  // '𝚈'
+	0x1D689: "Z",                  // '𝚉'
+	0x1D68A: "a",                  // '𝚊'
+	0x1D68B: "b",                  // '𝚋'
+	0x1D68C: "c",                  // '𝚌'
+	0x1D68D: "d",                  // '𝚍'
+	0x1D68E: "e",                  // '𝚎'
+	0x1D68F: "f",                  // '𝚏'
+	0x1D690: "g",                  // '𝚐'
+	0x1D691: "h",                  // '𝚑'
+	0x1D692: "i",                  // '𝚒'
+	0x1D693: "j",                  // '𝚓'
+	0x1D694: "k",                  // '𝚔'
+	0x1D695: "l",                  // '𝚕'
+	0x1D696: "rn",                 // '𝚖'
+	0x1D697: "n",                  // '𝚗'
+	0x1D698: "o",                  // '𝚘'
+	0x1D699: "p",                  // '𝚙'
+	0x1D69A: "q",                  // '𝚚'
+	0x1D69B: "r",                  // '𝚛'
+	0x1D69C: "s",                  // '𝚜'
+	0x1D69D: "t",                  // '𝚝'
+	0x1D69E: "u",                  // '𝚞'
+	0x1D69F: "v",                  // '𝚟'
+	0x1D6A0: "w",                  // '𝚠'
+	0x1D6A1: "x",                  // '𝚡'
+	0x1D6A2: "y",                  // '𝚢'
+	0x1D6A3: "z",                  // '𝚣'
+	0x1D6A4: "i",                  // '𝚤'
+	0x1D6A5: "ȷ",                  // '𝚥'
+	0x1D6A8: "A",                  // '𝚨'
+	0x1D6A9: "B",                  // '𝚩'
+	0x1D6AA: "Γ",                  // '𝚪'
+	0x1D6AB: "Δ",                  // '𝚫'
+	0x1D6AC: "E",                  // '𝚬'
+	0x1D6AD: "Z",                  // '𝚭'
+	0x1D6AE: "H",                  // '𝚮'
+	0x1D6AF: "O̵",                 // '𝚯'
+	0x1D6B0: "l",                  // '𝚰'
+	0x1D6B1: "K",                  // '𝚱'
+	0x1D6B2: "Ʌ",                  // '𝚲'
+	0x1D6B3: "M",                  // '𝚳'
+	0x1D6B4: "N",                  // '𝚴'
+	0x1D6B5: "Ξ",                  // '𝚵'
+	0x1D6B6: "O",                  // '𝚶'
+	0x1D6B7: "Π",                  // '𝚷'
+	0x1D6B8: "P",                  // '𝚸'
+	0x1D6B9: "O̵",                 // '𝚹'
+	0x1D6BA: "Ʃ",                  // '𝚺'
+	0x1D6BB: "T",                  // '𝚻'
+	0x1D6BC: "Y",                  // '𝚼'
+	0x1D6BD: "Φ",                  // '𝚽'
+	0x1D6BE: "X",                  // '𝚾'
+	0x1D6BF: "Ψ",                  // '𝚿'
+	0x1D6C0: "Ω",                  // '𝛀'
+	0x1D6C1: "∇",                  // '𝛁'
+	0x1D6C2: "a",                  // '𝛂'
+	0x1D6C3: "ß",                  // '𝛃'
+	0x1D6C4: "y",                  // '𝛄'
+	0x1D6C5: "ẟ",                  // '𝛅'
+	0x1D6C6: "ꞓ",                  // '𝛆'
+	0x1D6C7: "ζ",                  // '𝛇'
+	0x1D6C8: "n̩",                 // '𝛈'
+	0x1D6C9: "O̵",                 // '𝛉'
+	0x1D6CA: "i",                  // '𝛊'
+	0x1D6CB: "ĸ",                  // '𝛋'
+	0x1D6CC: "λ",                  // '𝛌'
+	0x1D6CD: "μ",                  // '𝛍'
+	0x1D6CE: "v",                  // '𝛎'
+	0x1D6CF: "ξ",                  // '𝛏'
+	0x1D6D0: "o",                  // '𝛐'
+	0x1D6D1: "π",                  // '𝛑'
+	0x1D6D2: "p",                  // '𝛒'
+	0x1D6D3: "ς",                  // '𝛓'
+	0x1D6D4: "o",                  // '𝛔'
+	0x1D6D5: "ᴛ",                  // '𝛕'
+	0x1D6D6: "u",                  // '𝛖'
+	0x1D6D7: "ɸ",                  // '𝛗'
+	0x1D6D8: "χ",                  // '𝛘'
+	0x1D6D9: "ψ",                  // '𝛙'
+	0x1D6DA: "ω",                  // '𝛚'
+	0x1D6DB: "∂",                  // '𝛛'
+	0x1D6DC: "ꞓ",                  // '𝛜'
+	0x1D6DD: "O̵",                 // '𝛝'
+	0x1D6DE: "ĸ",                  // '𝛞'
+	0x1D6DF: "ɸ",                  // '𝛟'
+	0x1D6E0: "p",                  // '𝛠'
+	0x1D6E1: "π",                  // '𝛡'
+	0x1D6E2: "A",                  // '𝛢'
+	0x1D6E3: "B",                  // '𝛣'
+	0x1D6E4: "Γ",                  // '𝛤'
+	0x1D6E5: "Δ",                  // '𝛥'
+	0x1D6E6: "E",                  // '𝛦'
+	0x1D6E7: "Z",                  // '𝛧'
+	0x1D6E8: "H",                  // '𝛨'
+	0x1D6E9: "O̵",                 // '𝛩'
+	0x1D6EA: "l",                  // '𝛪'
+	0x1D6EB: "K",                  // '𝛫'
+	0x1D6EC: "Ʌ",                  // '𝛬'
+	0x1D6ED: "M",                  // '𝛭'
+	0x1D6EE: "N",                  // '𝛮'
+	0x1D6EF: "Ξ",                  // '𝛯'
+	0x1D6F0: "O",                  // '𝛰'
+	0x1D6F1: "Π",                  // '𝛱'
+	0x1D6F2: "P",                  // '𝛲'
+	0x1D6F3: "O̵",                 // '𝛳'
+	0x1D6F4: "Ʃ",                  // '𝛴'
+	0x1D6F5: "T",                  // '𝛵'
+	0x1D6F6: "Y",                  // '𝛶'
+	0x1D6F7: "Φ",                  // '𝛷'
+	0x1D6F8: "X",                  // '𝛸'
+	0x1D6F9: "Ψ",                  // '𝛹'
+	0x1D6FA: "Ω",                  // '𝛺'
+	0x1D6FB: "∇",                  // '𝛻'
+	0x1D6FC: "a",                  // '𝛼'
+	0x1D6FD: "ß",                  // '𝛽'
+	0x1D6FE: "y",                  // '𝛾'
+	0x1D6FF: "ẟ",                  // '𝛿'
+	0x1D700: "ꞓ",                  // '𝜀'
+	0x1D701: "ζ",                  // '𝜁'
+	0x1D702: "n̩",                 // '𝜂'
+	0x1D703: "O̵",                 // '𝜃'
+	0x1D704: "i",                  // '𝜄'
+	0x1D705: "ĸ",                  // '𝜅'
+	0x1D706: "λ",                  // '𝜆'
+	0x1D707: "μ",                  // '𝜇'
+	0x1D708: "v",                  // '𝜈'
+	0x1D709: "ξ",                  // '𝜉'
+	0x1D70A: "o",                  // '𝜊'
+	0x1D70B: "π",                  // '𝜋'
+	0x1D70C: "p",                  // '𝜌'
+	0x1D70D: "ς",                  // '𝜍'
+	0x1D70E: "o",                  // '𝜎'
+	0x1D70F: "ᴛ",                  // '𝜏'
+	0x1D710: "u",                  // '𝜐'
+	0x1D711: "ɸ",                  // '𝜑'
+	0x1D712: "χ",                  // '𝜒'
+	0x1D713: "ψ",                  // '𝜓'
+	0x1D714: "ω",                  // '𝜔'
+	0x1D715: "∂",                  // '𝜕'
+	0x1D716: "ꞓ",                  // '𝜖'
+	0x1D717: "O̵",                 // '𝜗'
+	0x1D718: "ĸ",                  // '𝜘'
+	0x1D719: "ɸ",                  // '𝜙'
+	0x1D71A: "p",                  // '𝜚'
+	0x1D71B: "π",                  // '𝜛'
+	0x1D71C: "A",                  // '𝜜'
+	0x1D71D: "B",                  // '𝜝'
+	0x1D71E: "Γ",                  // '𝜞'
+	0x1D71F: "Δ",                  // '𝜟'
+	0x1D720: "E",                  // '𝜠'
+	0x1D721: "Z",                  // '𝜡'
+	0x1D722: "H",                  // '𝜢'
+	0x1D723: "O̵",                 // '𝜣'
+	0x1D724: "l",                  // '𝜤'
+	0x1D725: "K",                  // '𝜥'
+	0x1D726: "Ʌ",                  // '𝜦'
+	0x1D727: "M",                  // '𝜧'
+	0x1D728: "N",                  // '𝜨'
+	0x1D729: "Ξ",                  // '𝜩'
+	0x1D72A: "O",                  // '𝜪'
+	0x1D72B: "Π",                  // '𝜫'
+	0x1D72C: "P",                  // '𝜬'
+	0x1D72D: "O̵",                 // '𝜭'
+	0x1D72E: "Ʃ",                  // '𝜮'
+	0x1D72F: "T",                  // '𝜯'
+	0x1D730: "Y",                  // '𝜰'
+	0x1D731: "Φ",                  // '𝜱'
+	0x1D732: "X",                  // '𝜲'
+	0x1D733: "Ψ",                  // '𝜳'
+	0x1D734: "Ω",                  // '𝜴'
+	0x1D735: "∇",                  // '𝜵'
+	0x1D736: "a",                  // '𝜶'
+	0x1D737: "ß",                  // '𝜷'
+	0x1D738: "y",                  // '𝜸'
+	0x1D739: "ẟ",                  // '𝜹'
+	0x1D73A: "ꞓ",                  // '𝜺'
+	0x1D73B: "ζ",                  // '𝜻'
+	0x1D73C: "n̩",                 // '𝜼'
+	0x1D73D: "O̵",                 // '𝜽'
+	0x1D73E: "i",                  // '𝜾'
+	0x1D73F: "ĸ",                  // '𝜿'
+	0x1D740: "λ",                  // '𝝀'
+	0x1D741: "μ",                  // '𝝁'
+	0x1D742: "v",                  // '𝝂'
+	0x1D743: "ξ",                  // '𝝃'
+	0x1D744: "o",                  // '𝝄'
+	0x1D745: "π",                  // '𝝅'
+	0x1D746: "p",                  // '𝝆'
+	0x1D747: "ς",                  // '𝝇'
+	0x1D748: "o",                  // '𝝈'
+	0x1D749: "ᴛ",                  // '𝝉'
+	0x1D74A: "u",                  // '𝝊'
+	0x1D74B: "ɸ",                  // '𝝋'
+	0x1D74C: "χ",                  // '𝝌'
+	0x1D74D: "ψ",                  // '𝝍'
+	0x1D74E: "ω",                  // '𝝎'
+	0x1D74F: "∂",                  // '𝝏'
+	0x1D750: "ꞓ",                  // '𝝐'
+	0x1D751: "O̵",                 // '𝝑'
+	0x1D752: "ĸ",                  // '𝝒'
+	0x1D753: "ɸ",                  // '𝝓'
+	0x1D754: "p",                  // '𝝔'
+	0x1D755: "π",                  // '𝝕'
+	0x1D756: "A",                  // '𝝖'
+	0x1D757: "B",                  // '𝝗'
+	0x1D758: "Γ",                  // '𝝘'
+	0x1D759: "Δ",                  // '𝝙'
+	0x1D75A: "E",                  // '𝝚'
+	0x1D75B: "Z",                  // '𝝛'
+	0x1D75C: "H",                  // '𝝜'
+	0x1D75D: "O̵",                 // '𝝝'
+	0x1D75E: "l",                  // '𝝞'
+	0x1D75F: "K",                  // '𝝟'
+	0x1D760: "Ʌ",                  // '𝝠'
+	0x1D761: "M",                  // '𝝡'
+	0x1D762: "N",                  // '𝝢'
+	0x1D763: "Ξ",                  // '𝝣'
+	0x1D764: "O",                  // '𝝤'
+	0x1D765: "Π",                  // '𝝥'
+	0x1D766: "P",                  // '𝝦'
+	0x1D767: "O̵",                 // '𝝧'
+	0x1D768: "Ʃ",                  // '𝝨'
+	0x1D769: "T",                  // '𝝩'
+	0x1D76A: "Y",                  // '𝝪'
+	0x1D76B: "Φ",                  // '𝝫'
+	0x1D76C: "X",                  // '𝝬'
+	0x1D76D: "Ψ",                  // '𝝭'
+	0x1D76E: "Ω",                  // '𝝮'
+	0x1D76F: "∇",                  // '𝝯'
+	0x1D770: "a",                  // '𝝰'
+	0x1D771: "ß",                  // '𝝱'
+	0x1D772: "y",                  // '𝝲'
+	0x1D773: "ẟ",                  // '𝝳'
+	0x1D774: "ꞓ",                  // '𝝴'
+	0x1D775: "ζ",                  // '𝝵'
+	0x1D776: "n̩",                 // '𝝶'
+	0x1D777: "O̵",                 // '𝝷'
+	0x1D778: "i",                  // '𝝸'
+	0x1D779: "ĸ",                  // '𝝹'
+	0x1D77A: "λ",                  // '𝝺'
+	0x1D77B: "μ",                  // '𝝻'
+	0x1D77C: "v",                  // '𝝼'
+	0x1D77D: "ξ",                  // '𝝽'
+	0x1D77E: "o",                  // '𝝾'
+	0x1D77F: "π",                  // '𝝿'
+	0x1D780: "p",                  // '𝞀'
+	0x1D781: "ς",                  // '𝞁'
+	0x1D782: "o",                  // '𝞂'
+	0x1D783: "ᴛ",                  // '𝞃'
+	0x1D784: "u",                  // '𝞄'
+	0x1D785: "ɸ",                  // '𝞅'
+	0x1D786: "χ",                  // '𝞆'
+	0x1D787: "ψ",                  // '𝞇'
+	0x1D788: "ω",                  // '𝞈'
+	0x1D789: "∂",                  // '𝞉'
+	0x1D78A: "ꞓ",                  // '𝞊'
+	0x1D78B: "O̵",                 // '𝞋'
+	0x1D78C: "ĸ",                  // '𝞌'
+	0x1D78D: "ɸ",                  // '𝞍'
+	0x1D78E: "p",                  // '𝞎'
+	0x1D78F: "π",                  // '𝞏'
+	0x1D790: "A",                  // '𝞐'
+	0x1D791: "B",                  // '𝞑'
+	0x1D792: "Γ",                  // '𝞒'
+	0x1D793: "Δ",                  // '𝞓'
+	0x1D794: "E",                  // '𝞔'
+	0x1D795: "Z",                  // '𝞕'
+	0x1D796: "H",                  // '𝞖'
+	0x1D797: "O̵",                 // '𝞗'
+	0x1D798: "l",                  // '𝞘'
+	0x1D799: "K",                  // '𝞙'
+	0x1D79A: "Ʌ",                  // '𝞚'
+	0x1D79B: "M",                  // '𝞛'
+	0x1D79C: "N",                  // '𝞜'
+	0x1D79D: "Ξ",                  // '𝞝'
+	0x1D79E: "O",                  // '𝞞'
+	0x1D79F: "Π",                  // '𝞟'
+	0x1D7A0: "P",                  // '𝞠'
+	0x1D7A1: "O̵",                 // '𝞡'
+	0x1D7A2: "Ʃ",                  // '𝞢'
+	0x1D7A3: "T",                  // '𝞣'
+	0x1D7A4: "Y",                  // '𝞤'
+	0x1D7A5: "Φ",                  // '𝞥'
+	0x1D7A6: "X",                  // '𝞦'
+	0x1D7A7: "Ψ",                  // '𝞧'
+	0x1D7A8: "Ω",                  // '𝞨'
+	0x1D7A9: "∇",                  // '𝞩'
+	0x1D7AA: "a",                  // '𝞪'
+	0x1D7AB: "ß",                  // '𝞫'
+	0x1D7AC: "y",                  // '𝞬'
+	0x1D7AD: "ẟ",                  // '𝞭'
+	0x1D7AE: "ꞓ",                  // '𝞮'
+	0x1D7AF: "ζ",                  // '𝞯'
+	0x1D7B0: "n̩",                 // '𝞰'
+	0x1D7B1: "O̵",                 // '𝞱'
+	0x1D7B2: "i",                  // '𝞲'
+	0x1D7B3: "ĸ",                  // '𝞳'
+	0x1D7B4: "λ",                  // '𝞴'
+	0x1D7B5: "μ",                  // '𝞵'
+	0x1D7B6: "v",                  // '𝞶'
+	0x1D7B7: "ξ",                  // '𝞷'
+	0x1D7B8: "o",                  // '𝞸'
+	0x1D7B9: "π",                  // '𝞹'
+	0x1D7BA: "p",                  // '𝞺'
+	0x1D7BB: "ς",                  // '𝞻'
+	0x1D7BC: "o",                  // '𝞼'
+	0x1D7BD: "ᴛ",                  // '𝞽'
+	0x1D7BE: "u",                  // '𝞾'
+	0x1D7BF: "ɸ",                  // '𝞿'
+	0x1D7C0: "χ",                  // '𝟀'
+	0x1D7C1: "ψ",                  // '𝟁'
+	0x1D7C2: "ω",                  // '𝟂'
+	0x1D7C3: "∂",                  // '𝟃'
+	0x1D7C4: "ꞓ",                  // '𝟄'
+	0x1D7C5: "O̵",                 // '𝟅'
+	0x1D7C6: "ĸ",                  // '𝟆'
+	0x1D7C7: "ɸ",                  // '𝟇'
+	0x1D7C8: "p",                  // '𝟈'
+	0x1D7C9: "π",                  // '𝟉'
+	0x1D7CA: "F",                  // '𝟊'
+	0x1D7CB: "ϝ",                  // '𝟋'
+	0x1D7CE: "O",                  // '𝟎'
+	0x1D7CF: "l",                  // '𝟏'
+	0x1D7D0: "2",                  // '𝟐'
+	0x1D7D1: "3",                  // '𝟑'
+	0x1D7D2: "4",                  // '𝟒'
+	0x1D7D3: "5",                  // '𝟓'
+	0x1D7D4: "6",                  // '𝟔'
+	0x1D7D5: "7",                  // '𝟕'
+	0x1D7D6: "8",                  // '𝟖'
+	0x1D7D7: "9",                  // '𝟗'
+	0x1D7D8: "O",                  // '𝟘'
+	0x1D7D9: "l",                  // '𝟙'
+	0x1D7DA: "2",                  // '𝟚'
+	0x1D7DB: "3",                  // '𝟛'
+	0x1D7DC: "4",                  // '𝟜'
+	0x1D7DD: "5",                  // '𝟝'
+	0x1D7DE: "6",                  // '𝟞'
+	0x1D7DF: "7",                  // '𝟟'
+	0x1D7E0: "8",                  // '𝟠'
+	0x1D7E1: "9",                  // '𝟡'
+	0x1D7E2: "O",                  // '𝟢'
+	0x1D7E3: "l",                  // '𝟣'
+	0x1D7E4: "2",                  // '𝟤'
+	0x1D7E5: "3",                  // '𝟥'
+	0x1D7E6: "4",                  // '𝟦'
+	0x1D7E7: "5",                  // '𝟧'
+	0x1D7E8: "6",                  // '𝟨'
+	0x1D7E9: "7",                  // '𝟩'
+	0x1D7EA: "8",                  // '𝟪'
+	0x1D7EB: "9",                  // '𝟫'
+	0x1D7EC: "O",                  // '𝟬'
+	0x1D7ED: "l",                  // '𝟭'
+	0x1D7EE: "2",                  // '𝟮'
+	0x1D7EF: "3",                  // '𝟯'
+	0x1D7F0: "4",                  // '𝟰'
+	0x1D7F1: "5",                  // '𝟱'
+	0x1D7F2: "6",                  // '𝟲'
+	0x1D7F3: "7",                  // '𝟳'
+	0x1D7F4: "8",                  // '𝟴'
+	0x1D7F5: "9",                  // '𝟵'
+	0x1D7F6: "O",                  // '𝟶'
+	0x1D7F7: "l",                  // '𝟷'
+	0x1D7F8: "2",                  // '𝟸'
+	0x1D7F9: "3",                  // '𝟹'
+	0x1D7FA: "4",                  // '𝟺'
+	0x1D7FB: "5",                  // '𝟻'
+	0x1D7FC: "6",                  // '𝟼'
+	0x1D7FD: "7",                  // '𝟽'
+	0x1D7FE: "8",                  // '𝟾'
+	0x1D7FF: "9",                  // '𝟿'
+	0x1E8C7: "l",                  // '𞣇'
+	0x1E8C8: "∠",                  // '𞣈'
+	0x1E8C9: "٣",                  // '𞣉'
+	0x1E8CB: "8",                  // '𞣋'
+	0x1E8CC: "∂",                  // '𞣌'
+	0x1E8CD: "∂̵",                 // '𞣍'
+	0x1EE00: "l",                  // '𞸀'
+	0x1EE01: "ب",                  // '𞸁'
+	0x1EE02: "ج",                  // '𞸂'
+	0x1EE03: "د",                  // '𞸃'
+	0x1EE05: "و",                  // '𞸅'
+	0x1EE06: "ز",                  // '𞸆'
+	0x1EE07: "ح",                  // '𞸇'
+	0x1EE08: "ط",                  // '𞸈'
+	0x1EE09: "ى",                  // '𞸉'
+	0x1EE0A: "ك",                  // '𞸊'
+	0x1EE0B: "ل",                  // '𞸋'
+	0x1EE0C: "م",                  // '𞸌'
+	0x1EE0D: "ن",                  // '𞸍'
+	0x1EE0E: "س",                  // '𞸎'
+	0x1EE0F: "ع",                  // '𞸏'
+	0x1EE10: "ف",                  // '𞸐'
+	0x1EE11: "ص",                  // '𞸑'
+	0x1EE12: "ق",                  // '𞸒'
+	0x1EE13: "ر",                  // '𞸓'
+	0x1EE14: "سۛ",                 // '𞸔'
+	0x1EE15: "ت",                  // '𞸕'
+	0x1EE16: "ىۛ",                 // '𞸖'
+	0x1EE17: "خ",                  // '𞸗'
+	0x1EE18: "ذ",                  // '𞸘'
+	0x1EE19: "ض",                  // '𞸙'
+	0x1EE1A: "ظ",                  // '𞸚'
+	0x1EE1B: "غ",                  // '𞸛'
+	0x1EE1C: "ى",                  // '𞸜'
+	0x1EE1D: "ى",                  // '𞸝'
+	0x1EE1E: "ڡ",                  // '𞸞'
+	0x1EE1F: "ڡ",                  // '𞸟'
+	0x1EE21: "ب",                  // '𞸡'
+	0x1EE22: "ج",                  // '𞸢'
+	0x1EE24: "o",                  // '𞸤'
+	0x1EE27: "ح",                  // '𞸧'
+	0x1EE29: "ى",                  // '𞸩'
+	0x1EE2A: "ك",                  // '𞸪'
+	0x1EE2B: "ل",                  // '𞸫'
+	0x1EE2C: "م",                  // '𞸬'
+	0x1EE2D: "ن",                  // '𞸭'
+	0x1EE2E: "س",                  // '𞸮'
+	0x1EE2F: "ع",                  // '𞸯'
+	0x1EE30: "ف",                  // '𞸰'
+	0x1EE31: "ص",                  // '𞸱'
+	0x1EE32: "ق",                  // '𞸲'
+	0x1EE34: "سۛ",                 // '𞸴'
+	0x1EE35: "ت",                  // '𞸵'
+	0x1EE36: "ىۛ",                 // '𞸶'
+	0x1EE37: "خ",                  // '𞸷'
+	0x1EE39: "ض",                  // '𞸹'
+	0x1EE3B: "غ",                  // '𞸻'
+	0x1EE42: "ج",                  // '𞹂'
+	0x1EE47: "ح",                  // '𞹇'
+	0x1EE49: "ى",                  // '𞹉'
+	0x1EE4B: "ل",                  // '𞹋'
+	0x1EE4D: "ن",                  // '𞹍'
+	0x1EE4E: "س",                  // '𞹎'
+	0x1EE4F: "ع",                  // '𞹏'
+	0x1EE51: "ص",                  // '𞹑'
+	0x1EE52: "ق",                  // '𞹒'
+	0x1EE54: "سۛ",                 // '𞹔'
+	0x1EE57: "خ",                  // '𞹗'
+	0x1EE59: "ض",                  // '𞹙'
+	0x1EE5B: "غ",                  // '𞹛'
+	0x1EE5D: "ى",                  // '𞹝'
+	0x1EE5F: "ڡ",                  // '𞹟'
+	0x1EE61: "ب",                  // '𞹡'
+	0x1EE62: "ج",                  // '𞹢'
+	0x1EE64: "o",                  // '𞹤'
+	0x1EE67: "ح",                  // '𞹧'
+	0x1EE68: "ط",                  // '𞹨'
+	0x1EE69: "ى",                  // '𞹩'
+	0x1EE6A: "ك",                  // '𞹪'
+	0x1EE6C: "م",                  // '𞹬'
+	0x1EE6D: "ن",                  // '𞹭'
+	0x1EE6E: "س",                  // '𞹮'
+	0x1EE6F: "ع",                  // '𞹯'
+	0x1EE70: "ف",                  // '𞹰'
+	0x1EE71: "ص",                  // '𞹱'
+	0x1EE72: "ق",                  // '𞹲'
+	0x1EE74: "سۛ",                 // '𞹴'
+	0x1EE75: "ت",                  // '𞹵'
+	0x1EE76: "ىۛ",                 // '𞹶'
+	0x1EE77: "خ",                  // '𞹷'
+	0x1EE79: "ض",                  // '𞹹'
+	0x1EE7A: "ظ",                  // '𞹺'
+	0x1EE7B: "غ",                  // '𞹻'
+	0x1EE7C: "ى",                  // '𞹼'
+	0x1EE7E: "ڡ",                  // '𞹾'
+	0x1EE80: "l",                  // '𞺀'
+	0x1EE81: "ب",                  // '𞺁'
+	0x1EE82: "ج",                  // '𞺂'
+	0x1EE83: "د",                  // '𞺃'
+	0x1EE84: "o",                  // '𞺄'
+	0x1EE85: "و",                  // '𞺅'
+	0x1EE86: "ز",                  // '𞺆'
+	0x1EE87: "ح",                  // '𞺇'
+	0x1EE88: "ط",                  // '𞺈'
+	0x1EE89: "ى",                  // '𞺉'
+	0x1EE8B: "ل",                  // '𞺋'
+	0x1EE8C: "م",                  // '𞺌'
+	0x1EE8D: "ن",                  // '𞺍'
+	0x1EE8E: "س",                  // '𞺎'
+	0x1EE8F: "ع",                  // '𞺏'
+	0x1EE90: "ف",                  // '𞺐'
+	0x1EE91: "ص",                  // '𞺑'
+	0x1EE92: "ق",                  // '𞺒'
+	0x1EE93: "ر",                  // '𞺓'
+	0x1EE94: "سۛ",                 // '𞺔'
+	0x1EE95: "ت",                  // '𞺕'
+	0x1EE96: "ىۛ",                 // '𞺖'
+	0x1EE97: "خ",                  // '𞺗'
+	0x1EE98: "ذ",                  // '𞺘'
+	0x1EE99: "ض",                  // '𞺙'
+	0x1EE9A: "ظ",                  // '𞺚'
+	0x1EE9B: "غ",                  // '𞺛'
+	0x1EEA1: "ب",                  // '𞺡'
+	0x1EEA2: "ج",                  // '𞺢'
+	0x1EEA3: "د",                  // '𞺣'
+	0x1EEA5: "و",                  // '𞺥'
+	0x1EEA6: "ز",                  // '𞺦'
+	0x1EEA7: "ح",                  // '𞺧'
+	0x1EEA8: "ط",                  // '𞺨'
+	0x1EEA9: "ى",                  // '𞺩'
+	0x1EEAB: "ل",                  // '𞺫'
+	0x1EEAC: "م",                  // '𞺬'
+	0x1EEAD: "ن",                  // '𞺭'
+	0x1EEAE: "س",                  // '𞺮'
+	0x1EEAF: "ع",                  // '𞺯'
+	0x1EEB0: "ف",                  // '𞺰'
+	0x1EEB1: "ص",                  // '𞺱'
+	0x1EEB2: "ق",                  // '𞺲'
+	0x1EEB3: "ر",                  // '𞺳'
+	0x1EEB4: "سۛ",                 // '𞺴'
+	0x1EEB5: "ت",                  // '𞺵'
+	0x1EEB6: "ىۛ",                 // '𞺶'
+	0x1EEB7: "خ",                  // '𞺷'
+	0x1EEB8: "ذ",                  // '𞺸'
+	0x1EEB9: "ض",                  // '𞺹'
+	0x1EEBA: "ظ",                  // '𞺺'
+	0x1EEBB: "غ",                  // '𞺻'
+	0x1F100: "O.",                 // '🄀'
+	0x1F101: "O,",                 // '🄁'
+	0x1F102: "l,",                 // '🄂'
+	0x1F103: "2,",                 // '🄃'
+	0x1F104: "3,",                 // '🄄'
+	0x1F105: "4,",                 // '🄅'
+	0x1F106: "5,",                 // '🄆'
+	0x1F107: "6,",                 // '🄇'
+	0x1F108: "7,",                 // '🄈'
+	0x1F109: "8,",                 // '🄉'
+	0x1F10A: "9,",                 // '🄊'
+	0x1F10F: "$⃠",                 // '🄏'
+	0x1F110: "(A)",                // '🄐'
+	0x1F111: "(B)",                // '🄑'
+	0x1F112: "(C)",                // '🄒'
+	0x1F113: "(D)",                // '🄓'
+	0x1F114: "(E)",                // '🄔'
+	0x1F115: "(F)",                // '🄕'
+	0x1F116: "(G)",                // '🄖'
+	0x1F117: "(H)",                // '🄗'
+	0x1F118: "(l)",                // '🄘'
+	0x1F119: "(J)",                // '🄙'
+	0x1F11A: "(K)",                // '🄚'
+	0x1F11B: "(L)",                // '🄛'
+	0x1F11C: "(M)",                // '🄜'
+	0x1F11D: "(N)",                // '🄝'
+	0x1F11E: "(O)",                // '🄞'
+	0x1F11F: "(P)",                // '🄟'
+	0x1F120: "(Q)",                // '🄠'
+	0x1F121: "(R)",                // '🄡'
+	0x1F122: "(S)",                // '🄢'
+	0x1F123: "(T)",                // '🄣'
+	0x1F124: "(U)",                // '🄤'
+	0x1F125: "(V)",                // '🄥'
+	0x1F126: "(W)",                // '🄦'
+	0x1F127: "(X)",                // '🄧'
+	0x1F128: "(Y)",                // '🄨'
+	0x1F129: "(Z)",                // '🄩'
+	0x1F12A: "(S)",                // '🄪'
+	0x1F16D: "㏄\t⃝",               // '🅭'
+	0x1F16E: "C⃠",                 // '🅮'
+	0x1F240: "(本)",                // '🉀'
+	0x1F241: "(三)",                // '🉁'
+	0x1F242: "(二)",                // '🉂'
+	0x1F243: "(安)",                // '🉃'
+	0x1F244: "(点)",                // '🉄'
+	0x1F245: "(打)",                // '🉅'
+	0x1F246: "(盗)",                // '🉆'
+	0x1F247: "(勝)",                // '🉇'
+	0x1F248: "(敗)",                // '🉈'
+	0x1F312: "☽",                  // '🌒'
+	0x1F318: "☾",                  // '🌘'
+	0x1F319: "☽",                  // '🌙'
+	0x1F700: "QE",                 // '🜀'
+	0x1F701: "Ꙙ",                  // '🜁'
+	0x1F702: "Δ",                  // '🜂'
+	0x1F704: "𐊼",                  // '🜄'
+	0x1F707: "AR",                 // '🜇'
+	0x1F708: "Vᷤ",                 // '🜈'
+	0x1F70A: "☩",                  // '🜊'
+	0x1F714: "O̵",                 // '🜔'
+	0x1F728: "𐊨",                  // '🜨'
+	0x1F73A: "⧟",                  // '🜺'
+	0x1F74C: "C",                  // '🝌'
+	0x1F754: "ᛜ",                  // '🝔'
+	0x1F755: "⊡",                  // '🝕'
+	0x1F75C: "sss",                // '🝜'
+	0x1F75E: "≏",                  // '🝞'
+	0x1F768: "T",                  // '🝨'
+	0x1F76B: "MB",                 // '🝫'
+	0x1F76C: "VB",                 // '🝬'
+	0x1F771: "⊠",                  // '🝱'
+	0x1FBF0: "O",                  // '🯰'
+	0x1FBF1: "l",                  // '🯱'
+	0x1FBF2: "2",                  // '🯲'
+	0x1FBF3: "3",                  // '🯳'
+	0x1FBF4: "4",                  // '🯴'
+	0x1FBF5: "5",                  // '🯵'
+	0x1FBF6: "6",                  // '🯶'
+	0x1FBF7: "7",                  // '🯷'
+	0x1FBF8: "8",                  // '🯸'
+	0x1FBF9: "9",                  // '🯹'
+	0x21FE8: "❬",                  // '𡿨'
+	0x2F800: "丽",                  // '丽'
+	0x2F801: "丸",                  // '丸'
+	0x2F802: "乁",                  // '乁'
+	0x2F803: "𠄢",                  // '𠄢'
+	0x2F804: "你",                  // '你'
+	0x2F805: "侮",                  // '侮'
+	0x2F806: "侻",                  // '侻'
+	0x2F807: "併",                  // '倂'
+	0x2F808: "偺",                  // '偺'
+	0x2F809: "備",                  // '備'
+	0x2F80A: "僧",                  // '僧'
+	0x2F80B: "像",                  // '像'
+	0x2F80C: "㒞",                  // '㒞'
+	0x2F80D: "𠘺",                  // '𠘺'
+	0x2F80E: "免",                  // '免'
+	0x2F80F: "兔",                  // '兔'
+	0x2F810: "兤",                  // '兤'
+	0x2F811: "具",                  // '具'
+	0x2F812: "𠔜",                  // '𠔜'
+	0x2F813: "㒹",                  // '㒹'
+	0x2F814: "內",                  // '內'
+	0x2F815: "再",                  // '再'
+	0x2F816: "𠕋",                  // '𠕋'
+	0x2F817: "冗",                  // '冗'
+	0x2F818: "冤",                  // '冤'
+	0x2F819: "仌",                  // '仌'
+	0x2F81A: "冬",                  // '冬'
+	0x2F81B: "况",                  // '况'
+	0x2F81C: "𩇟",                  // '𩇟'
+	0x2F81D: "凵",                  // '凵'
+	0x2F81E: "刃",                  // '刃'
+	0x2F81F: "㓟",                  // '㓟'
+	0x2F820: "刻",                  // '刻'
+	0x2F821: "剆",                  // '剆'
+	0x2F822: "割",                  // '割'
+	0x2F823: "剷",                  // '剷'
+	0x2F824: "㔕",                  // '㔕'
+	0x2F825: "勇",                  // '勇'
+	0x2F826: "勉",                  // '勉'
+	0x2F827: "勤",                  // '勤'
+	0x2F828: "勺",                  // '勺'
+	0x2F829: "包",                  // '包'
+	0x2F82A: "匆",                  // '匆'
+	0x2F82B: "北",                  // '北'
+	0x2F82C: "卉",                  // '卉'
+	0x2F82D: "卑",                  // '卑'
+	0x2F82E: "博",                  // '博'
+	0x2F82F: "即",                  // '即'
+	0x2F830: "卽",                  // '卽'
+	0x2F831: "卿",                  // '卿'
+	0x2F832: "卿",                  // '卿'
+	0x2F833: "卿",                  // '卿'
+	0x2F834: "𠨬",                  // '𠨬'
+	0x2F835: "灰",                  // '灰'
+	0x2F836: "及",                  // '及'
+	0x2F837: "叟",                  // '叟'
+	0x2F838: "𠭣",                  // '𠭣'
+	0x2F839: "叫",                  // '叫'
+	0x2F83A: "叱",                  // '叱'
+	0x2F83B: "吆",                  // '吆'
+	0x2F83C: "咞",                  // '咞'
+	0x2F83D: "吸",                  // '吸'
+	0x2F83E: "呈",                  // '呈'
+	0x2F83F: "周",                  // '周'
+	0x2F840: "咢",                  // '咢'
+	0x2F841: "哶",                  // '哶'
+	0x2F842: "唐",                  // '唐'
+	0x2F843: "啓",                  // '啓'
+	0x2F844: "啣",                  // '啣'
+	0x2F845: "善",                  // '善'
+	0x2F846: "善",                  // '善'
+	0x2F847: "喙",                  // '喙'
+	0x2F848: "喫",                  // '喫'
+	0x2F849: "喳",                  // '喳'
+	0x2F84A: "嗂",                  // '嗂'
+	0x2F84B: "圖",                  // '圖'
+	0x2F84C: "嘆",                  // '嘆'
+	0x2F84D: "圗",                  // '圗'
+	0x2F84E: "噑",                  // '噑'
+	0x2F84F: "噴",                  // '噴'
+	0x2F850: "切",                  // '切'
+	0x2F851: "壮",                  // '壮'
+	0x2F852: "城",                  // '城'
+	0x2F853: "埴",                  // '埴'
+	0x2F854: "堍",                  // '堍'
+	0x2F855: "型",                  // '型'
+	0x2F856: "堲",                  // '堲'
+	0x2F857: "報",                  // '報'
+	0x2F858: "墬",                  // '墬'
+	0x2F859: "𡓤",                  // '𡓤'
+	0x2F85A: "売",                  // '売'
+	0x2F85B: "壷",                  // '壷'
+	0x2F85C: "夆",                  // '夆'
+	0x2F85D: "多",                  // '多'
+	0x2F85E: "夢",                  // '夢'
+	0x2F85F: "奢",                  // '奢'
+	0x2F860: "𡚨",                  // '𡚨'
+	0x2F861: "𡛪",                  // '𡛪'
+	0x2F862: "姬",                  // '姬'
+	0x2F863: "娛",                  // '娛'
+	0x2F864: "娧",                  // '娧'
+	0x2F865: "姘",                  // '姘'
+	0x2F866: "婦",                  // '婦'
+	0x2F867: "㛮",                  // '㛮'
+	0x2F868: "㛼",                  // '㛼'
+	0x2F869: "嬈",                  // '嬈'
+	0x2F86A: "嬾",                  // '嬾'
+	0x2F86B: "嬾",                  // '嬾'
+	0x2F86C: "𡧈",                  // '𡧈'
+	0x2F86D: "寃",                  // '寃'
+	0x2F86E: "寘",                  // '寘'
+	0x2F86F: "寧",                  // '寧'
+	0x2F870: "寳",                  // '寳'
+	0x2F871: "𡬘",                  // '𡬘'
+	0x2F872: "寿",                  // '寿'
+	0x2F873: "将",                  // '将'
+	0x2F874: "当",                  // '当'
+	0x2F875: "尢",                  // '尢'
+	0x2F876: "㞁",                  // '㞁'
+	0x2F877: "屠",                  // '屠'
+	0x2F878: "屮",                  // '屮'
+	0x2F879: "峀",                  // '峀'
+	0x2F87A: "岍",                  // '岍'
+	0x2F87B: "𡷤",                  // '𡷤'
+	0x2F87C: "嵃",                  // '嵃'
+	0x2F87D: "𡷦",                  // '𡷦'
+	0x2F87E: "嵮",                  // '嵮'
+	0x2F87F: "嵫",                  // '嵫'
+	0x2F880: "嵼",                  // '嵼'
+	0x2F881: "巡",                  // '巡'
+	0x2F882: "巢",                  // '巢'
+	0x2F883: "㠯",                  // '㠯'
+	0x2F884: "巽",                  // '巽'
+	0x2F885: "帨",                  // '帨'
+	0x2F886: "帽",                  // '帽'
+	0x2F887: "幩",                  // '幩'
+	0x2F888: "㡢",                  // '㡢'
+	0x2F889: "𢆃",                  // '𢆃'
+	0x2F88A: "㡼",                  // '㡼'
+	0x2F88B: "庰",                  // '庰'
+	0x2F88C: "庳",                  // '庳'
+	0x2F88D: "庶",                  // '庶'
+	0x2F88E: "廊",                  // '廊'
+	0x2F88F: "𪎒",                  // '𪎒'
+	0x2F890: "廾",                  // '廾'
+	0x2F891: "𢌱",                  // '𢌱'
+	0x2F892: "𢌱",                  // '𢌱'
+	0x2F893: "舁",                  // '舁'
+	0x2F894: "弢",                  // '弢'
+	0x2F895: "弢",                  // '弢'
+	0x2F896: "㣇",                  // '㣇'
+	0x2F897: "𣊸",                  // '𣊸'
+	0x2F898: "𦇚",                  // '𦇚'
+	0x2F899: "形",                  // '形'
+	0x2F89A: "彫",                  // '彫'
+	0x2F89B: "㣣",                  // '㣣'
+	0x2F89C: "徚",                  // '徚'
+	0x2F89D: "忍",                  // '忍'
+	0x2F89E: "志",                  // '志'
+	0x2F89F: "忹",                  // '忹'
+	0x2F8A0: "悁",                  // '悁'
+	0x2F8A1: "㤺",                  // '㤺'
+	0x2F8A2: "㤜",                  // '㤜'
+	0x2F8A3: "悔",                  // '悔'
+	0x2F8A4: "𢛔",                  // '𢛔'
+	0x2F8A5: "惇",                  // '惇'
+	0x2F8A6: "慈",                  // '慈'
+	0x2F8A7: "慌",                  // '慌'
+	0x2F8A8: "慎",                  // '慎'
+	0x2F8A9: "慌",                  // '慌'
+	0x2F8AA: "慺",                  // '慺'
+	0x2F8AB: "憎",                  // '憎'
+	0x2F8AC: "憲",                  // '憲'
+	0x2F8AD: "憤",                  // '憤'
+	0x2F8AE: "憯",                  // '憯'
+	0x2F8AF: "懞",                  // '懞'
+	0x2F8B0: "懲",                  // '懲'
+	0x2F8B1: "懶",                  // '懶'
+	0x2F8B2: "成",                  // '成'
+	0x2F8B3: "戛",                  // '戛'
+	0x2F8B4: "扝",                  // '扝'
+	0x2F8B5: "抱",                  // '抱'
+	0x2F8B6: "拔",                  // '拔'
+	0x2F8B7: "捐",                  // '捐'
+	0x2F8B8: "𢬌",                  // '𢬌'
+	0x2F8B9: "挽",                  // '挽'
+	0x2F8BA: "拼",                  // '拼'
+	0x2F8BB: "捨",                  // '捨'
+	0x2F8BC: "掃",                  // '掃'
+	0x2F8BD: "揤",                  // '揤'
+	0x2F8BE: "𢯱",                  // '𢯱'
+	0x2F8BF: "搢",                  // '搢'
+	0x2F8C0: "揅",                  // '揅'
+	0x2F8C1: "掩",                  // '掩'
+	0x2F8C2: "㨮",                  // '㨮'
+	0x2F8C3: "摩",                  // '摩'
+	0x2F8C4: "摾",                  // '摾'
+	0x2F8C5: "撝",                  // '撝'
+	0x2F8C6: "摷",                  // '摷'
+	0x2F8C7: "㩬",                  // '㩬'
+	0x2F8C8: "敏",                  // '敏'
+	0x2F8C9: "敬",                  // '敬'
+	0x2F8CA: "𣀊",                  // '𣀊'
+	0x2F8CB: "旣",                  // '旣'
+	0x2F8CC: "書",                  // '書'
+	0x2F8CD: "晉",                  // '晉'
+	0x2F8CE: "㬙",                  // '㬙'
+	0x2F8CF: "暑",                  // '暑'
+	0x2F8D0: "㬈",                  // '㬈'
+	0x2F8D1: "㫤",                  // '㫤'
+	0x2F8D2: "冒",                  // '冒'
+	0x2F8D3: "冕",                  // '冕'
+	0x2F8D4: "最",                  // '最'
+	0x2F8D5: "暜",                  // '暜'
+	0x2F8D6: "肭",                  // '肭'
+	0x2F8D7: "䏙",                  // '䏙'
+	0x2F8D8: "朗",                  // '朗'
+	0x2F8D9: "望",                  // '望'
+	0x2F8DA: "朡",                  // '朡'
+	0x2F8DB: "杞",                  // '杞'
+	0x2F8DC: "杓",                  // '杓'
+	0x2F8DD: "𣏃",                  // '𣏃'
+	0x2F8DE: "㭉",                  // '㭉'
+	0x2F8DF: "柺",                  // '柺'
+	0x2F8E0: "枅",                  // '枅'
+	0x2F8E1: "桒",                  // '桒'
+	0x2F8E2: "梅",                  // '梅'
+	0x2F8E3: "𣑭",                  // '𣑭'
+	0x2F8E4: "梎",                  // '梎'
+	0x2F8E5: "栟",                  // '栟'
+	0x2F8E6: "椔",                  // '椔'
+	0x2F8E7: "㮝",                  // '㮝'
+	0x2F8E8: "楂",                  // '楂'
+	0x2F8E9: "榣",                  // '榣'
+	0x2F8EA: "槪",                  // '槪'
+	0x2F8EB: "檨",                  // '檨'
+	0x2F8EC: "𣚣",                  // '𣚣'
+	0x2F8ED: "櫛",                  // '櫛'
+	0x2F8EE: "㰘",                  // '㰘'
+	0x2F8EF: "次",                  // '次'
+	0x2F8F0: "𣢧",                  // '𣢧'
+	0x2F8F1: "歔",                  // '歔'
+	0x2F8F2: "㱎",                  // '㱎'
+	0x2F8F3: "歲",                  // '歲'
+	0x2F8F4: "殟",                  // '殟'
+	0x2F8F5: "殺",                  // '殺'
+	0x2F8F6: "殻",                  // '殻'
+	0x2F8F7: "𣪍",                  // '𣪍'
+	0x2F8F8: "𡴋",                  // '𡴋'
+	0x2F8F9: "𣫺",                  // '𣫺'
+	0x2F8FA: "汎",                  // '汎'
+	0x2F8FB: "𣲼",                  // '𣲼'
+	0x2F8FC: "沿",                  // '沿'
+	0x2F8FD: "泍",                  // '泍'
+	0x2F8FE: "汧",                  // '汧'
+	0x2F8FF: "洖",                  // '洖'
+	0x2F900: "派",                  // '派'
+	0x2F901: "海",                  // '海'
+	0x2F902: "流",                  // '流'
+	0x2F903: "浩",                  // '浩'
+	0x2F904: "浸",                  // '浸'
+	0x2F905: "涅",                  // '涅'
+	0x2F906: "𣴞",                  // '𣴞'
+	0x2F907: "洴",                  // '洴'
+	0x2F908: "港",                  // '港'
+	0x2F909: "湮",                  // '湮'
+	0x2F90A: "㴳",                  // '㴳'
+	0x2F90B: "滋",                  // '滋'
+	0x2F90C: "滇",                  // '滇'
+	0x2F90D: "𣻑",                  // '𣻑'
+	0x2F90E: "淹",                  // '淹'
+	0x2F90F: "潮",                  // '潮'
+	0x2F910: "𣽞",                  // '𣽞'
+	0x2F911: "𣾎",                  // '𣾎'
+	0x2F912: "濆",                  // '濆'
+	0x2F913: "瀹",                  // '瀹'
+	0x2F914: "瀞",                  // '瀞'
+	0x2F915: "瀛",                  // '瀛'
+	0x2F916: "㶖",                  // '㶖'
+	0x2F917: "灊",                  // '灊'
+	0x2F918: "災",                  // '災'
+	0x2F919: "灷",                  // '灷'
+	0x2F91A: "炭",                  // '炭'
+	0x2F91B: "𠔥",                  // '𠔥'
+	0x2F91C: "煅",                  // '煅'
+	0x2F91D: "𤉣",                  // '𤉣'
+	0x2F91E: "熜",                  // '熜'
+	0x2F91F: "𤎫",                  // '𤎫'
+	0x2F920: "爨",                  // '爨'
+	0x2F921: "爵",                  // '爵'
+	0x2F922: "牐",                  // '牐'
+	0x2F923: "𤘈",                  // '𤘈'
+	0x2F924: "犀",                  // '犀'
+	0x2F925: "犕",                  // '犕'
+	0x2F926: "𤜵",                  // '𤜵'
+	0x2F927: "𤠔",                  // '𤠔'
+	0x2F928: "獺",                  // '獺'
+	0x2F929: "王",                  // '王'
+	0x2F92A: "㺬",                  // '㺬'
+	0x2F92B: "玥",                  // '玥'
+	0x2F92C: "㺸",                  // '㺸'
+	0x2F92D: "㺸",                  // '㺸'
+	0x2F92E: "瑇",                  // '瑇'
+	0x2F92F: "瑜",                  // '瑜'
+	0x2F930: "瑱",                  // '瑱'
+	0x2F931: "璅",                  // '璅'
+	0x2F932: "瓊",                  // '瓊'
+	0x2F933: "㼛",                  // '㼛'
+	0x2F934: "甤",                  // '甤'
+	0x2F935: "𤰶",                  // '𤰶'
+	0x2F936: "甾",                  // '甾'
+	0x2F937: "𤲒",                  // '𤲒'
+	0x2F938: "異",                  // '異'
+	0x2F939: "𢆟",                  // '𢆟'
+	0x2F93A: "瘐",                  // '瘐'
+	0x2F93B: "𤾡",                  // '𤾡'
+	0x2F93C: "𤾸",                  // '𤾸'
+	0x2F93D: "𥁄",                  // '𥁄'
+	0x2F93E: "㿼",                  // '㿼'
+	0x2F93F: "䀈",                  // '䀈'
+	0x2F940: "直",                  // '直'
+	0x2F941: "𥃳",                  // '𥃳'
+	0x2F942: "𥃲",                  // '𥃲'
+	0x2F943: "𥄙",                  // '𥄙'
+	0x2F944: "𥄳",                  // '𥄳'
+	0x2F945: "眞",                  // '眞'
+	0x2F946: "真",                  // '真'
+	0x2F947: "真",                  // '真'
+	0x2F948: "睊",                  // '睊'
+	0x2F949: "䀹",                  // '䀹'
+	0x2F94A: "瞋",                  // '瞋'
+	0x2F94B: "䁆",                  // '䁆'
+	0x2F94C: "䂖",                  // '䂖'
+	0x2F94D: "𥐝",                  // '𥐝'
+	0x2F94E: "硎",                  // '硎'
+	0x2F94F: "碌",                  // '碌'
+	0x2F950: "磌",                  // '磌'
+	0x2F951: "䃣",                  // '䃣'
+	0x2F952: "𥘦",                  // '𥘦'
+	0x2F953: "祖",                  // '祖'
+	0x2F954: "𥚚",                  // '𥚚'
+	0x2F955: "𥛅",                  // '𥛅'
+	0x2F956: "福",                  // '福'
+	0x2F957: "秫",                  // '秫'
+	0x2F958: "䄯",                  // '䄯'
+	0x2F959: "穀",                  // '穀'
+	0x2F95A: "穊",                  // '穊'
+	0x2F95B: "穏",                  // '穏'
+	0x2F95C: "𥥼",                  // '𥥼'
+	0x2F95D: "𥪧",                  // '𥪧'
+	0x2F95E: "𥪧",                  // '𥪧'
+	0x2F95F: "竮",                  // '竮'
+	0x2F960: "䈂",                  // '䈂'
+	0x2F961: "𥮫",                  // '𥮫'
+	0x2F962: "篆",                  // '篆'
+	0x2F963: "築",                  // '築'
+	0x2F964: "䈧",                  // '䈧'
+	0x2F965: "𥲀",                  // '𥲀'
+	0x2F966: "糒",                  // '糒'
+	0x2F967: "䊠",                  // '䊠'
+	0x2F968: "糨",                  // '糨'
+	0x2F969: "糣",                  // '糣'
+	0x2F96A: "紀",                  // '紀'
+	0x2F96B: "𥾆",                  // '𥾆'
+	0x2F96C: "絣",                  // '絣'
+	0x2F96D: "䌁",                  // '䌁'
+	0x2F96E: "緇",                  // '緇'
+	0x2F96F: "縂",                  // '縂'
+	0x2F970: "繅",                  // '繅'
+	0x2F971: "䌴",                  // '䌴'
+	0x2F972: "𦈨",                  // '𦈨'
+	0x2F973: "𦉇",                  // '𦉇'
+	0x2F974: "䍙",                  // '䍙'
+	0x2F975: "𦋙",                  // '𦋙'
+	0x2F976: "罺",                  // '罺'
+	0x2F977: "𦌾",                  // '𦌾'
+	0x2F978: "羕",                  // '羕'
+	0x2F979: "翺",                  // '翺'
+	0x2F97A: "者",                  // '者'
+	0x2F97B: "𦓚",                  // '𦓚'
+	0x2F97C: "𦔣",                  // '𦔣'
+	0x2F97D: "聠",                  // '聠'
+	0x2F97E: "𦖨",                  // '𦖨'
+	0x2F97F: "聰",                  // '聰'
+	0x2F980: "𣍟",                  // '𣍟'
+	0x2F981: "䏕",                  // '䏕'
+	0x2F982: "育",                  // '育'
+	0x2F983: "脃",                  // '脃'
+	0x2F984: "䐋",                  // '䐋'
+	0x2F985: "脾",                  // '脾'
+	0x2F986: "媵",                  // '媵'
+	0x2F987: "𦞧",                  // '𦞧'
+	0x2F988: "𦞵",                  // '𦞵'
+	0x2F989: "𣎓",                  // '𣎓'
+	0x2F98A: "𣎜",                  // '𣎜'
+	0x2F98B: "舁",                  // '舁'
+	0x2F98C: "舄",                  // '舄'
+	0x2F98D: "辞",                  // '辞'
+	0x2F98E: "䑫",                  // '䑫'
+	0x2F98F: "芑",                  // '芑'
+	0x2F990: "芋",                  // '芋'
+	0x2F991: "芝",                  // '芝'
+	0x2F992: "劳",                  // '劳'
+	0x2F993: "花",                  // '花'
+	0x2F994: "芳",                  // '芳'
+	0x2F995: "芽",                  // '芽'
+	0x2F996: "苦",                  // '苦'
+	0x2F997: "𦬼",                  // '𦬼'
+	0x2F998: "若",                  // '若'
+	0x2F999: "茝",                  // '茝'
+	0x2F99A: "荣",                  // '荣'
+	0x2F99B: "莭",                  // '莭'
+	0x2F99C: "茣",                  // '茣'
+	0x2F99D: "莽",                  // '莽'
+	0x2F99E: "菧",                  // '菧'
+	0x2F99F: "著",                  // '著'
+	0x2F9A0: "荓",                  // '荓'
+	0x2F9A1: "菊",                  // '菊'
+	0x2F9A2: "菌",                  // '菌'
+	0x2F9A3: "菜",                  // '菜'
+	0x2F9A4: "𦰶",                  // '𦰶'
+	0x2F9A5: "𦵫",                  // '𦵫'
+	0x2F9A6: "𦳕",                  // '𦳕'
+	0x2F9A7: "䔫",                  // '䔫'
+	0x2F9A8: "蓱",                  // '蓱'
+	0x2F9A9: "蓳",                  // '蓳'
+	0x2F9AA: "蔖",                  // '蔖'
+	0x2F9AB: "𧏊",                  // '𧏊'
+	0x2F9AC: "蕤",                  // '蕤'
+	0x2F9AD: "𦼬",                  // '𦼬'
+	0x2F9AE: "䕝",                  // '䕝'
+	0x2F9AF: "䕡",                  // '䕡'
+	0x2F9B0: "𦾱",                  // '𦾱'
+	0x2F9B1: "𧃒",                  // '𧃒'
+	0x2F9B2: "䕫",                  // '䕫'
+	0x2F9B3: "虐",                  // '虐'
+	0x2F9B4: "虜",                  // '虜'
+	0x2F9B5: "虧",                  // '虧'
+	0x2F9B6: "虩",                  // '虩'
+	0x2F9B7: "蚩",                  // '蚩'
+	0x2F9B8: "蚈",                  // '蚈'
+	0x2F9B9: "蜎",                  // '蜎'
+	0x2F9BA: "蛢",                  // '蛢'
+	0x2F9BB: "蝹",                  // '蝹'
+	0x2F9BC: "蜨",                  // '蜨'
+	0x2F9BD: "蝫",                  // '蝫'
+	0x2F9BE: "螆",                  // '螆'
+	0x2F9BF: "䗗",                  // '䗗'
+	0x2F9C0: "蟡",                  // '蟡'
+	0x2F9C1: "蠁",                  // '蠁'
+	0x2F9C2: "䗹",                  // '䗹'
+	0x2F9C3: "衠",                  // '衠'
+	0x2F9C4: "衣",                  // '衣'
+	0x2F9C5: "𧙧",                  // '𧙧'
+	0x2F9C6: "裗",                  // '裗'
+	0x2F9C7: "裞",                  // '裞'
+	0x2F9C8: "䘵",                  // '䘵'
+	0x2F9C9: "裺",                  // '裺'
+	0x2F9CA: "㒻",                  // '㒻'
+	0x2F9CB: "𧢮",                  // '𧢮'
+	0x2F9CC: "𧥦",                  // '𧥦'
+	0x2F9CD: "䚾",                  // '䚾'
+	0x2F9CE: "䛇",                  // '䛇'
+	0x2F9CF: "誠",                  // '誠'
+	0x2F9D0: "諭",                  // '諭'
+	0x2F9D1: "變",                  // '變'
+	0x2F9D2: "豕",                  // '豕'
+	0x2F9D3: "𧲨",                  // '𧲨'
+	0x2F9D4: "貫",                  // '貫'
+	0x2F9D5: "賁",                  // '賁'
+	0x2F9D6: "贛",                  // '贛'
+	0x2F9D7: "起",                  // '起'
+	0x2F9D8: "𧼯",                  // '𧼯'
+	0x2F9D9: "𠠄",                  // '𠠄'
+	0x2F9DA: "跋",                  // '跋'
+	0x2F9DB: "趼",                  // '趼'
+	0x2F9DC: "跰",                  // '跰'
+	0x2F9DD: "𠣞",                  // '𠣞'
+	0x2F9DE: "軔",                  // '軔'
+	0x2F9DF: "輸",                  // '輸'
+	0x2F9E0: "𨗒",                  // '𨗒'
+	0x2F9E1: "𨗭",                  // '𨗭'
+	0x2F9E2: "邔",                  // '邔'
+	0x2F9E3: "郱",                  // '郱'
+	0x2F9E4: "鄑",                  // '鄑'
+	0x2F9E5: "𨜮",                  // '𨜮'
+	0x2F9E6: "鄛",                  // '鄛'
+	0x2F9E7: "鈸",                  // '鈸'
+	0x2F9E8: "鋗",                  // '鋗'
+	0x2F9E9: "鋘",                  // '鋘'
+	0x2F9EA: "鉼",                  // '鉼'
+	0x2F9EB: "鏹",                  // '鏹'
+	0x2F9EC: "鐕",                  // '鐕'
+	0x2F9ED: "𨯺",                  // '𨯺'
+	0x2F9EE: "開",                  // '開'
+	0x2F9EF: "䦕",                  // '䦕'
+	0x2F9F0: "閷",                  // '閷'
+	0x2F9F1: "𨵷",                  // '𨵷'
+	0x2F9F2: "䧦",                  // '䧦'
+	0x2F9F3: "雃",                  // '雃'
+	0x2F9F4: "嶲",                  // '嶲'
+	0x2F9F5: "霣",                  // '霣'
+	0x2F9F6: "𩅅",                  // '𩅅'
+	0x2F9F7: "𩈚",                  // '𩈚'
+	0x2F9F8: "䩮",                  // '䩮'
+	0x2F9F9: "䩶",                  // '䩶'
+	0x2F9FA: "韠",                  // '韠'
+	0x2F9FB: "𩐊",                  // '𩐊'
+	0x2F9FC: "䪲",                  // '䪲'
+	0x2F9FD: "𩒖",                  // '𩒖'
+	0x2F9FE: "頋",                  // '頋'
+	0x2F9FF: "頋",                  // '頋'
+	0x2FA00: "頩",                  // '頩'
+	0x2FA01: "𩖶",                  // '𩖶'
+	0x2FA02: "飢",                  // '飢'
+	0x2FA03: "䬳",                  // '䬳'
+	0x2FA04: "餩",                  // '餩'
+	0x2FA05: "馧",                  // '馧'
+	0x2FA06: "駂",                  // '駂'
+	0x2FA07: "駾",                  // '駾'
+	0x2FA08: "䯎",                  // '䯎'
+	0x2FA09: "𩬰",                  // '𩬰'
+	0x2FA0A: "鬒",                  // '鬒'
+	0x2FA0B: "鱀",                  // '鱀'
+	0x2FA0C: "鳽",                  // '鳽'
+	0x2FA0D: "䳎",                  // '䳎'
+	0x2FA0E: "䳭",                  // '䳭'
+	0x2FA0F: "鵧",                  // '鵧'
+	0x2FA10: "𪃎",                  // '𪃎'
+	0x2FA11: "䳸",                  // '䳸'
+	0x2FA12: "𪄅",                  // '𪄅'
+	0x2FA13: "𪈎",                  // '𪈎'
+	0x2FA14: "𪊑",                  // '𪊑'
+	0x2FA15: "麻",                  // '麻'
+	0x2FA16: "䵖",                  // '䵖'
+	0x2FA17: "黹",                  // '黹'
+	0x2FA18: "黾",                  // '黾'
+	0x2FA19: "鼅",                  // '鼅'
+	0x2FA1A: "鼏",                  // '鼏'
+	0x2FA1B: "鼖",                  // '鼖'
+	0x2FA1C: "鼻",                  // '鼻'
+	0x2FA1D: "𪘀",                  // '𪘀'
+}
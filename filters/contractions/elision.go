@@ -0,0 +1,109 @@
+package contractions
+
+import (
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// FrenchElisions are the elided forms which French attaches to the following word, e.g. l'homme
+var FrenchElisions = []string{
+	"c'", "d'", "j'", "l'", "m'", "n'", "s'", "t'", "qu'",
+	"jusqu'", "lorsqu'", "puisqu'", "quoiqu'",
+}
+
+// ItalianElisions are the elided forms which Italian attaches to the following word, e.g. dell'anno
+var ItalianElisions = []string{
+	"c'", "d'", "l'", "m'", "s'", "t'", "v'", "un'",
+	"all'", "dall'", "dell'", "nell'", "sull'", "coll'", "quell'", "bell'",
+	"quest'", "nessun'", "buon'", "sant'", "tutt'", "anch'", "com'", "dov'",
+}
+
+// French splits elisions from the following word, e.g. l'homme → l', homme, and qu’il → qu’, il
+var French = NewElision(FrenchElisions)
+
+// Italian splits elisions from the following word, e.g. dell'anno → dell', anno
+var Italian = NewElision(ItalianElisions)
+
+// NewElision creates a filter which splits elisions, such as l', from the following word, so that the word can be
+// matched on its own. Elisions are matched case-insensitively, with straight ' or smart ’ apostrophes. The resulting
+// tokens are as they appeared, and record the original token as their origin.
+func NewElision(elisions []string) jargon.Filter {
+	elider := NewElider(elisions)
+
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		t := &elided{
+			incoming: incoming,
+			outgoing: tokenqueue.New(),
+			elider:   elider,
+		}
+		return jargon.NewTokenStream(t.next)
+	}
+}
+
+// Elider splits elisions from the start of words, see NewElider
+type Elider struct {
+	set map[string]bool
+}
+
+// NewElider creates an Elider for the given elisions, such as FrenchElisions. Elisions are matched case-insensitively,
+// with straight ' or smart ’ apostrophes.
+func NewElider(elisions []string) Elider {
+	set := map[string]bool{}
+	for _, e := range elisions {
+		set[strings.ToLower(strings.ReplaceAll(e, "’", "'"))] = true
+	}
+	return Elider{set: set}
+}
+
+// Split splits an elision from the start of s, returning the elision and the rest, as they appeared, e.g. l'homme →
+// l', homme. It returns false if s does not start with an elision, or there is nothing following it.
+func (e Elider) Split(s string) (prefix, rest string, ok bool) {
+	i := strings.IndexAny(s, "'’")
+	if i < 0 || !e.set[strings.ToLower(s[:i])+"'"] {
+		return "", "", false
+	}
+
+	width := len("'")
+	if strings.HasPrefix(s[i:], "’") {
+		width = len("’")
+	}
+	prefix, rest = s[:i+width], s[i+width:]
+	if rest == "" {
+		return "", "", false
+	}
+	return prefix, rest, true
+}
+
+type elided struct {
+	incoming *jargon.TokenStream
+	outgoing *tokenqueue.TokenQueue
+	elider   Elider
+}
+
+func (t *elided) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	token, err := t.incoming.Next()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.IsPunct() || token.IsSpace() {
+		return token, nil
+	}
+
+	prefix, rest, ok := t.elider.Split(token.String())
+	if !ok {
+		return token, nil
+	}
+
+	t.outgoing.Push(jargon.NewTokenFrom(rest, false, token))
+	return jargon.NewTokenFrom(prefix, false, token), nil
+}
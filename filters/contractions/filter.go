@@ -1,30 +1,113 @@
-// Package contractions provides a filter to expand English contractions, such as "don't" → "does not", for use with jargon
+// Package contractions provides filters to expand English contractions, such as "don't" → "do not", to contract them,
+// and to split French and Italian elisions, such as "l'homme" → "l'", "homme", for use with jargon
 package contractions
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/synonyms"
 	"github.com/clipperhouse/jargon/tokenqueue"
 )
 
 //go:generate go run generate/main.go
 
+// Options determine the expansion of ambiguous contractions, which would need parts-of-speech to determine
+type Options struct {
+	// Had expands 'd as had rather than would, e.g. I'd → I had
+	Had bool
+	// Has expands 's as has rather than is, e.g. she's → she has
+	Has bool
+}
+
+// NewExpander creates a filter which expands contractions, with the given readings of ambiguous contractions
+func NewExpander(options Options) jargon.Filter {
+	lookups := []map[string]string{}
+	if options.Had {
+		lookups = append(lookups, had)
+	}
+	if options.Has {
+		lookups = append(lookups, has)
+	}
+	lookups = append(lookups, mappings)
+
+	return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+		t := &tokens{
+			incoming: incoming,
+			outgoing: tokenqueue.New(),
+			lookups:  lookups,
+		}
+		return jargon.NewTokenStream(t.next)
+	}
+}
+
 // Expand converts single-token contractions to non-contracted version. Examples:
 // don't → does not
 // We’ve → We have
 // SHE'S -> SHE IS
-func Expand(incoming *jargon.TokenStream) *jargon.TokenStream {
-	t := &tokens{
-		incoming: incoming,
-		outgoing: tokenqueue.New(),
-	}
-	return jargon.NewTokenStream(t.next)
+var Expand = NewExpander(Options{})
+
+// Contract converts multi-token expansions to contractions, the reverse of Expand. Examples:
+// do not → don't
+// We have → We've
+// SHE IS -> SHE'S
+//
+// Informal contractions, such as gonna, and ambiguous readings, such as she has, are not contracted.
+var Contract = synonyms.NewFilter(contracted, false, nil)
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "contractions",
+		Description: "expand contractions, e.g. Would've → Would have",
+		Params: []jargon.Param{
+			{Name: "had", Description: "whether to expand 'd as had rather than would", Default: "false", Options: []string{"true", "false"}},
+			{Name: "has", Description: "whether to expand 's as has rather than is", Default: "false", Options: []string{"true", "false"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			had, err := strconv.ParseBool(params["had"])
+			if err != nil {
+				return nil, fmt.Errorf("had: %v", err)
+			}
+			has, err := strconv.ParseBool(params["has"])
+			if err != nil {
+				return nil, fmt.Errorf("has: %v", err)
+			}
+			if !had && !has {
+				return Expand, nil
+			}
+			return NewExpander(Options{Had: had, Has: has}), nil
+		},
+	})
+	jargon.Register(jargon.Registration{
+		Name:        "contract",
+		Description: "contract expansions, e.g. Would have → Would've",
+		Filter:      Contract,
+	})
+	jargon.Register(jargon.Registration{
+		Name:        "elision",
+		Description: "split elided articles and pronouns from the following word, e.g. l'homme → l' homme",
+		Params: []jargon.Param{
+			{Name: "lang", Description: "language of input", Default: "french", Options: []string{"french", "italian"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			switch params["lang"] {
+			case "french":
+				return French, nil
+			case "italian":
+				return Italian, nil
+			}
+			return nil, fmt.Errorf("lang %q is not known; options are french, italian", params["lang"])
+		},
+	})
 }
 
 type tokens struct {
 	incoming *jargon.TokenStream
 	outgoing *tokenqueue.TokenQueue
+	// lookups are searched in order
+	lookups []map[string]string
 }
 
 func (t *tokens) next() (*jargon.Token, error) {
@@ -66,14 +149,23 @@ func (t *tokens) tryExpansion(token *jargon.Token, ignoreCase bool) (bool, error
 		key = strings.ToLower(key)
 	}
 
-	expansion, found := mappings[key]
+	var expansion string
+	var found bool
+	for _, lookup := range t.lookups {
+		expansion, found = lookup[key]
+		if found {
+			break
+		}
+	}
 
 	if found {
 		tokens, err := jargon.TokenizeString(expansion).ToSlice()
 		if err != nil {
 			return found, err
 		}
-		t.outgoing.Push(tokens...)
+		for _, tok := range tokens {
+			t.outgoing.Push(jargon.NewTokenFrom(tok.String(), tok.IsLemma(), token))
+		}
 	}
 
 	return found, nil
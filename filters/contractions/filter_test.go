@@ -1,6 +1,7 @@
 package contractions_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/clipperhouse/jargon"
@@ -21,3 +22,103 @@ func TestContractions(t *testing.T) {
 		t.Errorf("given %q, expected %q, got %q", given, expected, got)
 	}
 }
+
+func TestAmbiguous(t *testing.T) {
+	type test struct {
+		options  contractions.Options
+		expected string
+	}
+
+	given := "I'd say she's here"
+	tests := []test{
+		{contractions.Options{}, "I would say she is here"},
+		{contractions.Options{Had: true}, "I had say she is here"},
+		{contractions.Options{Has: true}, "I would say she has here"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(given).Filter(contractions.NewExpander(test.options)).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q with %+v, expected %q, got %q", given, test.options, test.expected, got)
+		}
+	}
+}
+
+func TestContract(t *testing.T) {
+	given := "i will go. She is not here, and we DO NOT care; they can not, I would have"
+	expected := "i'll go. She's not here, and we DON'T care; they can't, I'd have"
+
+	got, err := jargon.TokenizeString(given).Filter(contractions.Contract).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+
+	// Round trip
+	roundtrip, err := jargon.TokenizeString(expected).Filter(contractions.Expand).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if roundtrip != given {
+		t.Errorf("expected %q to expand to %q, got %q", expected, given, roundtrip)
+	}
+}
+
+func TestElision(t *testing.T) {
+	type test struct {
+		filter   jargon.Filter
+		given    string
+		expected []string
+	}
+
+	tests := []test{
+		{contractions.French, "L’homme qu'il aime, aujourd'hui", []string{"L’", "homme", " ", "qu'", "il", " ", "aime", ",", " ", "aujourd'hui"}},
+		{contractions.Italian, "dell'anno c'è un'altra", []string{"dell'", "anno", " ", "c'", "è", " ", "un'", "altra"}},
+		// Not Italian
+		{contractions.Italian, "qu'il", []string{"qu'il"}},
+	}
+
+	for _, test := range tests {
+		tokens, err := jargon.TokenizeString(test.given).Filter(test.filter).ToSlice()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, token := range tokens {
+			got = append(got, token.String())
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("given %q, expected %q, got %q", test.given, test.expected, got)
+		}
+	}
+}
+
+func TestElider(t *testing.T) {
+	type test struct {
+		given         string
+		prefix, rest  string
+		expectedSplit bool
+	}
+
+	elider := contractions.NewElider(contractions.FrenchElisions)
+	tests := []test{
+		{"l'homme", "l'", "homme", true},
+		{"Qu’il", "Qu’", "il", true},
+		{"aujourd'hui", "", "", false},
+		{"l'", "", "", false},
+		{"homme", "", "", false},
+	}
+
+	for _, test := range tests {
+		prefix, rest, ok := elider.Split(test.given)
+		if prefix != test.prefix || rest != test.rest || ok != test.expectedSplit {
+			t.Errorf("given %q, expected %q, %q, %t, got %q, %q, %t", test.given, test.prefix, test.rest, test.expectedSplit, prefix, rest, ok)
+		}
+	}
+}
@@ -17,15 +17,44 @@ func main() {
 }
 
 func getMappings() (map[string]string, error) {
-	mappings := make(map[string]string)
+	return variations(contractions)
+}
 
-	cases := []func(string) string{
-		strings.ToLower,
-		title,
-		strings.ToUpper,
+// getContracted maps expansions to contractions, for the Contract filter. Only contractions with apostrophes are
+// reversed, e.g. not cannot or gonna, and only with straight apostrophes.
+func getContracted() (map[string]string, error) {
+	reversed := make(map[string]string)
+	for contraction, expansion := range contractions {
+		if !strings.Contains(contraction, a) {
+			continue
+		}
+		if existing, exists := reversed[expansion]; exists {
+			return nil, fmt.Errorf("attempting to re-add expansion %q (previous contraction was %q)", expansion, existing)
+		}
+		reversed[expansion] = contraction
 	}
 
-	for contraction, expansion := range contractions {
+	contracted := make(map[string]string)
+	for expansion, contraction := range reversed {
+		for _, f := range cases {
+			contracted[f(expansion)] = f(contraction)
+		}
+	}
+
+	return contracted, nil
+}
+
+var cases = []func(string) string{
+	strings.ToLower,
+	title,
+	strings.ToUpper,
+}
+
+// variations adds the case and apostrophe variations of each contraction
+func variations(m map[string]string) (map[string]string, error) {
+	mappings := make(map[string]string)
+
+	for contraction, expansion := range m {
 		for _, apostrophed := range apostrophes(contraction) {
 			for _, f := range cases {
 				key := f(apostrophed)
@@ -46,10 +75,26 @@ func write() error {
 	if err != nil {
 		return err
 	}
+	had, err := variations(hadContractions)
+	if err != nil {
+		return err
+	}
+	has, err := variations(hasContractions)
+	if err != nil {
+		return err
+	}
+	contracted, err := getContracted()
+	if err != nil {
+		return err
+	}
+
+	data := struct {
+		Mappings, Had, Has, Contracted map[string]string
+	}{mappings, had, has, contracted}
 
 	var source bytes.Buffer
 
-	tmplErr := tmpl.Execute(&source, mappings)
+	tmplErr := tmpl.Execute(&source, data)
 	if tmplErr != nil {
 		return tmplErr
 	}
@@ -104,7 +149,16 @@ package contractions
 // This file is generated. Best not to modify it, as it will likely be overwritten.
 
 // maps do not guarantee order, so this will look random
-var mappings = {{ printf "%#v" . }}
+var mappings = {{ printf "%#v" .Mappings }}
+
+// had are alternative expansions of ambiguous contractions, see Options.Had
+var had = {{ printf "%#v" .Had }}
+
+// has are alternative expansions of ambiguous contractions, see Options.Has
+var has = {{ printf "%#v" .Has }}
+
+// contracted maps expansions to contractions, see Contract
+var contracted = {{ printf "%#v" .Contracted }}
 `))
 
 var contractions = map[string]string{
@@ -119,7 +173,7 @@ var contractions = map[string]string{
 
 	"i'm":     "i am",
 	"you're":  "you are",
-	"she's":   "she is", // arguably 'she has', would need parts-of-speech to determine
+	"she's":   "she is", // arguably 'she has', would need parts-of-speech to determine; see hasContractions
 	"he's":    "he is",
 	"we're":   "we are",
 	"they're": "they are",
@@ -129,7 +183,7 @@ var contractions = map[string]string{
 	"we've":   "we have",
 	"they've": "they have",
 
-	"i'd":    "i would", // arguably "i had"
+	"i'd":    "i would", // arguably "i had"; see hadContractions
 	"you'd":  "you would",
 	"she'd":  "she would",
 	"he'd":   "he would",
@@ -173,3 +227,19 @@ var contractions = map[string]string{
 	"gimme":  "give me",
 	"cannot": "can not",
 }
+
+// hadContractions are alternative expansions of 'd, which are ambiguous without parts-of-speech
+var hadContractions = map[string]string{
+	"i'd":    "i had",
+	"you'd":  "you had",
+	"she'd":  "she had",
+	"he'd":   "he had",
+	"we'd":   "we had",
+	"they'd": "they had",
+}
+
+// hasContractions are alternative expansions of 's, which are ambiguous without parts-of-speech
+var hasContractions = map[string]string{
+	"she's": "she has",
+	"he's":  "he has",
+}
@@ -39,3 +39,21 @@ func TestMappings(t *testing.T) {
 		t.Errorf("generated variations should have %d items, but got %d", expected, got)
 	}
 }
+
+func TestContracted(t *testing.T) {
+	mappings, err := getMappings()
+	if err != nil {
+		t.Error(err)
+	}
+	contracted, err := getContracted()
+	if err != nil {
+		t.Error(err)
+	}
+
+	// Contractions should expand back to the same expansion
+	for expansion, contraction := range contracted {
+		if got := mappings[contraction]; got != expansion {
+			t.Errorf("expected %q to expand to %q, got %q", contraction, expansion, got)
+		}
+	}
+}
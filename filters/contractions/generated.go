@@ -278,3 +278,192 @@ var mappings = map[string]string{
 	"you’re":    "you are",
 	"you’ve":    "you have",
 }
+
+// had are alternative expansions of ambiguous contractions, see Options.Had
+var had = map[string]string{
+	"HE'D":   "HE HAD",
+	"HE’D":   "HE HAD",
+	"He'd":   "He had",
+	"He’d":   "He had",
+	"I'D":    "I HAD",
+	"I'd":    "I had",
+	"I’D":    "I HAD",
+	"I’d":    "I had",
+	"SHE'D":  "SHE HAD",
+	"SHE’D":  "SHE HAD",
+	"She'd":  "She had",
+	"She’d":  "She had",
+	"THEY'D": "THEY HAD",
+	"THEY’D": "THEY HAD",
+	"They'd": "They had",
+	"They’d": "They had",
+	"WE'D":   "WE HAD",
+	"WE’D":   "WE HAD",
+	"We'd":   "We had",
+	"We’d":   "We had",
+	"YOU'D":  "YOU HAD",
+	"YOU’D":  "YOU HAD",
+	"You'd":  "You had",
+	"You’d":  "You had",
+	"he'd":   "he had",
+	"he’d":   "he had",
+	"i'd":    "i had",
+	"i’d":    "i had",
+	"she'd":  "she had",
+	"she’d":  "she had",
+	"they'd": "they had",
+	"they’d": "they had",
+	"we'd":   "we had",
+	"we’d":   "we had",
+	"you'd":  "you had",
+	"you’d":  "you had",
+}
+
+// has are alternative expansions of ambiguous contractions, see Options.Has
+var has = map[string]string{
+	"HE'S":  "HE HAS",
+	"HE’S":  "HE HAS",
+	"He's":  "He has",
+	"He’s":  "He has",
+	"SHE'S": "SHE HAS",
+	"SHE’S": "SHE HAS",
+	"She's": "She has",
+	"She’s": "She has",
+	"he's":  "he has",
+	"he’s":  "he has",
+	"she's": "she has",
+	"she’s": "she has",
+}
+
+// contracted maps expansions to contractions, see Contract
+var contracted = map[string]string{
+	"ARE NOT":     "AREN'T",
+	"Are not":     "Aren't",
+	"CAN NOT":     "CAN'T",
+	"COULD HAVE":  "COULD'VE",
+	"COULD NOT":   "COULDN'T",
+	"Can not":     "Can't",
+	"Could have":  "Could've",
+	"Could not":   "Couldn't",
+	"DID NOT":     "DIDN'T",
+	"DO NOT":      "DON'T",
+	"DOES NOT":    "DOESN'T",
+	"Did not":     "Didn't",
+	"Do not":      "Don't",
+	"Does not":    "Doesn't",
+	"HAD NOT":     "HADN'T",
+	"HAVE NOT":    "HAVEN'T",
+	"HE IS":       "HE'S",
+	"HE WILL":     "HE'LL",
+	"HE WOULD":    "HE'D",
+	"Had not":     "Hadn't",
+	"Have not":    "Haven't",
+	"He is":       "He's",
+	"He will":     "He'll",
+	"He would":    "He'd",
+	"I AM":        "I'M",
+	"I HAVE":      "I'VE",
+	"I WILL":      "I'LL",
+	"I WOULD":     "I'D",
+	"I am":        "I'm",
+	"I have":      "I've",
+	"I will":      "I'll",
+	"I would":     "I'd",
+	"IS NOT":      "ISN'T",
+	"Is not":      "Isn't",
+	"MIGHT HAVE":  "MIGHT'VE",
+	"MIGHT NOT":   "MIGHTN'T",
+	"MUST HAVE":   "MUST'VE",
+	"MUST NOT":    "MUSTN'T",
+	"Might have":  "Might've",
+	"Might not":   "Mightn't",
+	"Must have":   "Must've",
+	"Must not":    "Mustn't",
+	"SHE IS":      "SHE'S",
+	"SHE WILL":    "SHE'LL",
+	"SHE WOULD":   "SHE'D",
+	"SHOULD HAVE": "SHOULD'VE",
+	"SHOULD NOT":  "SHOULDN'T",
+	"She is":      "She's",
+	"She will":    "She'll",
+	"She would":   "She'd",
+	"Should have": "Should've",
+	"Should not":  "Shouldn't",
+	"THEY ARE":    "THEY'RE",
+	"THEY HAVE":   "THEY'VE",
+	"THEY WILL":   "THEY'LL",
+	"THEY WOULD":  "THEY'D",
+	"They are":    "They're",
+	"They have":   "They've",
+	"They will":   "They'll",
+	"They would":  "They'd",
+	"WAS NOT":     "WASN'T",
+	"WE ARE":      "WE'RE",
+	"WE HAVE":     "WE'VE",
+	"WE WILL":     "WE'LL",
+	"WE WOULD":    "WE'D",
+	"WILL HAVE":   "WILL'VE",
+	"WILL NOT":    "WON'T",
+	"WOULD HAVE":  "WOULD'VE",
+	"WOULD NOT":   "WOULDN'T",
+	"Was not":     "Wasn't",
+	"We are":      "We're",
+	"We have":     "We've",
+	"We will":     "We'll",
+	"We would":    "We'd",
+	"Will have":   "Will've",
+	"Will not":    "Won't",
+	"Would have":  "Would've",
+	"Would not":   "Wouldn't",
+	"YOU ARE":     "YOU'RE",
+	"YOU HAVE":    "YOU'VE",
+	"YOU WILL":    "YOU'LL",
+	"YOU WOULD":   "YOU'D",
+	"You are":     "You're",
+	"You have":    "You've",
+	"You will":    "You'll",
+	"You would":   "You'd",
+	"are not":     "aren't",
+	"can not":     "can't",
+	"could have":  "could've",
+	"could not":   "couldn't",
+	"did not":     "didn't",
+	"do not":      "don't",
+	"does not":    "doesn't",
+	"had not":     "hadn't",
+	"have not":    "haven't",
+	"he is":       "he's",
+	"he will":     "he'll",
+	"he would":    "he'd",
+	"i am":        "i'm",
+	"i have":      "i've",
+	"i will":      "i'll",
+	"i would":     "i'd",
+	"is not":      "isn't",
+	"might have":  "might've",
+	"might not":   "mightn't",
+	"must have":   "must've",
+	"must not":    "mustn't",
+	"she is":      "she's",
+	"she will":    "she'll",
+	"she would":   "she'd",
+	"should have": "should've",
+	"should not":  "shouldn't",
+	"they are":    "they're",
+	"they have":   "they've",
+	"they will":   "they'll",
+	"they would":  "they'd",
+	"was not":     "wasn't",
+	"we are":      "we're",
+	"we have":     "we've",
+	"we will":     "we'll",
+	"we would":    "we'd",
+	"will have":   "will've",
+	"will not":    "won't",
+	"would have":  "would've",
+	"would not":   "wouldn't",
+	"you are":     "you're",
+	"you have":    "you've",
+	"you will":    "you'll",
+	"you would":   "you'd",
+}
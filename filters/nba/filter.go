@@ -1,6 +1,9 @@
 package nba
 
-import "github.com/clipperhouse/jargon/filters/synonyms"
+import (
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/synonyms"
+)
 
 //go:generate go run generate/main.go
 
@@ -9,3 +12,11 @@ var ignore = []rune{' ', '.', '\'', '-', '–'}
 // CurrentPlayers is a token filter for identifying current NBA players accoring to Wikipedia
 // It is insensitive to spaces, dashes, apostrophes, periods and diacritics in players' names.
 var CurrentPlayers = synonyms.NewFilter(mappings, true, ignore)
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "nba",
+		Description: "recognize current NBA players, e.g. Lebron → LeBron James",
+		Filter:      CurrentPlayers,
+	})
+}
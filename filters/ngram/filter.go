@@ -0,0 +1,249 @@
+// Package ngram provides filters to emit word n-grams (shingles) and character n-grams, for use with jargon. Emitted
+// tokens are marked with a position (see jargon.Token.Position), so that they can feed similarity measures, such as
+// MinHash, or search indexes.
+package ngram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Shingles creates a filter which emits word n-grams of min to max words, joined by joiner. Spaces are skipped, and
+// punctuation is dropped. Shingles do not cross punctuation, unless crossPunct is true. Each shingle's position is
+// that of its first word, counting words from zero.
+//
+// For example, Shingles(2, 3, " ", false) on "Ruby on Rails" emits "Ruby on", "Ruby on Rails", "on Rails".
+//
+// A min of less than 1 is taken as 1, and a max of less than min is taken as min.
+func Shingles(min, max int, joiner string, crossPunct bool) jargon.Filter {
+	min, max = clamp(min, max)
+	f := &shingles{
+		min:        min,
+		max:        max,
+		joiner:     joiner,
+		crossPunct: crossPunct,
+	}
+	return f.filter
+}
+
+type shingles struct {
+	min, max   int
+	joiner     string
+	crossPunct bool
+}
+
+func (f *shingles) filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &shingleTokens{
+		filter:   f,
+		incoming: incoming,
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type shingleTokens struct {
+	filter *shingles
+
+	incoming *jargon.TokenStream
+	// words are the current run of words, up to max
+	words []*jargon.Token
+	// position is the position of words[0]
+	position int
+	outgoing *tokenqueue.TokenQueue
+}
+
+func (t *shingleTokens) next() (*jargon.Token, error) {
+	for !t.outgoing.Any() {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			// EOF
+			t.flush()
+			break
+		}
+
+		if token.IsSpace() {
+			continue
+		}
+		if token.IsPunct() {
+			if !t.filter.crossPunct {
+				t.flush()
+			}
+			continue
+		}
+
+		t.words = append(t.words, token)
+		if len(t.words) == t.filter.max {
+			t.emit()
+		}
+	}
+
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+	return nil, nil
+}
+
+// emit queues the shingles starting at the first word, and drops it
+func (t *shingleTokens) emit() {
+	for n := t.filter.min; n <= t.filter.max && n <= len(t.words); n++ {
+		words := t.words[:n]
+
+		values := make([]string, n)
+		origin := make([]*jargon.Token, n)
+		for i, word := range words {
+			values[i] = word.String()
+			origin[i] = word
+		}
+
+		token := jargon.NewTokenFrom(strings.Join(values, t.filter.joiner), false, origin...)
+		t.outgoing.Push(token.WithPosition(t.position))
+	}
+
+	t.words = t.words[1:]
+	t.position++
+}
+
+// flush emits the shingles remaining in a run of words
+func (t *shingleTokens) flush() {
+	for len(t.words) > 0 {
+		t.emit()
+	}
+}
+
+// Chars creates a filter which emits character n-grams of min to max runes for each word, for fuzzy matching. Spaces
+// and punctuation are dropped. Words shorter than min are emitted whole. Each n-gram's position is that of its word,
+// counting words from zero.
+//
+// For example, Chars(3, 3) on "Rails" emits "Rai", "ail", "ils".
+//
+// A min of less than 1 is taken as 1, and a max of less than min is taken as min.
+func Chars(min, max int) jargon.Filter {
+	min, max = clamp(min, max)
+	f := &chars{
+		min: min,
+		max: max,
+	}
+	return f.filter
+}
+
+type chars struct {
+	min, max int
+}
+
+func (f *chars) filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &charTokens{
+		filter:   f,
+		incoming: incoming,
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type charTokens struct {
+	filter *chars
+
+	incoming *jargon.TokenStream
+	position int
+	outgoing *tokenqueue.TokenQueue
+}
+
+func (t *charTokens) next() (*jargon.Token, error) {
+	for !t.outgoing.Any() {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, nil
+		}
+		if token.IsSpace() || token.IsPunct() {
+			continue
+		}
+
+		runes := []rune(token.String())
+		if len(runes) < t.filter.min {
+			t.outgoing.Push(token.WithPosition(t.position))
+		}
+		for start := range runes {
+			for n := t.filter.min; n <= t.filter.max && start+n <= len(runes); n++ {
+				gram := jargon.NewTokenFrom(string(runes[start:start+n]), false, token)
+				t.outgoing.Push(gram.WithPosition(t.position))
+			}
+		}
+		t.position++
+	}
+
+	return t.outgoing.Pop(), nil
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "shingles",
+		Description: "emit word n-grams (shingles), e.g. Ruby on Rails → Ruby on, on Rails",
+		Params: []jargon.Param{
+			{Name: "min", Description: "the minimum number of words", Default: "2"},
+			{Name: "max", Description: "the maximum number of words", Default: "2"},
+			{Name: "joiner", Description: "the string which joins words", Default: " "},
+			{Name: "crossPunct", Description: "whether shingles may cross punctuation", Default: "false", Options: []string{"true", "false"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			min, max, err := sizes(params)
+			if err != nil {
+				return nil, err
+			}
+			crossPunct, err := strconv.ParseBool(params["crossPunct"])
+			if err != nil {
+				return nil, err
+			}
+			return Shingles(min, max, params["joiner"], crossPunct), nil
+		},
+	})
+	jargon.Register(jargon.Registration{
+		Name:        "chargrams",
+		Description: "emit character n-grams of each word, e.g. Rails → Rai, ail, ils",
+		Params: []jargon.Param{
+			{Name: "min", Description: "the minimum number of characters", Default: "3"},
+			{Name: "max", Description: "the maximum number of characters", Default: "3"},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			min, max, err := sizes(params)
+			if err != nil {
+				return nil, err
+			}
+			return Chars(min, max), nil
+		},
+	})
+}
+
+func sizes(params map[string]string) (min, max int, err error) {
+	min, err = strconv.Atoi(params["min"])
+	if err != nil {
+		return 0, 0, fmt.Errorf("min: %v", err)
+	}
+	max, err = strconv.Atoi(params["max"])
+	if err != nil {
+		return 0, 0, fmt.Errorf("max: %v", err)
+	}
+	if min < 1 || max < min {
+		return 0, 0, fmt.Errorf("expected 1 <= min <= max, got min %d, max %d", min, max)
+	}
+	return min, max, nil
+}
+
+// clamp ensures that 1 <= min <= max, see sizes for the equivalent check of params
+func clamp(min, max int) (int, int) {
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		max = min
+	}
+	return min, max
+}
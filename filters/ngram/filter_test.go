@@ -0,0 +1,110 @@
+package ngram_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/ngram"
+)
+
+type gram struct {
+	value    string
+	position int
+}
+
+func grams(t *testing.T, tokens *jargon.TokenStream) []gram {
+	var result []gram
+	for tokens.Scan() {
+		token := tokens.Token()
+		pos, ok := token.Position()
+		if !ok {
+			t.Errorf("expected %q to have a position", token)
+		}
+		result = append(result, gram{token.String(), pos})
+	}
+	if err := tokens.Err(); err != nil {
+		t.Error(err)
+	}
+	return result
+}
+
+func TestShingles(t *testing.T) {
+	type test struct {
+		input      string
+		min, max   int
+		joiner     string
+		crossPunct bool
+		expected   []gram
+	}
+
+	tests := []test{
+		{
+			input: "Ruby on Rails", min: 2, max: 3, joiner: " ",
+			expected: []gram{{"Ruby on", 0}, {"Ruby on Rails", 0}, {"on Rails", 1}},
+		},
+		{
+			input: "a b, c d", min: 1, max: 2, joiner: "_",
+			expected: []gram{{"a", 0}, {"a_b", 0}, {"b", 1}, {"c", 2}, {"c_d", 2}, {"d", 3}},
+		},
+		{
+			input: "a b, c d", min: 2, max: 2, joiner: "_", crossPunct: true,
+			expected: []gram{{"a_b", 0}, {"b_c", 1}, {"c_d", 2}},
+		},
+		{
+			input: "a", min: 2, max: 2, joiner: " ",
+			expected: nil,
+		},
+		// Sizes are clamped to 1 <= min <= max
+		{
+			input: "a b", min: 0, max: 2, joiner: " ",
+			expected: []gram{{"a", 0}, {"a b", 0}, {"b", 1}},
+		},
+		{
+			input: "a b", min: 2, max: 0, joiner: " ",
+			expected: []gram{{"a b", 0}},
+		},
+	}
+
+	for _, test := range tests {
+		tokens := jargon.TokenizeString(test.input).Filter(ngram.Shingles(test.min, test.max, test.joiner, test.crossPunct))
+		got := grams(t, tokens)
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("%q: expected %v, got %v", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestChars(t *testing.T) {
+	tokens := jargon.TokenizeString("Rails, go").Filter(ngram.Chars(3, 4))
+	got := grams(t, tokens)
+
+	expected := []gram{{"Rai", 0}, {"Rail", 0}, {"ail", 0}, {"ails", 0}, {"ils", 0}, {"go", 1}}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	// Sizes are clamped to 1 <= min <= max
+	got = grams(t, jargon.TokenizeString("go").Filter(ngram.Chars(0, 2)))
+	expected = []gram{{"g", 0}, {"go", 0}, {"o", 0}}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	filter, err := jargon.ParseFilter("shingles:2:2:-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := jargon.TokenizeString("Ruby on Rails").Filter(filter).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].String() != "Ruby-on" {
+		t.Errorf("expected Ruby-on, on-Rails, got %v", got)
+	}
+
+	if _, err := jargon.ParseFilter("chargrams:3:2"); err == nil {
+		t.Error("expected error for max < min")
+	}
+}
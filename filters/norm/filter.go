@@ -18,6 +18,26 @@ var NFKC = newFilter(norm.NFKC)
 // NFKD normalizes tokens into Unicode Normalization Form KD
 var NFKD = newFilter(norm.NFKD)
 
+var forms = map[string]jargon.Filter{
+	"NFC":  NFC,
+	"NFD":  NFD,
+	"NFKC": NFKC,
+	"NFKD": NFKD,
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "norm",
+		Description: "normalize tokens to a Unicode normalization form",
+		Params: []jargon.Param{
+			{Name: "form", Description: "the normalization form", Default: "NFC", Options: []string{"NFC", "NFD", "NFKC", "NFKD"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			return forms[params["form"]], nil
+		},
+	})
+}
+
 func newFilter(form norm.Form) jargon.Filter {
 	f := func(token *jargon.Token) *jargon.Token {
 		if form.IsNormalString(token.String()) {
@@ -25,7 +45,7 @@ func newFilter(form norm.Form) jargon.Filter {
 		}
 
 		s := form.String(token.String())
-		return jargon.NewToken(s, true)
+		return jargon.NewTokenFrom(s, true, token)
 	}
 
 	return mapper.NewFilter(f)
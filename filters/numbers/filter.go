@@ -0,0 +1,482 @@
+// Package numbers provides a filter to normalize numbers, such as "two thousand and five", "2,005" and "MMV", to a
+// canonical numeric form, for use with jargon
+package numbers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Locale determines the separators of formatted numbers
+type Locale struct {
+	// Thousands separates groups of three digits; a space also matches no-break spaces
+	Thousands string
+	// Decimal separates the integer and fraction
+	Decimal string
+}
+
+// Locales which are commonly used
+var (
+	// English is e.g. 1,234.5
+	English = Locale{Thousands: ",", Decimal: "."}
+	// German, and much of continental Europe, is e.g. 1.234,5
+	German = Locale{Thousands: ".", Decimal: ","}
+	// French is e.g. 1 234,5
+	French = Locale{Thousands: " ", Decimal: ","}
+	// Swiss is e.g. 1'234.5
+	Swiss = Locale{Thousands: "'", Decimal: "."}
+)
+
+var locales = map[string]Locale{
+	"english": English,
+	"german":  German,
+	"french":  French,
+	"swiss":   Swiss,
+}
+
+// Options determine which numbers are recognized
+type Options struct {
+	// Locale of formatted numbers; if zero, English
+	Locale Locale
+	// Roman recognizes uppercase Roman numerals of two or more letters, e.g. MMV; single letters, such as I, are
+	// too ambiguous. Numerals of two letters, such as CV, DC, CD, CI, MD and XL, and a few which are common words or
+	// abbreviations, such as MIX, DIV and CLI, are only recognized following a word which cues a number, such as
+	// Chapter, Part, War or Bowl, e.g. World War II.
+	Roman bool
+}
+
+// Numbers is a filter for spelled-out English numbers, and formatted numbers in the English locale
+var Numbers = NewFilter(Options{})
+
+// NewFilter creates a filter which replaces numbers with a canonical lemma: digits, with no thousands separators and
+// a decimal point, e.g. "two thousand and five" → 2005, and "1.234,5" → 1234.5 in the German locale. Ordinals are
+// digits with a suffix, e.g. "twenty-first" → 21st.
+func NewFilter(options Options) jargon.Filter {
+	if options.Locale == (Locale{}) {
+		options.Locale = English
+	}
+
+	l := options.Locale
+	digits := `\d{1,3}(?:` + separator(l.Thousands) + `\d{3})+|\d+`
+	fraction := `(?:` + regexp.QuoteMeta(l.Decimal) + `\d+)?`
+
+	f := &filter{
+		options:   options,
+		formatted: regexp.MustCompile(`^(?:` + digits + `)` + fraction + `$`),
+	}
+	return f.Filter
+}
+
+func separator(s string) string {
+	if s == " " {
+		return `[ \x{00A0}\x{202F}]`
+	}
+	return regexp.QuoteMeta(s)
+}
+
+type filter struct {
+	options   Options
+	formatted *regexp.Regexp
+}
+
+// Filter normalizes numbers
+func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		filter:   f,
+		incoming: incoming,
+		buffer:   tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer *tokenqueue.TokenQueue
+	// previous is the most recent word, if not separated by punctuation, for the context of Roman numerals
+	previous string
+}
+
+// peek returns the token at index i of the lookahead buffer, filling the buffer as needed; nil indicates EOF
+func (t *tokens) peek(i int) (*jargon.Token, error) {
+	for t.buffer.Len() <= i {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, nil
+		}
+		t.buffer.Push(token)
+	}
+	return t.buffer.Tokens[i], nil
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	token, err := t.peek(0)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.IsSpace() {
+		return t.buffer.Pop(), nil
+	}
+	if token.IsPunct() {
+		t.previous = ""
+		return t.buffer.Pop(), nil
+	}
+
+	for _, try := range []func() (string, int, error){t.spelled, t.formatted, t.roman} {
+		s, consumed, err := try()
+		if err != nil {
+			return nil, err
+		}
+		if consumed == 0 {
+			continue
+		}
+		if consumed == 1 && s == token.String() {
+			// Already canonical
+			break
+		}
+
+		origin := make([]*jargon.Token, consumed)
+		copy(origin, t.buffer.Tokens[:consumed])
+		t.buffer.Drop(consumed)
+		t.previous = origin[consumed-1].String()
+		return jargon.NewTokenFrom(s, true, origin...), nil
+	}
+
+	t.previous = token.String()
+	return t.buffer.Pop(), nil
+}
+
+// formatted recognizes numbers with separators, returning the canonical number and the number of tokens consumed
+func (t *tokens) formatted() (string, int, error) {
+	head := t.buffer.Tokens[0]
+	s := head.String()
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return "", 0, nil
+	}
+
+	l := t.filter.options.Locale
+	result, consumed := "", 0
+	if t.filter.formatted.MatchString(s) {
+		result, consumed = s, 1
+	}
+
+	if l.Thousands == " " {
+		// Space-separated groups are separate tokens, e.g. "2", " ", "005"
+		for i := 1; ; i += 2 {
+			space, err := t.peek(i)
+			if err != nil {
+				return "", 0, err
+			}
+			if space == nil || !space.IsSpace() || len([]rune(space.String())) != 1 {
+				break
+			}
+			group, err := t.peek(i + 1)
+			if err != nil {
+				return "", 0, err
+			}
+			if group == nil {
+				break
+			}
+			s += " " + group.String()
+			if !t.filter.formatted.MatchString(s) {
+				break
+			}
+			result, consumed = s, i+2
+		}
+	}
+
+	if consumed == 0 {
+		return "", 0, nil
+	}
+
+	if l.Thousands == " " {
+		result = strings.Map(func(r rune) rune {
+			if r == ' ' || r == '\u00A0' || r == '\u202F' {
+				return -1
+			}
+			return r
+		}, result)
+	} else {
+		result = strings.ReplaceAll(result, l.Thousands, "")
+	}
+	return strings.ReplaceAll(result, l.Decimal, "."), consumed, nil
+}
+
+var roman = regexp.MustCompile(`^M{0,3}(?:CM|CD|D?C{0,3})(?:XC|XL|L?X{0,3})(?:IX|IV|V?I{0,3})$`)
+
+// romanWords are numerals which are more often words or abbreviations, such as DIV and CLI; see also romanCues
+var romanWords = map[string]bool{
+	"MIX": true, "DIV": true, "CIV": true, "CLI": true, "MDX": true, "MMX": true, "XXX": true, "LIV": true,
+	"DCL": true, "MCC": true, "MDC": true, "CCC": true, "DCC": true,
+}
+
+// romanCues are words which precede Roman numerals, such as World War II; ambiguous numerals require one
+var romanCues = map[string]bool{
+	"chapter": true, "part": true, "volume": true, "vol": true, "book": true, "act": true, "scene": true,
+	"section": true, "article": true, "appendix": true, "phase": true, "stage": true, "level": true, "round": true,
+	"war": true, "bowl": true, "type": true, "class": true, "mark": true, "mk": true, "episode": true, "season": true,
+}
+
+var romanValues = map[byte]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+
+// roman recognizes Roman numerals, if enabled
+func (t *tokens) roman() (string, int, error) {
+	if !t.filter.options.Roman {
+		return "", 0, nil
+	}
+
+	s := t.buffer.Tokens[0].String()
+	if len(s) < 2 || !roman.MatchString(s) {
+		return "", 0, nil
+	}
+	if (len(s) == 2 || romanWords[s]) && !romanCues[strings.ToLower(t.previous)] {
+		return "", 0, nil
+	}
+
+	value := 0
+	for i := 0; i < len(s); i++ {
+		v := romanValues[s[i]]
+		if i+1 < len(s) && v < romanValues[s[i+1]] {
+			value -= v
+		} else {
+			value += v
+		}
+	}
+	return strconv.Itoa(value), 1, nil
+}
+
+type kind int
+
+const (
+	none kind = iota
+	unit
+	teen
+	ten
+	hundred
+	scale
+)
+
+type word struct {
+	value   int64
+	kind    kind
+	ordinal bool
+}
+
+var words = map[string]word{}
+
+func init() {
+	units := []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+	unitOrdinals := []string{"zeroth", "first", "second", "third", "fourth", "fifth", "sixth", "seventh", "eighth", "ninth"}
+	for i := range units {
+		words[units[i]] = word{int64(i), unit, false}
+		words[unitOrdinals[i]] = word{int64(i), unit, true}
+	}
+
+	teens := []string{"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	teenOrdinals := []string{"tenth", "eleventh", "twelfth", "thirteenth", "fourteenth", "fifteenth", "sixteenth", "seventeenth", "eighteenth", "nineteenth"}
+	for i := range teens {
+		words[teens[i]] = word{int64(10 + i), teen, false}
+		words[teenOrdinals[i]] = word{int64(10 + i), teen, true}
+	}
+
+	tens := []string{"twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	for i, s := range tens {
+		words[s] = word{int64(20 + 10*i), ten, false}
+		words[strings.TrimSuffix(s, "y")+"ieth"] = word{int64(20 + 10*i), ten, true}
+	}
+
+	words["hundred"] = word{100, hundred, false}
+	words["hundredth"] = word{100, hundred, true}
+
+	scales := map[string]int64{"thousand": 1e3, "million": 1e6, "billion": 1e9, "trillion": 1e12}
+	for s, v := range scales {
+		words[s] = word{v, scale, false}
+		words[s+"th"] = word{v, scale, true}
+	}
+}
+
+// number accumulates the words of a spelled-out number
+type number struct {
+	total, current int64
+	// last is the kind of the last word
+	last kind
+	// scale is the last scale word, which must descend, e.g. million before thousand
+	scale int64
+	// and is true following "and", which may only precede a number under one hundred
+	and bool
+	// hyphen is true following a hyphen, which may only join tens and units, e.g. twenty-five
+	hyphen bool
+}
+
+// add adds a word, returning false if it is not a valid continuation
+func (n *number) add(w word) bool {
+	if n.hyphen && !(n.last == ten && w.kind == unit && w.value > 0) {
+		return false
+	}
+	if n.and && w.kind != unit && w.kind != teen && w.kind != ten {
+		return false
+	}
+
+	switch w.kind {
+	case unit:
+		if w.value == 0 {
+			if n.last != none {
+				return false
+			}
+			n.last = unit
+			return true
+		}
+		if n.last != none && n.last != ten && n.last != hundred && n.last != scale {
+			return false
+		}
+		n.current += w.value
+	case teen, ten:
+		if n.last != none && n.last != hundred && n.last != scale {
+			return false
+		}
+		n.current += w.value
+	case hundred:
+		if n.last != unit && n.last != teen && n.last != ten {
+			return false
+		}
+		// e.g. nineteen hundred, but not five thousand twenty hundred
+		if n.current == 0 || n.current >= 100 || (n.current >= 10 && n.scale != 0) {
+			return false
+		}
+		n.current *= 100
+	case scale:
+		if n.current == 0 || (n.scale != 0 && w.value >= n.scale) {
+			return false
+		}
+		n.total += n.current * w.value
+		n.current = 0
+		n.scale = w.value
+	}
+
+	n.last = w.kind
+	n.and = false
+	n.hyphen = false
+	return true
+}
+
+func (n *number) value() int64 {
+	return n.total + n.current
+}
+
+// spelled recognizes spelled-out English numbers, which may span several tokens, returning the canonical number
+// and the number of tokens consumed
+func (t *tokens) spelled() (string, int, error) {
+	var (
+		n        number
+		result   string
+		consumed int
+		count    int
+		first    string
+	)
+
+	for i := 0; ; i++ {
+		token, err := t.peek(i)
+		if err != nil {
+			return "", 0, err
+		}
+		if token == nil {
+			break
+		}
+
+		s := strings.ToLower(token.String())
+		if token.IsSpace() {
+			if n.hyphen {
+				break
+			}
+			continue
+		}
+		if s == "-" {
+			if n.hyphen || n.last != ten {
+				break
+			}
+			n.hyphen = true
+			continue
+		}
+		if s == "and" {
+			if n.and || (n.last != hundred && n.last != scale) {
+				break
+			}
+			n.and = true
+			continue
+		}
+
+		w, found := words[s]
+		if !found || !n.add(w) {
+			break
+		}
+
+		count++
+		if count == 1 {
+			first = s
+		}
+		result, consumed = strconv.FormatInt(n.value(), 10), i+1
+		if w.ordinal {
+			result += suffix(n.value())
+			break
+		}
+	}
+
+	// Standalone, "second" is more likely a unit of time
+	if count == 1 && first == "second" {
+		return "", 0, nil
+	}
+
+	return result, consumed, nil
+}
+
+// suffix returns the ordinal suffix of n, e.g. st for 21
+func suffix(n int64) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "numbers",
+		Description: "normalize numbers, e.g. two thousand and five|2,005 → 2005",
+		Params: []jargon.Param{
+			{Name: "locale", Description: "locale of formatted numbers", Default: "english", Options: []string{"english", "german", "french", "swiss"}},
+			{Name: "roman", Description: "whether to recognize Roman numerals, e.g. MMV", Default: "false", Options: []string{"true", "false"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			locale, found := locales[params["locale"]]
+			if !found {
+				return nil, fmt.Errorf("locale %q is not known", params["locale"])
+			}
+			roman, err := strconv.ParseBool(params["roman"])
+			if err != nil {
+				return nil, err
+			}
+			return NewFilter(Options{Locale: locale, Roman: roman}), nil
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package numbers_test
+
+import (
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/numbers"
+)
+
+func normalized(t *testing.T, s string, filter jargon.Filter) string {
+	result, err := jargon.TokenizeString(s).Filter(filter).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestSpelled(t *testing.T) {
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		{"two thousand and five", "2005"},
+		{"Two Thousand and Five years", "2005 years"},
+		{"one hundred twenty-three", "123"},
+		{"nineteen hundred and eighty-four", "1984"},
+		{"three million four hundred thousand", "3400000"},
+		{"zero", "0"},
+		{"the twenty-first century", "the 21st century"},
+		{"the one hundred and second time", "the 102nd time"},
+		{"eleventh, twelfth and thirteenth", "11th, 12th and 13th"},
+		// Not valid continuations, which are separate numbers
+		{"five six", "5 6"},
+		{"twenty thirty", "20 30"},
+		{"thousand million", "thousand million"},
+		// Trailing "and" and hyphens are not consumed
+		{"one hundred and ", "100 and "},
+		{"forty-", "40-"},
+		{"rock and roll", "rock and roll"},
+		// Standalone, a unit of time
+		{"wait a second", "wait a second"},
+	}
+
+	for _, test := range tests {
+		got := normalized(t, test.input, numbers.Numbers)
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestFormatted(t *testing.T) {
+	type test struct {
+		input    string
+		locale   numbers.Locale
+		expected string
+	}
+
+	tests := []test{
+		{"2,005 and 1,234,567.89", numbers.English, "2005 and 1234567.89"},
+		{"2.005 and 1.234.567,89", numbers.English, "2.005 and 1.234.567,89"},
+		{"2.005 and 1.234.567,89", numbers.German, "2005 and 1234567.89"},
+		{"2,005", numbers.German, "2.005"},
+		{"2 005 et 1 234 567,89", numbers.French, "2005 et 1234567.89"},
+		{"12'345.50", numbers.Swiss, "12345.50"},
+		// Not valid groups
+		{"1,2345 and 12 34", numbers.English, "1,2345 and 12 34"},
+		{"12 34", numbers.French, "12 34"},
+	}
+
+	for _, test := range tests {
+		got := normalized(t, test.input, numbers.NewFilter(numbers.Options{Locale: test.locale}))
+		if got != test.expected {
+			t.Errorf("given %q in %v, expected %q, got %q", test.input, test.locale, test.expected, got)
+		}
+	}
+}
+
+func TestRoman(t *testing.T) {
+	input := "Super Bowl XLIX in MMXV, and I am here"
+
+	got := normalized(t, input, numbers.Numbers)
+	if got != input {
+		t.Errorf("expected Roman numerals to be ignored by default, got %q", got)
+	}
+
+	roman := numbers.NewFilter(numbers.Options{Roman: true})
+	got = normalized(t, input, roman)
+	expected := "Super Bowl 49 in 2015, and I am here"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		// Common abbreviations are not numerals, without a cue
+		{"Send your CV to our DC office on a CD", "Send your CV to our DC office on a CD"},
+		{"CI runs for the MD, in XL", "CI runs for the MD, in XL"},
+		{"Use a DIV, the CLI and MMX", "Use a DIV, the CLI and MMX"},
+		// With a cue
+		{"World War II and Chapter XL", "World War 2 and Chapter 40"},
+		{"Super Bowl XL, Part CD", "Super Bowl 40, Part 400"},
+		// Punctuation breaks the cue
+		{"Chapter: CD", "Chapter: CD"},
+		// Longer numerals need no cue
+		{"Henry VIII in MCMXCIX", "Henry 8 in 1999"},
+	}
+
+	for _, test := range tests {
+		got := normalized(t, test.input, roman)
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tokens, err := jargon.TokenizeString("twenty-five and 25").Filter(numbers.Numbers).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, last := tokens[0], tokens[len(tokens)-1]
+	if !first.IsLemma() || first.String() != "25" || first.Original() != "twenty-five" {
+		t.Errorf("expected a 25 lemma from twenty-five, got %q from %q", first, first.Original())
+	}
+	if last.IsLemma() {
+		t.Errorf("expected an already-canonical number to be left alone, got a lemma %q", last)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	filter, err := jargon.ParseFilter("numbers:locale=german:roman=true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := normalized(t, "MMV 2.005", filter)
+	expected := "2005 2005"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if _, err := jargon.ParseFilter("numbers:locale=klingon"); err == nil {
+		t.Error("expected an error for an unknown locale")
+	}
+}
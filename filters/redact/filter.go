@@ -0,0 +1,439 @@
+// Package redact provides a filter to redact personal information, such as email addresses, phone numbers and credit
+// card numbers, for use with jargon
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Category is a kind of personal information
+type Category string
+
+// Categories which are recognized
+const (
+	Email Category = "email"
+	Phone Category = "phone"
+	// Card is a credit card number, validated by the Luhn checksum
+	Card Category = "card"
+	// IP is an IPv4 or IPv6 address, standing alone: not part of a word, such as std::vector, nor following a word which
+	// indicates a version, such as v or release. IPv6 addresses need at least three groups, so that a::b is not matched.
+	IP Category = "ip"
+	// IBAN is an international bank account number, validated by its checksum
+	IBAN Category = "iban"
+	// SSN is a US social security number
+	SSN Category = "ssn"
+)
+
+// Categories are all categories, in order of precedence, when matches are of equal length
+var Categories = []Category{Email, Card, IBAN, SSN, IP, Phone}
+
+// Options determine which categories are redacted, and how
+type Options struct {
+	// Categories are the categories to redact; if empty, all
+	Categories []Category
+	// Placeholders replace redacted text, by category; the default is the category in uppercase and brackets, e.g. [EMAIL]
+	Placeholders map[Category]string
+	// Hash appends a hash of the redacted text to the placeholder, e.g. [EMAIL:3f2a9c1b], so that redacted values can be
+	// correlated without being revealed
+	Hash bool
+	// Salt is prepended to text before hashing
+	Salt string
+}
+
+// Redact is a filter which redacts all categories, with default placeholders
+var Redact = NewFilter(Options{})
+
+// NewFilter creates a filter which replaces personal information with placeholders. Matches may span several tokens,
+// such as "123", "-", "45", "-", "6789". Replacements are lemmas, so that jargon.TokenStream.Lemmas shows what was redacted.
+//
+// Note that the replaced tokens are retained as the replacement's origin, for review; see jargon.Token.Origin.
+// Write tokens' Source or String, not Original, to persist redacted text.
+func NewFilter(options Options) jargon.Filter {
+	f := &filter{
+		options: options,
+	}
+
+	categories := options.Categories
+	if len(categories) == 0 {
+		categories = Categories
+	}
+	enabled := map[Category]bool{}
+	for _, c := range categories {
+		enabled[c] = true
+	}
+	// Maintain precedence
+	for _, c := range Categories {
+		if enabled[c] {
+			f.patterns = append(f.patterns, patterns[c])
+		}
+	}
+
+	return f.Filter
+}
+
+type filter struct {
+	options  Options
+	patterns []*pattern
+}
+
+type pattern struct {
+	category Category
+	// prefix finds the longest possible match at the start of text; it is leftmost-longest, rather than Go's default of
+	// leftmost-first, in which an earlier alternative may match a shorter prefix than a later one
+	prefix *regexp.Regexp
+	// full matches the entirety of a candidate
+	full *regexp.Regexp
+	// valid performs further validation, such as a checksum; nil if none
+	valid func(s string) bool
+}
+
+func newPattern(category Category, expr string, valid func(s string) bool) *pattern {
+	prefix := regexp.MustCompile(`^(?:` + expr + `)`)
+	prefix.Longest()
+
+	return &pattern{
+		category: category,
+		prefix:   prefix,
+		full:     regexp.MustCompile(`^(?:` + expr + `)$`),
+		valid:    valid,
+	}
+}
+
+var patterns = map[Category]*pattern{
+	Email: newPattern(Email, `[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`, nil),
+	Card:  newPattern(Card, `(?:\d[ \-]?){12,18}\d`, luhn),
+	IBAN:  newPattern(IBAN, `[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?`, iban),
+	SSN:   newPattern(SSN, `\d{3}-\d{2}-\d{4}`, ssn),
+	IP:    newPattern(IP, `\d{1,3}(?:\.\d{1,3}){3}|[0-9A-Fa-f]{0,4}:[0-9A-Fa-f:.]*:[0-9A-Fa-f.]*`, ip),
+	Phone: newPattern(Phone, `(?:\+?1[ .\-]?)?(?:\(\d{3}\) ?|\d{3}[ .\-])\d{3}[ .\-]\d{4}|\+\d{1,3}(?:[ .\-]?\d{1,4}){2,5}`, phone),
+}
+
+func digits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// luhn validates a card number's checksum
+func luhn(s string) bool {
+	d := digits(s)
+	if len(d) < 13 || len(d) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(d) - 1; i >= 0; i-- {
+		n := int(d[i] - '0')
+		if double {
+			n *= 2
+			if n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// iban validates an IBAN's mod-97 checksum
+func iban(s string) bool {
+	s = strings.ReplaceAll(s, " ", "")
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+
+	// Move the country code and check digits to the end, and convert letters to numbers, A = 10
+	s = s[4:] + s[:4]
+	remainder := 0
+	for _, r := range s {
+		var n int
+		switch {
+		case r >= '0' && r <= '9':
+			n = int(r - '0')
+			remainder = (remainder*10 + n) % 97
+		case r >= 'A' && r <= 'Z':
+			n = int(r-'A') + 10
+			remainder = (remainder*100 + n) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// ssn excludes numbers which are never issued
+func ssn(s string) bool {
+	parts := strings.Split(s, "-")
+	area, group, serial := parts[0], parts[1], parts[2]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+func ip(s string) bool {
+	parsed := net.ParseIP(s)
+	if parsed == nil {
+		return false
+	}
+	if !strings.Contains(s, ":") {
+		// Exclude things like version numbers, which are not dotted quads
+		return strings.Count(s, ".") == 3
+	}
+
+	// Exclude identifiers such as a::b, and a bare ::; an embedded IPv4 address counts as two groups
+	groups := 0
+	for _, group := range strings.Split(s, ":") {
+		switch {
+		case group == "":
+		case strings.Contains(group, "."):
+			groups += 2
+		default:
+			groups++
+		}
+	}
+	return groups >= 3
+}
+
+// versionCues are words which precede version numbers, which may look like IPv4 addresses, e.g. version 1.2.3.4
+var versionCues = map[string]bool{
+	"v": true, "ver": true, "version": true, "release": true, "build": true, "rev": true, "revision": true,
+	"update": true, "patch": true,
+}
+
+// joined determines whether r joins an IP address to adjacent text, making it part of something else, e.g. std::vector
+func joined(r rune) bool {
+	return r == ':' || r == '.' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// port follows an IPv4 address, e.g. 10.0.0.1:8080
+var port = regexp.MustCompile(`^:\d+`)
+
+// standsAlone determines whether a candidate IP address, followed by after, is not part of something else
+func (t *tokens) standsAlone(candidate, after string) bool {
+	if t.previous != nil {
+		s := t.previous.String()
+		if r, _ := utf8.DecodeLastRuneInString(s); joined(r) {
+			return false
+		}
+	}
+
+	// Trailing punctuation, such as the end of a sentence, does not join
+	if r, n := utf8.DecodeRuneInString(after); after != "" && joined(r) {
+		next, _ := utf8.DecodeRuneInString(after[n:])
+		switch {
+		case r != ':' && r != '.':
+			return false
+		case !strings.Contains(candidate, ":") && port.MatchString(after):
+		case n < len(after) && joined(next):
+			return false
+		}
+	}
+
+	return t.previousWord == nil || !versionCues[strings.ToLower(t.previousWord.String())]
+}
+
+func phone(s string) bool {
+	n := len(digits(s))
+	return n >= 10 && n <= 15
+}
+
+// window is the maximum number of tokens in a match
+const window = 40
+
+// Filter redacts tokens
+func (f *filter) Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		filter:   f,
+		incoming: incoming,
+		buffer:   tokenqueue.New(),
+		outgoing: tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	filter *filter
+
+	incoming *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer   *tokenqueue.TokenQueue
+	outgoing *tokenqueue.TokenQueue
+	// previous is the most recent outgoing token, and previousWord the most recent which is not space, to determine
+	// whether an IP address stands alone
+	previous, previousWord *jargon.Token
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	if t.outgoing.Any() {
+		return t.outgoing.Pop(), nil
+	}
+
+	// Fill the lookahead buffer
+	for t.buffer.Len() < window {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			break
+		}
+		t.buffer.Push(token)
+	}
+
+	if t.buffer.Len() == 0 {
+		return nil, nil
+	}
+
+	p, consumed := t.match()
+	if p == nil {
+		return t.remember(t.buffer.Pop()), nil
+	}
+
+	origin := make([]*jargon.Token, consumed)
+	copy(origin, t.buffer.Tokens[:consumed])
+	t.buffer.Drop(consumed)
+
+	return t.remember(jargon.NewTokenFrom(t.filter.replacement(p.category, join(origin)), true, origin...)), nil
+}
+
+// remember records an outgoing token, see previous
+func (t *tokens) remember(token *jargon.Token) *jargon.Token {
+	t.previous = token
+	if !token.IsSpace() {
+		t.previousWord = token
+	}
+	return token
+}
+
+// match finds the longest match at the start of the buffer, ending at a token boundary, returning the number of
+// tokens consumed
+func (t *tokens) match() (*pattern, int) {
+	head := t.buffer.Tokens[0]
+	if head.IsSpace() {
+		return nil, 0
+	}
+
+	s := join(t.buffer.Tokens)
+
+	// ends are the offsets of the ends of tokens in s
+	ends := make([]int, t.buffer.Len())
+	end := 0
+	for i, token := range t.buffer.Tokens {
+		end += len(token.String())
+		ends[i] = end
+	}
+
+	var (
+		best     *pattern
+		length   int
+		consumed int
+	)
+	for _, p := range t.filter.patterns {
+		loc := p.prefix.FindStringIndex(s)
+		if loc == nil {
+			continue
+		}
+
+		// The longest candidate which matches fully and is valid; a shorter candidate may be valid where a longer is not
+		for i := len(ends) - 1; i >= 0; i-- {
+			if ends[i] > loc[1] || ends[i] <= length {
+				continue
+			}
+			candidate := s[:ends[i]]
+			if !p.full.MatchString(candidate) {
+				continue
+			}
+			if p.valid != nil && !p.valid(candidate) {
+				continue
+			}
+			if p.category == IP && !t.standsAlone(candidate, s[ends[i]:]) {
+				continue
+			}
+			best, length, consumed = p, ends[i], i+1
+			break
+		}
+	}
+
+	return best, consumed
+}
+
+func join(tokens []*jargon.Token) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString(token.String())
+	}
+	return b.String()
+}
+
+func (f *filter) replacement(category Category, s string) string {
+	placeholder, found := f.options.Placeholders[category]
+	if !found {
+		placeholder = "[" + strings.ToUpper(string(category)) + "]"
+	}
+
+	if !f.options.Hash {
+		return placeholder
+	}
+
+	sum := sha256.Sum256([]byte(f.options.Salt + s))
+	h := hex.EncodeToString(sum[:4])
+	if strings.HasSuffix(placeholder, "]") {
+		return placeholder[:len(placeholder)-1] + ":" + h + "]"
+	}
+	return placeholder + ":" + h
+}
+
+func init() {
+	var names []string
+	for _, c := range Categories {
+		names = append(names, string(c))
+	}
+
+	jargon.Register(jargon.Registration{
+		Name:        "redact",
+		Description: "redact personal information, e.g. john@example.com → [EMAIL]",
+		Params: []jargon.Param{
+			{Name: "categories", Description: "comma-separated categories to redact, of " + strings.Join(names, ", ") + "; default is all"},
+			{Name: "hash", Description: "whether to append a hash of the redacted text to the placeholder", Default: "false", Options: []string{"true", "false"}},
+			{Name: "salt", Description: "salt for hashing"},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			options := Options{
+				Salt: params["salt"],
+			}
+
+			hash, err := strconv.ParseBool(params["hash"])
+			if err != nil {
+				return nil, err
+			}
+			options.Hash = hash
+
+			if s := params["categories"]; s != "" {
+				for _, name := range strings.Split(s, ",") {
+					c := Category(strings.TrimSpace(name))
+					if _, found := patterns[c]; !found {
+						return nil, fmt.Errorf("category %q is not known; options are %s", c, strings.Join(names, ", "))
+					}
+					options.Categories = append(options.Categories, c)
+				}
+			}
+
+			return NewFilter(options), nil
+		},
+	})
+}
@@ -0,0 +1,131 @@
+package redact_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/redact"
+)
+
+func redacted(t *testing.T, s string, filter jargon.Filter) string {
+	tokens := jargon.TokenizeString(s).Filter(filter)
+	result, err := tokens.String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestRedact(t *testing.T) {
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		{"Write to john.doe+x@example.co.uk.", "Write to [EMAIL]."},
+		{"Call (555) 123-4567 or +1 555.123.4567 today", "Call [PHONE] or [PHONE] today"},
+		{"Call +44 20 7946 0958", "Call [PHONE]"},
+		// The longest alternative, rather than the first
+		{"Call +1 555 123 4567 890", "Call [PHONE]"},
+		{"Card 4111 1111 1111 1111, or 4111-1111-1111-1111", "Card [CARD], or [CARD]"},
+		// Fails the Luhn check
+		{"Card 4111 1111 1111 1112", "Card 4111 1111 1111 1112"},
+		{"From 192.168.0.1 and 2001:db8::ff00:42:8329", "From [IP] and [IP]"},
+		{"Not an IP: 999.1.1.1, or version 1.2.3", "Not an IP: 999.1.1.1, or version 1.2.3"},
+		{"Loopback ::1 and mapped ::ffff:192.0.2.1", "Loopback ::1 and mapped [IP]"},
+		// Identifiers are not IPs
+		{"use std::vector and Foo::Bar", "use std::vector and Foo::Bar"},
+		{"a::b and ::", "a::b and ::"},
+		{"Ruby's Net::HTTP and Rust's io::Result", "Ruby's Net::HTTP and Rust's io::Result"},
+		// Nor are version numbers
+		{"Upgrade to version 1.2.3.4, or v 10.0.0.1", "Upgrade to version 1.2.3.4, or v 10.0.0.1"},
+		{"Release 1.2.3.4.5 and build 4.3.2.1", "Release 1.2.3.4.5 and build 4.3.2.1"},
+		{"Server 10.0.0.1: down, try 10.0.0.2:8080.", "Server [IP]: down, try [IP]:8080."},
+		{"Pay GB82 WEST 1234 5698 7654 32 or DE89370400440532013000", "Pay [IBAN] or [IBAN]"},
+		// Fails the checksum
+		{"Pay GB82 WEST 1234 5698 7654 33", "Pay GB82 WEST 1234 5698 7654 33"},
+		{"SSN 123-45-6789.", "SSN [SSN]."},
+		// Never issued
+		{"SSN 666-45-6789", "SSN 666-45-6789"},
+		{"Nothing to see here", "Nothing to see here"},
+	}
+
+	for _, test := range tests {
+		got := redacted(t, test.input, redact.Redact)
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	input := "Email john@example.com, SSN 123-45-6789"
+
+	// Categories
+	got := redacted(t, input, redact.NewFilter(redact.Options{Categories: []redact.Category{redact.SSN}}))
+	expected := "Email john@example.com, SSN [SSN]"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// Placeholders
+	got = redacted(t, input, redact.NewFilter(redact.Options{Placeholders: map[redact.Category]string{redact.Email: "<email>"}}))
+	expected = "Email <email>, SSN [SSN]"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	// Hashes are consistent for the same value, and differ by salt
+	hash := redact.NewFilter(redact.Options{Hash: true})
+	a := redacted(t, "john@example.com", hash)
+	b := redacted(t, "john@example.com", hash)
+	if a != b || !strings.HasPrefix(a, "[EMAIL:") {
+		t.Errorf("expected consistent hashed placeholders, got %q and %q", a, b)
+	}
+	salted := redacted(t, "john@example.com", redact.NewFilter(redact.Options{Hash: true, Salt: "pepper"}))
+	if salted == a {
+		t.Errorf("expected salt to change the hash, got %q", salted)
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tokens := jargon.TokenizeString("Email john@example.com now").Filter(redact.Redact)
+	lemmas := tokens.Lemmas()
+
+	token, err := lemmas.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == nil || token.String() != "[EMAIL]" {
+		t.Fatalf("expected [EMAIL] lemma, got %v", token)
+	}
+	if token.Original() != "john@example.com" {
+		t.Errorf("expected origin john@example.com, got %q", token.Original())
+	}
+
+	token, err = lemmas.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != nil {
+		t.Errorf("expected one lemma, got %q", token)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	filter, err := jargon.ParseFilter("redact:categories=email,ssn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := redacted(t, "john@example.com 4111 1111 1111 1111", filter)
+	expected := "[EMAIL] 4111 1111 1111 1111"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if _, err := jargon.ParseFilter("redact:categories=foo"); err == nil {
+		t.Error("expected an error for an unknown category")
+	}
+}
@@ -2,6 +2,7 @@
 package stackoverflow
 
 import (
+	"github.com/clipperhouse/jargon"
 	"github.com/clipperhouse/jargon/filters/synonyms"
 )
 
@@ -13,3 +14,11 @@ import (
 var Tags = synonyms.NewFilter(mappings, true, ignoreRunes)
 
 var ignoreRunes = []rune{' ', '-', '.', '/'}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "stack",
+		Description: "recognize tech terms as Stack Overflow tags, e.g. Ruby on Rails → ruby-on-rails",
+		Filter:      Tags,
+	})
+}
@@ -30,6 +30,28 @@ var Spanish = newStemmer(spanish.Stem)
 // Swedish is a Snowball stemmer for Swedish, implemented as a jargon.Filter
 var Swedish = newStemmer(swedish.Stem)
 
+var langs = map[string]jargon.Filter{
+	"english":   English,
+	"french":    French,
+	"norwegian": Norwegian,
+	"russian":   Russian,
+	"spanish":   Spanish,
+	"swedish":   Swedish,
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "stem",
+		Description: "stem words using snowball stemmer, e.g. management|manager → manag",
+		Params: []jargon.Param{
+			{Name: "lang", Description: "language of input", Default: "english", Options: []string{"english", "french", "norwegian", "russian", "spanish", "swedish"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			return langs[params["lang"]], nil
+		},
+	})
+}
+
 // newStemmer creates a new stemmer
 func newStemmer(stem func(string, bool) string) jargon.Filter {
 	f := func(token *jargon.Token) *jargon.Token {
@@ -45,7 +67,7 @@ func newStemmer(stem func(string, bool) string) jargon.Filter {
 			return token
 		}
 
-		return jargon.NewToken(stemmed, true)
+		return jargon.NewTokenFrom(stemmed, true, token)
 	}
 
 	return mapper.NewFilter(f)
@@ -2,6 +2,10 @@
 package stopwords
 
 import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/clipperhouse/jargon"
@@ -28,6 +32,69 @@ func NewFilter(stopwords []string, ignoreCase bool) jargon.Filter {
 	return f.Filter
 }
 
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "stopwords",
+		Description: "omit stop words",
+		Params: []jargon.Param{
+			{Name: "words", Description: "comma-separated stop words"},
+			{Name: "file", Description: "path to a file of stop words, one per line"},
+			{Name: "ignoreCase", Description: "whether to match words case-insensitively", Default: "true", Options: []string{"true", "false"}},
+		},
+		New: newFromParams,
+	})
+}
+
+func newFromParams(params map[string]string) (jargon.Filter, error) {
+	var words []string
+	for _, word := range strings.Split(params["words"], ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, word)
+		}
+	}
+
+	if path := params["file"]; path != "" {
+		lines, err := readLines(path)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, lines...)
+	}
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("expected words or file param")
+	}
+
+	ignoreCase, err := strconv.ParseBool(params["ignoreCase"])
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFilter(words, ignoreCase), nil
+}
+
+// readLines reads non-blank lines from a file
+func readLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
 type filter struct {
 	includes   map[string]bool
 	ignoreCase bool
@@ -0,0 +1,85 @@
+package synonyms
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "synonyms",
+		Description: "replace synonyms with canonical terms, from a dictionary file",
+		Params: []jargon.Param{
+			{Name: "file", Description: "path to a dictionary file, with lines of the form canonical: synonym, synonym", Required: true},
+			{Name: "ignoreCase", Description: "whether to match synonyms case-insensitively", Default: "true", Options: []string{"true", "false"}},
+			{Name: "ignore", Description: "runes to ignore when matching, e.g. ' -.'"},
+		},
+		New: newFromParams,
+	})
+}
+
+func newFromParams(params map[string]string) (jargon.Filter, error) {
+	path := params["file"]
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	mappings, err := ReadDictionary(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	ignoreCase, err := strconv.ParseBool(params["ignoreCase"])
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFilter(mappings, ignoreCase, []rune(params["ignore"])), nil
+}
+
+// ReadDictionary reads mappings, suitable for NewFilter, from a text file. Each line is a canonical term, a colon, and a
+// comma-separated list of synonyms, for example:
+//
+//	ruby-on-rails: Ruby on Rails, RoR, rails
+//
+// Blank lines, and lines beginning with #, are ignored.
+func ReadDictionary(r io.Reader) (map[string]string, error) {
+	mappings := map[string]string{}
+
+	sc := bufio.NewScanner(r)
+	line := 0
+	for sc.Scan() {
+		line++
+
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		i := strings.Index(s, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: expected canonical: synonyms, got %q", line, s)
+		}
+
+		canonical := strings.TrimSpace(s[:i])
+		synonyms := strings.TrimSpace(s[i+1:])
+		if canonical == "" || synonyms == "" {
+			return nil, fmt.Errorf("line %d: expected canonical: synonyms, got %q", line, s)
+		}
+
+		mappings[synonyms] = canonical
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return mappings, nil
+}
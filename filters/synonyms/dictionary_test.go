@@ -0,0 +1,36 @@
+package synonyms
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadDictionary(t *testing.T) {
+	dictionary := `
+# Frameworks
+ruby-on-rails: Ruby on Rails, RoR
+node.js:nodejs, node js
+
+`
+	got, err := ReadDictionary(strings.NewReader(dictionary))
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := map[string]string{
+		"Ruby on Rails, RoR": "ruby-on-rails",
+		"nodejs, node js":    "node.js",
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	invalids := []string{"no colon", ": no canonical", "no synonyms:"}
+	for _, invalid := range invalids {
+		_, err := ReadDictionary(strings.NewReader(invalid))
+		if err == nil {
+			t.Errorf("expected an error reading %q", invalid)
+		}
+	}
+}
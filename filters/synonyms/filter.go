@@ -173,7 +173,9 @@ func (t *tokens) next() (*jargon.Token, error) {
 		found, canonical, consumed := t.filter.trie.SearchCanonical(run...)
 		if found {
 			if canonical != "" {
-				token := jargon.NewToken(canonical, true)
+				origin := make([]*jargon.Token, consumed)
+				copy(origin, t.buffer.Tokens[:consumed])
+				token := jargon.NewTokenFrom(canonical, true, origin...)
 				t.outgoing.Push(token)
 			}
 			t.buffer.Drop(consumed)
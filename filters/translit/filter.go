@@ -0,0 +1,302 @@
+// Package translit provides a filter to transliterate Cyrillic and Greek to ASCII, such as "Лука Дончич" → "Luka
+// Doncic", so that names match Latin dictionaries, for use with jargon
+package translit
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/ascii"
+	"github.com/clipperhouse/jargon/filters/mapper"
+)
+
+// Standard is a transliteration standard for a script
+type Standard struct {
+	Name   string
+	script *unicode.RangeTable
+	// word transliterates a word, in lowercase, to Latin
+	word func(runes []rune) []segment
+}
+
+// segment is the transliteration of one or more runes of a word
+type segment struct {
+	// start and end are the indexes of the source runes
+	start, end int
+	latin      string
+}
+
+// Standards for Cyrillic
+var (
+	// ISO9 is ISO 9:1995, a one-to-one transliteration with diacritics, e.g. щ → ŝ
+	ISO9 = Standard{Name: "iso9", script: unicode.Cyrillic, word: table(iso9)}
+	// BGN is the BGN/PCGN romanization of Russian, e.g. щ → shch, with е → ye at the start of words and after vowels.
+	// Ukrainian, Belarusian, Serbian and Macedonian letters are by their BGN/PCGN romanizations, e.g. ї → yi, љ → lj
+	BGN = Standard{Name: "bgn", script: unicode.Cyrillic, word: bgn}
+	// Scientific is the scientific transliteration, common in linguistics, e.g. щ → šč
+	Scientific = Standard{Name: "scientific", script: unicode.Cyrillic, word: table(scientific)}
+)
+
+// Standards for Greek
+var (
+	// ELOT743 is ELOT 743, the Greek standard for transliteration of Greek, e.g. θ → th, with μπ → b at the start of
+	// words
+	ELOT743 = Standard{Name: "elot743", script: unicode.Greek, word: elot743}
+)
+
+// Translit is a filter which transliterates Cyrillic by ISO9, and Greek by ELOT743
+var Translit = NewFilter(ISO9, ELOT743)
+
+// NewFilter creates a filter which transliterates words using the given standards, at most one per script. Results
+// are folded to ASCII, for example ž → z, and hard and soft signs are dropped. Transliterated words are lemmas,
+// recording the original token as their origin.
+func NewFilter(standards ...Standard) jargon.Filter {
+	f := func(token *jargon.Token) *jargon.Token {
+		if token.IsPunct() || token.IsSpace() {
+			return token
+		}
+
+		s := token.String()
+		for _, standard := range standards {
+			s = standard.Transliterate(s)
+		}
+		if s == token.String() {
+			return token
+		}
+
+		return jargon.NewTokenFrom(s, true, token)
+	}
+
+	return mapper.NewFilter(f)
+}
+
+// signs are the transliterations of hard and soft signs, which are dropped from ASCII
+var signs = strings.NewReplacer("ʺ", "", "ʹ", "", "”", "", "’", "")
+
+// Transliterate transliterates the runes of s in the standard's script to ASCII, leaving others as is
+func (standard Standard) Transliterate(s string) string {
+	var b strings.Builder
+	var run []rune
+
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		b.WriteString(standard.transliterate(run))
+		run = run[:0]
+	}
+
+	for _, r := range s {
+		if unicode.Is(standard.script, r) {
+			run = append(run, r)
+			continue
+		}
+		flush()
+		b.WriteRune(r)
+	}
+	flush()
+
+	return b.String()
+}
+
+// transliterate transliterates a run of runes in the standard's script, preserving case
+func (standard Standard) transliterate(runes []rune) string {
+	lower := make([]rune, len(runes))
+	upper := 0
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+	// e.g. ЖУК → ZHUK, rather than ZhUK
+	allUpper := len(runes) > 1 && upper == len(runes)
+
+	var b strings.Builder
+	for _, seg := range standard.word(lower) {
+		latin := signs.Replace(seg.latin)
+		if latin == "" {
+			continue
+		}
+		if unicode.IsUpper(runes[seg.start]) {
+			if allUpper {
+				latin = strings.ToUpper(latin)
+			} else {
+				r := []rune(latin)
+				latin = string(unicode.ToUpper(r[0])) + string(r[1:])
+			}
+		}
+		b.WriteString(latin)
+	}
+
+	// Combining marks, e.g. the grave of g̀, do not fold
+	s := strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, b.String())
+	if folded, ok := ascii.FoldString(s); ok {
+		s = folded
+	}
+	return s
+}
+
+// table creates a one-to-one transliteration from a table; runes which are not in it are left as is
+func table(t map[rune]string) func(runes []rune) []segment {
+	return func(runes []rune) []segment {
+		segments := make([]segment, len(runes))
+		for i, r := range runes {
+			latin, found := t[r]
+			if !found {
+				latin = string(r)
+			}
+			segments[i] = segment{i, i + 1, latin}
+		}
+		return segments
+	}
+}
+
+var iso9 = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë", 'ж': "ž", 'з': "z", 'и': "i", 'й': "j",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "h", 'ц': "c", 'ч': "č", 'ш': "š", 'щ': "ŝ", 'ъ': "ʺ", 'ы': "y", 'ь': "ʹ", 'э': "è", 'ю': "û", 'я': "â",
+	// Ukrainian, Belarusian
+	'ґ': "g̀", 'є': "ê", 'і': "ì", 'ї': "ï", 'ў': "ǔ",
+	// Serbian, Macedonian
+	'ђ': "đ", 'ѓ': "ǵ", 'ѕ': "ẑ", 'ј': "ǰ", 'љ': "l̂", 'њ': "n̂", 'ћ': "ć", 'ќ': "ḱ", 'џ': "d̂",
+}
+
+var scientific = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë", 'ж': "ž", 'з': "z", 'и': "i", 'й': "j",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "x", 'ц': "c", 'ч': "č", 'ш': "š", 'щ': "šč", 'ъ': "ʺ", 'ы': "y", 'ь': "ʹ", 'э': "è", 'ю': "ju", 'я': "ja",
+	// Ukrainian, Belarusian
+	'ґ': "g", 'є': "je", 'і': "i", 'ї': "ji", 'ў': "ŭ",
+	// Serbian, Macedonian
+	'ђ': "đ", 'ѓ': "ǵ", 'ѕ': "dz", 'ј': "j", 'љ': "lj", 'њ': "nj", 'ћ': "ć", 'ќ': "ḱ", 'џ': "dž",
+}
+
+var bgnTable = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "ë", 'ж': "zh", 'з': "z", 'и': "i", 'й': "y",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "”", 'ы': "y", 'ь': "’", 'э': "e", 'ю': "yu", 'я': "ya",
+	// Ukrainian, Belarusian
+	'ґ': "g", 'є': "ye", 'і': "i", 'ї': "yi", 'ў': "w",
+	// Serbian, Macedonian
+	'ђ': "đ", 'ѓ': "gj", 'ѕ': "dz", 'ј': "j", 'љ': "lj", 'њ': "nj", 'ћ': "ć", 'ќ': "kj", 'џ': "dž",
+}
+
+func isCyrillicVowel(r rune) bool {
+	return strings.ContainsRune("аеёиоуыэюяєії", r)
+}
+
+// bgn is BGN/PCGN for Russian, in which е and ё are ye and yë at the start of words, and after vowels, й, ъ and ь
+func bgn(runes []rune) []segment {
+	segments := table(bgnTable)(runes)
+	for i, r := range runes {
+		if r != 'е' && r != 'ё' {
+			continue
+		}
+		if i == 0 || isCyrillicVowel(runes[i-1]) || strings.ContainsRune("йъь", runes[i-1]) {
+			segments[i].latin = "y" + segments[i].latin
+		}
+	}
+	return segments
+}
+
+var elotTable = map[rune]string{
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l",
+	'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f",
+	'χ': "ch", 'ψ': "ps", 'ω': "o",
+	'ά': "a", 'έ': "e", 'ή': "i", 'ί': "i", 'ό': "o", 'ύ': "y", 'ώ': "o", 'ϊ': "i", 'ϋ': "y", 'ΐ': "i", 'ΰ': "y",
+}
+
+// voiceless consonants, before which αυ, ευ and ηυ are af, ef and if, rather than av, ev and iv
+const voiceless = "θκξπσςτφχψ"
+
+// elot743 is ELOT 743 for Greek, with its digraph rules
+func elot743(runes []rune) []segment {
+	var segments []segment
+
+	at := func(i int) rune {
+		if i < 0 || i >= len(runes) {
+			return 0
+		}
+		return runes[i]
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r, next := runes[i], at(i+1)
+
+		switch {
+		// ου is ou
+		case r == 'ο' && (next == 'υ' || next == 'ύ'):
+			segments = append(segments, segment{i, i + 2, "ou"})
+			i++
+			continue
+		// αυ, ευ, ηυ are av, ev, iv, or af, ef, if before voiceless consonants and at the end of words
+		case strings.ContainsRune("αάεέηή", r) && (next == 'υ' || next == 'ύ'):
+			v := "v"
+			if after := at(i + 2); after == 0 || strings.ContainsRune(voiceless, after) {
+				v = "f"
+			}
+			segments = append(segments, segment{i, i + 2, elotTable[r] + v})
+			i++
+			continue
+		// γγ, γκ, γξ, γχ are ng, nk, nx, nch
+		case r == 'γ' && strings.ContainsRune("γκξχ", next):
+			segments = append(segments, segment{i, i + 1, "n"})
+			continue
+		// μπ is b at the start and end of words, otherwise mp
+		case r == 'μ' && next == 'π':
+			if i == 0 || i+2 == len(runes) {
+				segments = append(segments, segment{i, i + 2, "b"})
+				i++
+				continue
+			}
+		// ντ is d at the start of words, otherwise nt
+		case r == 'ν' && next == 'τ':
+			if i == 0 {
+				segments = append(segments, segment{i, i + 2, "d"})
+				i++
+				continue
+			}
+		}
+
+		latin, found := elotTable[r]
+		if !found {
+			latin = string(r)
+		}
+		segments = append(segments, segment{i, i + 1, latin})
+	}
+
+	return segments
+}
+
+var cyrillic = map[string]Standard{
+	ISO9.Name:       ISO9,
+	BGN.Name:        BGN,
+	Scientific.Name: Scientific,
+}
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "translit",
+		Description: "transliterate Cyrillic and Greek to ASCII, e.g. Лука Дончич → Luka Doncic",
+		Params: []jargon.Param{
+			{Name: "cyrillic", Description: "standard for Cyrillic", Default: "iso9", Options: []string{"iso9", "bgn", "scientific"}},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			standard, found := cyrillic[params["cyrillic"]]
+			if !found {
+				return nil, fmt.Errorf("cyrillic %q is not known; options are iso9, bgn, scientific", params["cyrillic"])
+			}
+			if standard.Name == ISO9.Name {
+				return Translit, nil
+			}
+			return NewFilter(standard, ELOT743), nil
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package translit_test
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/nba"
+	"github.com/clipperhouse/jargon/filters/translit"
+)
+
+func TestStandards(t *testing.T) {
+	type test struct {
+		standard translit.Standard
+		given    string
+		expected string
+	}
+
+	tests := []test{
+		{translit.ISO9, "Лука Дончич", "Luka Doncic"},
+		{translit.ISO9, "Щука, ЖУК и подъезд", "Suka, ZUK i podezd"},
+		{translit.BGN, "Щука, ЖУК и подъезд", "Shchuka, ZHUK i podyezd"},
+		{translit.BGN, "Елена Фёдорова", "Yelena Fedorova"},
+		{translit.BGN, "Хрущёв", "Khrushchev"},
+		{translit.Scientific, "Хрущёв и Юрий", "Xruscev i Jurij"},
+		{translit.ISO9, "Київ", "Kiiv"},
+		{translit.ELOT743, "Γιάννης Αντετοκούνμπο", "Giannis Antetokounmpo"},
+		{translit.ELOT743, "Μπαρτζώκας, ντομάτα, άγγελος, αυτός, Εύβοια", "Bartzokas, domata, angelos, aftos, Evvoia"},
+		{translit.ELOT743, "ΘΕΣΣΑΛΟΝΙΚΗ", "THESSALONIKI"},
+		// Other scripts are left as is
+		{translit.ELOT743, "Лука Doncic", "Лука Doncic"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.given).Filter(translit.NewFilter(test.standard)).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q by %s, expected %q, got %q", test.given, test.standard.Name, test.expected, got)
+		}
+	}
+}
+
+func TestASCII(t *testing.T) {
+	lower := "абвгдеёжзийклмнопрстуфхцчшщъыьэюя ґєіїў ђѓѕјљњћќџ αβγδεζηθικλμνξοπρσςτυφχψω άέήίόύώϊϋΐΰ"
+	given := lower + " " + strings.ToUpper(lower)
+
+	for _, standard := range []translit.Standard{translit.ISO9, translit.BGN, translit.Scientific} {
+		got := translit.ELOT743.Transliterate(standard.Transliterate(given))
+		for _, r := range got {
+			if r > unicode.MaxASCII {
+				t.Errorf("by %s, expected ASCII, got %q in %q", standard.Name, r, got)
+				break
+			}
+		}
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	given := "Лука Дончич and Γιάννης Αντετοκούνμπο"
+	expected := "Luka Dončić and Giannis Antetokounmpo"
+
+	got, err := jargon.TokenizeString(given).Filter(translit.Translit, nba.CurrentPlayers).String()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != expected {
+		t.Errorf("given %q, expected %q, got %q", given, expected, got)
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	lemmas, err := jargon.TokenizeString("Москва and Paris").Filter(translit.Translit).Lemmas().ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lemmas) != 1 || lemmas[0].String() != "Moskva" || lemmas[0].Original() != "Москва" {
+		t.Errorf("expected one lemma Moskva from Москва, got %v", lemmas)
+	}
+}
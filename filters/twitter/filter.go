@@ -5,6 +5,7 @@ import (
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/clipperhouse/jargon"
 	"github.com/clipperhouse/jargon/filters/sigil"
 )
 
@@ -14,6 +15,19 @@ var Handles = sigil.NewFilter("@", legalHandle)
 // Hashtags will identify Twitter-style hashtags, combining the # and tag into a single token
 var Hashtags = sigil.NewFilter("#", legalHashtag)
 
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "handles",
+		Description: "combine Twitter-style handles into a single token, e.g. @ + username → @username",
+		Filter:      Handles,
+	})
+	jargon.Register(jargon.Registration{
+		Name:        "hashtags",
+		Description: "combine Twitter-style hashtags into a single token, e.g. # + tag → #tag",
+		Filter:      Hashtags,
+	})
+}
+
 // https://help.twitter.com/en/managing-your-account/twitter-username-rules
 func legalHandle(s string) bool {
 	length := 0
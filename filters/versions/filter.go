@@ -0,0 +1,295 @@
+// Package versions provides a filter to recognize versioned technologies, such as "Python 3.8", "Java SE 11" and "ES6",
+// for use with jargon
+package versions
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/tokenqueue"
+)
+
+// Version is a technology and its version
+type Version struct {
+	// Technology is canonical, in the style of a Stack Overflow tag, e.g. python
+	Technology string
+	// Segments are the numbers of the version, e.g. 3, 8; a trailing wildcard, as in 3.x, is omitted
+	Segments []int
+}
+
+// String is the canonical form of the version, e.g. python-3.8
+func (v Version) String() string {
+	s := make([]string, len(v.Segments))
+	for i, n := range v.Segments {
+		s[i] = strconv.Itoa(n)
+	}
+	return v.Technology + "-" + strings.Join(s, ".")
+}
+
+// Major is the first segment of the version
+func (v Version) Major() int {
+	if len(v.Segments) == 0 {
+		return 0
+	}
+	return v.Segments[0]
+}
+
+type key struct{}
+
+// Of returns the version of a token created by Filter, or its descendants
+func Of(token *jargon.Token) (Version, bool) {
+	v, ok := token.Value(key{}).(Version)
+	return v, ok
+}
+
+type technology struct {
+	name string
+	// qualifiers may appear between the name and the version, e.g. Java SE 11
+	qualifiers []string
+	// normalize adjusts versions which have several numbering schemes, e.g. Java 1.8 is Java 8
+	normalize func(v Version) Version
+}
+
+var technologies = map[string]technology{}
+
+func add(t technology, aliases ...string) {
+	for _, alias := range aliases {
+		technologies[alias] = t
+	}
+}
+
+func init() {
+	add(technology{name: "python"}, "python", "py")
+	add(technology{name: "java", qualifiers: []string{"se", "jdk"}, normalize: java}, "java", "jdk", "j2se")
+	add(technology{name: "ecmascript", normalize: ecmascript}, "ecmascript", "es")
+	add(technology{name: "angular", normalize: angular}, "angular")
+	add(technology{name: "angularjs"}, "angularjs", "angular.js")
+	add(technology{name: "reactjs"}, "react", "reactjs", "react.js")
+	add(technology{name: "vue.js"}, "vue", "vuejs", "vue.js")
+	add(technology{name: "node.js"}, "node", "nodejs", "node.js")
+	add(technology{name: "typescript"}, "typescript")
+	add(technology{name: "php"}, "php")
+	add(technology{name: "ruby"}, "ruby")
+	add(technology{name: "ruby-on-rails"}, "rails")
+	add(technology{name: "django"}, "django")
+	add(technology{name: "laravel"}, "laravel")
+	add(technology{name: "twitter-bootstrap"}, "bootstrap")
+	add(technology{name: "c++"}, "c++", "cpp")
+	add(technology{name: "c#"}, "c#", "csharp")
+	add(technology{name: ".net"}, ".net", "dotnet")
+	add(technology{name: "swift"}, "swift")
+	add(technology{name: "kotlin"}, "kotlin")
+	add(technology{name: "scala"}, "scala")
+	add(technology{name: "perl"}, "perl")
+	add(technology{name: "html"}, "html")
+	add(technology{name: "css"}, "css")
+	add(technology{name: "ios"}, "ios")
+	add(technology{name: "android"}, "android")
+	add(technology{name: "ubuntu"}, "ubuntu")
+	add(technology{name: "postgresql"}, "postgresql", "postgres")
+	add(technology{name: "mysql"}, "mysql")
+
+	jargon.Register(jargon.Registration{
+		Name:        "versions",
+		Description: "recognize versioned technologies, e.g. Python 3.8 → python-3.8, ES6 → ecmascript-2015",
+		Filter:      Filter,
+	})
+}
+
+// java numbers versions before 9 as 1.x, e.g. 1.8 is 8
+func java(v Version) Version {
+	if len(v.Segments) > 1 && v.Segments[0] == 1 && v.Segments[1] >= 2 {
+		v.Segments = v.Segments[1:]
+	}
+	return v
+}
+
+// ecmascript numbers editions from 6 by year, e.g. ES6 is ES2015
+func ecmascript(v Version) Version {
+	if n := v.Major(); n >= 6 && n < 2015 {
+		v.Segments = []int{2009 + n}
+	}
+	return v
+}
+
+// angular 1.x is AngularJS
+func angular(v Version) Version {
+	if v.Major() == 1 {
+		v.Technology = "angularjs"
+	}
+	return v
+}
+
+var (
+	version  = regexp.MustCompile(`^v?(\d+(?:\.\d+)*)(?:\.x)?$`)
+	attached = regexp.MustCompile(`^(.+?)v?(\d+(?:\.\d+)*)(?:\.x)?$`)
+)
+
+func parse(name, s string) (Version, bool) {
+	t, found := technologies[name]
+	if !found {
+		return Version{}, false
+	}
+
+	v := Version{Technology: t.name}
+	for _, segment := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(segment)
+		if err != nil {
+			return Version{}, false
+		}
+		v.Segments = append(v.Segments, n)
+	}
+
+	if t.normalize != nil {
+		v = t.normalize(v)
+	}
+	return v, true
+}
+
+// Filter recognizes a known technology followed by a version, e.g. Python 3.8, or with the version attached, e.g.
+// py3 or ES2015, and replaces it with a lemma of the canonical technology and version, e.g. python-3.8. The Version is
+// attached to the token, see Of. Apply it before stackoverflow.Tags, which would otherwise consume the technology.
+func Filter(incoming *jargon.TokenStream) *jargon.TokenStream {
+	t := &tokens{
+		incoming: incoming,
+		buffer:   tokenqueue.New(),
+	}
+	return jargon.NewTokenStream(t.next)
+}
+
+type tokens struct {
+	incoming *jargon.TokenStream
+	// a 'lookahead' buffer for incoming tokens
+	buffer *tokenqueue.TokenQueue
+}
+
+// peek returns the token at index i of the lookahead buffer, filling the buffer as needed; nil indicates EOF
+func (t *tokens) peek(i int) (*jargon.Token, error) {
+	for t.buffer.Len() <= i {
+		token, err := t.incoming.Next()
+		if err != nil {
+			return nil, err
+		}
+		if token == nil {
+			return nil, nil
+		}
+		t.buffer.Push(token)
+	}
+	return t.buffer.Tokens[i], nil
+}
+
+// maxRun is the maximum number of adjacent tokens in a name or version, e.g. "C", "+", "+"
+const maxRun = 4
+
+// run returns the concatenations of adjacent (not space) tokens from index i, shortest first
+func (t *tokens) run(i int) ([]string, error) {
+	var result []string
+	var b strings.Builder
+	for j := i; j < i+maxRun; j++ {
+		token, err := t.peek(j)
+		if err != nil {
+			return nil, err
+		}
+		if token == nil || token.IsSpace() {
+			break
+		}
+		b.WriteString(strings.ToLower(token.String()))
+		result = append(result, b.String())
+	}
+	return result, nil
+}
+
+func (t *tokens) next() (*jargon.Token, error) {
+	token, err := t.peek(0)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+
+	if token.IsSpace() {
+		return t.buffer.Pop(), nil
+	}
+
+	v, consumed, err := t.match()
+	if err != nil {
+		return nil, err
+	}
+	if consumed == 0 {
+		return t.buffer.Pop(), nil
+	}
+
+	origin := make([]*jargon.Token, consumed)
+	copy(origin, t.buffer.Tokens[:consumed])
+	t.buffer.Drop(consumed)
+
+	return jargon.NewTokenFrom(v.String(), true, origin...).WithValue(key{}, v), nil
+}
+
+// match finds the longest match at the start of the buffer, returning the number of tokens consumed
+func (t *tokens) match() (Version, int, error) {
+	names, err := t.run(0)
+	if err != nil {
+		return Version{}, 0, err
+	}
+
+	for n := len(names); n > 0; n-- {
+		name := names[n-1]
+
+		// Attached, e.g. py3
+		if m := attached.FindStringSubmatch(name); m != nil {
+			if v, ok := parse(m[1], m[2]); ok {
+				return v, n, nil
+			}
+		}
+
+		tech, found := technologies[name]
+		if !found {
+			continue
+		}
+
+		// Followed by a version, after a space, and perhaps qualifiers, e.g. Java SE 11
+		i := n
+		for {
+			space, err := t.peek(i)
+			if err != nil {
+				return Version{}, 0, err
+			}
+			if space == nil || space.String() != " " {
+				break
+			}
+
+			versions, err := t.run(i + 1)
+			if err != nil {
+				return Version{}, 0, err
+			}
+
+			for k := len(versions); k > 0; k-- {
+				if m := version.FindStringSubmatch(versions[k-1]); m != nil {
+					if v, ok := parse(name, m[1]); ok {
+						return v, i + 1 + k, nil
+					}
+				}
+			}
+
+			if len(versions) == 0 || !qualifies(tech, versions[0]) {
+				break
+			}
+			i += 2
+		}
+	}
+
+	return Version{}, 0, nil
+}
+
+func qualifies(tech technology, s string) bool {
+	for _, q := range tech.qualifiers {
+		if s == q {
+			return true
+		}
+	}
+	return false
+}
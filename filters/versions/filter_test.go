@@ -0,0 +1,66 @@
+package versions_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/filters/versions"
+)
+
+func TestFilter(t *testing.T) {
+	type test struct {
+		input    string
+		expected string
+	}
+
+	tests := []test{
+		{"Python 3.8, py3 and python3.8.", "python-3.8, python-3 and python-3.8."},
+		{"Java SE 11, JDK 1.8 and Java 8", "java-11, java-8 and java-8"},
+		{"ES6/ES2015, ECMAScript 2017", "ecmascript-2015/ecmascript-2015, ecmascript-2017"},
+		{"Angular 9 and Angular 1.5", "angular-9 and angularjs-1.5"},
+		{"Node 12.x, C++11, C# 9 and .NET 5", "node.js-12, c++-11, c#-9 and .net-5"},
+		// Without versions
+		{"Python and Java", "Python and Java"},
+		{"Python 3 or 4", "python-3 or 4"},
+	}
+
+	for _, test := range tests {
+		got, err := jargon.TokenizeString(test.input).Filter(versions.Filter).String()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.expected {
+			t.Errorf("given %q, expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	tokens := jargon.TokenizeString("I know Java SE 11 and Ruby on Rails").Filter(versions.Filter, stackoverflow.Tags).Lemmas()
+
+	lemmas, err := tokens.ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lemmas) != 2 {
+		t.Fatalf("expected 2 lemmas, got %v", lemmas)
+	}
+
+	v, ok := versions.Of(lemmas[0])
+	expected := versions.Version{Technology: "java", Segments: []int{11}}
+	if !ok || !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %v, got %v", expected, v)
+	}
+	if v.Major() != 11 {
+		t.Errorf("expected major version 11, got %d", v.Major())
+	}
+	if lemmas[0].Original() != "Java SE 11" {
+		t.Errorf("expected original Java SE 11, got %q", lemmas[0].Original())
+	}
+
+	if _, ok := versions.Of(lemmas[1]); ok {
+		t.Errorf("expected no version for %q", lemmas[1])
+	}
+}
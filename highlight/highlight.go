@@ -0,0 +1,123 @@
+// Package highlight writes the original text of a token stream, with the spans which were changed by filters
+// highlighted, for example to review what a dictionary matched in a document. A Highlighter marks the spans which
+// match given terms, such as those of a search query, and finds the best snippets.
+package highlight
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Options determine how changed spans are marked
+type Options struct {
+	// Color highlights changed spans using ANSI escapes; see IsColorTerminal
+	Color bool
+	// Canonical shows the filtered (canonical) value inline, following the original, e.g. Ruby on Rails⟨ruby-on-rails⟩
+	Canonical bool
+}
+
+// ANSI escapes
+const (
+	highlight = "\x1b[1;33m"
+	dim       = "\x1b[2m"
+	reset     = "\x1b[0m"
+)
+
+// Write writes the original text of tokens to w, marking the spans which were changed by a filter. A span is changed if
+// its token is a lemma, or has an origin; see jargon.Token.Origin. Where one original span was replaced by several tokens,
+// for example a contraction which was expanded, the span is written once.
+func Write(w io.Writer, tokens *jargon.TokenStream, options Options) error {
+	var group []*jargon.Token
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		_, err := io.WriteString(w, span(group, options))
+		group = group[:0]
+		return err
+	}
+
+	for tokens.Scan() {
+		token := tokens.Token()
+
+		if !changed(token) {
+			if err := flush(); err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, token.Source()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(group) > 0 && root(group[0]) != root(token) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		group = append(group, token)
+	}
+	if err := tokens.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+// span formats a group of changed tokens which share an original
+func span(group []*jargon.Token, options Options) string {
+	var b strings.Builder
+
+	if options.Color {
+		b.WriteString(highlight)
+	}
+	b.WriteString(group[0].Original())
+	if options.Color {
+		b.WriteString(reset)
+	}
+
+	if options.Canonical {
+		if options.Color {
+			b.WriteString(dim)
+		}
+		b.WriteString("⟨")
+		for _, token := range group {
+			b.WriteString(token.String())
+		}
+		b.WriteString("⟩")
+		if options.Color {
+			b.WriteString(reset)
+		}
+	}
+
+	return b.String()
+}
+
+func changed(token *jargon.Token) bool {
+	return token.IsLemma() || len(token.Origin()) > 0
+}
+
+// root returns the first token of the original input from which the token derives
+func root(token *jargon.Token) *jargon.Token {
+	for len(token.Origin()) > 0 {
+		token = token.Origin()[0]
+	}
+	return token
+}
+
+// IsColorTerminal determines whether color should be written to f: it must be a terminal, and the NO_COLOR environment
+// variable must not be set (https://no-color.org)
+func IsColorTerminal(f *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,111 @@
+package highlight_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/highlight"
+)
+
+func TestWrite(t *testing.T) {
+	type test struct {
+		input    string
+		options  highlight.Options
+		expected string
+	}
+
+	tests := []test{
+		{
+			input:    "I'd like Ruby on Rails.",
+			options:  highlight.Options{},
+			expected: "I'd like Ruby on Rails.",
+		},
+		{
+			input:    "I'd like Ruby on Rails.",
+			options:  highlight.Options{Canonical: true},
+			expected: "I'd⟨I would⟩ like Ruby on Rails⟨ruby-on-rails⟩.",
+		},
+		{
+			input:    "We like Ruby on Rails and node.js",
+			options:  highlight.Options{Color: true},
+			expected: "We like \x1b[1;33mRuby on Rails\x1b[0m and \x1b[1;33mnode.js\x1b[0m",
+		},
+		{
+			input:    "Ruby on Rails",
+			options:  highlight.Options{Color: true, Canonical: true},
+			expected: "\x1b[1;33mRuby on Rails\x1b[0m\x1b[2m⟨ruby-on-rails⟩\x1b[0m",
+		},
+	}
+
+	for _, test := range tests {
+		tokens := jargon.TokenizeString(test.input).Filter(contractions.Expand, stackoverflow.Tags)
+
+		var b bytes.Buffer
+		if err := highlight.Write(&b, tokens, test.options); err != nil {
+			t.Error(err)
+		}
+
+		got := b.String()
+		if got != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, got)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	h := highlight.New([]string{"ruby-on-rails", "PostgreSQL"}, "<mark>", "</mark>")
+
+	tokens := jargon.TokenizeString("We don't use Ruby on Rails, but we do use Postgres.").Filter(contractions.Expand, stackoverflow.Tags)
+	got, err := h.Highlight(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "We don't use <mark>Ruby on Rails</mark>, but we do use <mark>Postgres</mark>."
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSnippets(t *testing.T) {
+	h := highlight.New([]string{"ruby-on-rails", "postgresql"}, "[", "]")
+
+	text := "Rails is mentioned once here. Then a lot of filler words follow, which do not match anything at all. " +
+		"Later we need RoR and Postgres and Rails again. The end."
+
+	tokens := jargon.TokenizeString(text).Filter(stackoverflow.Tags)
+	got, err := h.Snippets(tokens, 2, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []highlight.Snippet{
+		{Text: "[RoR] and [Postgres] and [Rails] again", Matches: 3, Start: 22},
+		{Text: "[Rails] is mentioned once here. Then", Matches: 1, Start: 0},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	for _, k := range []int{0, -1} {
+		got, err := h.Snippets(jargon.TokenizeString(text).Filter(stackoverflow.Tags), k, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Errorf("expected no snippets for k %d, got %v", k, got)
+		}
+	}
+	for _, size := range []int{0, -1} {
+		got, err := h.Snippets(jargon.TokenizeString(text).Filter(stackoverflow.Tags), 2, size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Errorf("expected no snippets for size %d, got %v", size, got)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package highlight
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Highlighter marks occurrences of terms, such as the canonical terms of a search query, in the original text of a
+// document. Tokens should have been filtered by the same pipeline as the terms; the original text of lemmas is
+// recovered via jargon.Token.Origin, so the term ruby-on-rails highlights the text "Ruby on Rails".
+type Highlighter struct {
+	// Terms are matched case-insensitively against token values
+	Terms []string
+	// Pre and Post wrap each match, e.g. <mark> and </mark>
+	Pre, Post string
+}
+
+// New creates a new Highlighter
+func New(terms []string, pre, post string) *Highlighter {
+	return &Highlighter{
+		Terms: terms,
+		Pre:   pre,
+		Post:  post,
+	}
+}
+
+// fragment is the original text of one or more tokens, which share an origin
+type fragment struct {
+	text  string
+	word  bool
+	match bool
+}
+
+// fragments groups tokens by origin, and determines which match
+func (h *Highlighter) fragments(tokens *jargon.TokenStream) ([]fragment, error) {
+	var result []fragment
+	var previous *jargon.Token
+
+	for tokens.Scan() {
+		token := tokens.Token()
+		match := h.matches(token)
+
+		r := root(token)
+		if len(result) > 0 && r == previous && len(token.Origin()) > 0 {
+			// Same original, e.g. an expanded contraction
+			result[len(result)-1].match = result[len(result)-1].match || match
+			continue
+		}
+		previous = r
+
+		result = append(result, fragment{
+			text:  token.Original(),
+			word:  !token.IsSpace() && !token.IsPunct(),
+			match: match,
+		})
+	}
+	if err := tokens.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (h *Highlighter) matches(token *jargon.Token) bool {
+	for _, term := range h.Terms {
+		if strings.EqualFold(token.String(), term) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Highlighter) write(b *strings.Builder, fragments []fragment) {
+	for _, s := range fragments {
+		if s.match {
+			b.WriteString(h.Pre + s.text + h.Post)
+			continue
+		}
+		b.WriteString(s.text)
+	}
+}
+
+// Highlight returns the original text of tokens, with matches wrapped in markers
+func (h *Highlighter) Highlight(tokens *jargon.TokenStream) (string, error) {
+	fragments, err := h.fragments(tokens)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	h.write(&b, fragments)
+	return b.String(), nil
+}
+
+// Snippet is a fragment of a document
+type Snippet struct {
+	// Text is the original text of the fragment, with matches wrapped in markers
+	Text string
+	// Matches is the number of matches in the fragment
+	Matches int
+	// Start is the position of the fragment's first word in the document, counting words from zero
+	Start int
+}
+
+// Snippets returns up to k fragments of tokens, of up to size words, which contain the most matches, most matches
+// first. Fragments do not overlap, and those without matches are not returned. If k or size is not positive, there
+// are none.
+func (h *Highlighter) Snippets(tokens *jargon.TokenStream, k, size int) ([]Snippet, error) {
+	if k <= 0 || size <= 0 {
+		return nil, nil
+	}
+
+	fragments, err := h.fragments(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	// Indexes of word fragments
+	var words []int
+	for i, s := range fragments {
+		if s.word {
+			words = append(words, i)
+		}
+	}
+
+	// Score each window of words; a prefix sum of matches makes it linear
+	type window struct {
+		start, matches int
+	}
+	sums := make([]int, len(words)+1)
+	for i, w := range words {
+		sums[i+1] = sums[i]
+		if fragments[w].match {
+			sums[i+1]++
+		}
+	}
+
+	var windows []window
+	for start := range words {
+		end := start + size
+		if end > len(words) {
+			end = len(words)
+		}
+		matches := sums[end] - sums[start]
+		if matches > 0 && fragments[words[start]].match {
+			// Fragments start at a match
+			windows = append(windows, window{start, matches})
+		}
+	}
+
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].matches > windows[j].matches
+	})
+
+	var result []Snippet
+	taken := make([]bool, len(words))
+	for _, w := range windows {
+		if len(result) == k {
+			break
+		}
+
+		end := w.start + size
+		if end > len(words) {
+			end = len(words)
+		}
+
+		overlaps := false
+		for i := w.start; i < end; i++ {
+			if taken[i] {
+				overlaps = true
+				break
+			}
+		}
+		if overlaps {
+			continue
+		}
+
+		for i := w.start; i < end; i++ {
+			taken[i] = true
+		}
+
+		var b strings.Builder
+		h.write(&b, fragments[words[w.start]:words[end-1]+1])
+		result = append(result, Snippet{
+			Text:    b.String(),
+			Matches: w.matches,
+			Start:   w.start,
+		})
+	}
+
+	return result, nil
+}
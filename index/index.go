@@ -0,0 +1,246 @@
+// Package index is an in-memory inverted index, which normalizes documents and queries using the same jargon
+// filters. For example, with stackoverflow.Tags, the query "Ruby on Rails" matches documents containing RoR.
+//
+// Queries support phrases, boolean operators and grouping, and results are ranked using BM25:
+//
+//	ruby on rails                 documents containing all of the terms (after filtering)
+//	"react native"                documents containing the phrase
+//	golang OR rust                documents containing either term
+//	python AND NOT django         documents containing python, but not django
+//	(golang OR rust) postgresql   grouping; adjacent terms are implicitly AND
+//
+// Terms are case-insensitive.
+package index
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Index is an inverted index of documents. Use New to create. It is not safe for concurrent use.
+type Index struct {
+	filters []jargon.Filter
+	// ids is a set of IDs, to prevent duplicates
+	ids map[string]bool
+	// total is the sum of Lengths, for the average length of documents; it is not saved, see Load
+	total int
+	data
+}
+
+// data is the serialized state of the index, see Save and Load
+type data struct {
+	// IDs are the document IDs, indexed by document number
+	IDs []string
+	// Lengths are the number of terms in each document, indexed by document number
+	Lengths []int
+	// Postings are keyed by term
+	Postings map[string][]Posting
+}
+
+// Posting records the occurrences of a term in a document
+type Posting struct {
+	// Doc is the document number
+	Doc int
+	// Positions are the positions of the term in the document, counting terms from zero. Terms which a filter assigned
+	// the same position, such as subwords from compounds, share a position.
+	Positions []int
+}
+
+// New creates a new Index, which applies filters to documents and queries
+func New(filters ...jargon.Filter) *Index {
+	return &Index{
+		filters: filters,
+		ids:     map[string]bool{},
+		data: data{
+			Postings: map[string][]Posting{},
+		},
+	}
+}
+
+// Add tokenizes and indexes a document, identified by id
+func (ix *Index) Add(id string, r io.Reader) error {
+	return ix.AddTokens(id, jargon.Tokenize(r))
+}
+
+// AddTokens indexes a document which has already been tokenized, for example by TokenizeHTML. The index's filters
+// are applied.
+func (ix *Index) AddTokens(id string, tokens *jargon.TokenStream) error {
+	if ix.ids[id] {
+		return fmt.Errorf("document %q is already in the index", id)
+	}
+
+	doc := len(ix.IDs)
+	positions := map[string][]int{}
+	var order []string
+
+	pos := 0
+	// previous is the position assigned by a filter to the previous term, if any; see jargon.Token.Position
+	previous := -1
+	filtered := tokens.Filter(ix.filters...)
+	for filtered.Scan() {
+		token := filtered.Token()
+		if token.IsSpace() {
+			continue
+		}
+		if token.IsPunct() {
+			// Punctuation breaks phrases, but is not a term
+			pos++
+			previous = -1
+			continue
+		}
+
+		// Terms with the same assigned position as the previous term, such as subwords from compounds, are
+		// alternatives at the same position
+		p, ok := token.Position()
+		stacked := ok && p == previous && pos > 0
+		if ok {
+			previous = p
+		} else {
+			previous = -1
+		}
+		if stacked {
+			pos--
+		}
+
+		term := strings.ToLower(token.String())
+		if _, found := positions[term]; !found {
+			order = append(order, term)
+		}
+		if n := len(positions[term]); n == 0 || positions[term][n-1] != pos {
+			positions[term] = append(positions[term], pos)
+		}
+		pos++
+	}
+	if err := filtered.Err(); err != nil {
+		return err
+	}
+
+	length := 0
+	for _, term := range order {
+		ix.Postings[term] = append(ix.Postings[term], Posting{
+			Doc:       doc,
+			Positions: positions[term],
+		})
+		length += len(positions[term])
+	}
+
+	ix.ids[id] = true
+	ix.IDs = append(ix.IDs, id)
+	ix.Lengths = append(ix.Lengths, length)
+	ix.total += length
+	return nil
+}
+
+// Len is the number of documents in the index
+func (ix *Index) Len() int {
+	return len(ix.IDs)
+}
+
+// Result is a document which matched a query
+type Result struct {
+	ID    string
+	Score float64
+}
+
+// Search parses and evaluates a query, returning matching documents, highest score first
+func (ix *Index) Search(query string) ([]Result, error) {
+	q, err := ix.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	return ix.Evaluate(q), nil
+}
+
+// Evaluate returns documents matching a parsed query, highest score first
+func (ix *Index) Evaluate(q Query) []Result {
+	docs := q.match(ix)
+
+	var terms []string
+	q.terms(&terms)
+
+	avg := float64(ix.total) / float64(len(ix.IDs))
+
+	var results []Result
+	for doc := range docs {
+		results = append(results, Result{
+			ID:    ix.IDs[doc],
+			Score: ix.bm25(doc, terms, avg),
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+	return results
+}
+
+// BM25 parameters
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// bm25 scores doc for terms, given the average length of documents
+func (ix *Index) bm25(doc int, terms []string, avg float64) float64 {
+	n := float64(len(ix.IDs))
+
+	score := 0.0
+	for _, term := range terms {
+		postings := ix.Postings[term]
+		p, found := find(postings, doc)
+		if !found {
+			continue
+		}
+
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		tf := float64(len(p.Positions))
+		length := float64(ix.Lengths[doc])
+		score += idf * tf * (k1 + 1) / (tf + k1*(1-b+b*length/avg))
+	}
+	return score
+}
+
+// find returns the posting for doc; postings are sorted by doc
+func find(postings []Posting, doc int) (Posting, bool) {
+	i := sort.Search(len(postings), func(i int) bool {
+		return postings[i].Doc >= doc
+	})
+	if i < len(postings) && postings[i].Doc == doc {
+		return postings[i], true
+	}
+	return Posting{}, false
+}
+
+// Save writes a snapshot of the index, which can be read using Load
+func (ix *Index) Save(w io.Writer) error {
+	return gob.NewEncoder(w).Encode(ix.data)
+}
+
+// Load reads a snapshot of an index, written by Save. Filters are not saved, so should be passed again, and should be
+// the same as those with which the index was created.
+func Load(r io.Reader, filters ...jargon.Filter) (*Index, error) {
+	ix := New(filters...)
+	if err := gob.NewDecoder(r).Decode(&ix.data); err != nil {
+		return nil, err
+	}
+	if ix.Postings == nil {
+		ix.Postings = map[string][]Posting{}
+	}
+	for _, id := range ix.IDs {
+		ix.ids[id] = true
+	}
+	for _, length := range ix.Lengths {
+		ix.total += length
+	}
+	return ix, nil
+}
@@ -0,0 +1,178 @@
+package index_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon/filters/compounds"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/index"
+)
+
+var docs = []struct {
+	id, text string
+}{
+	{"rails", "We are hiring Ruby on Rails developers. Rails experience, and some Postgres, is required."},
+	{"go", "Golang developers wanted, with PostgreSQL and React Native."},
+	{"rust", "Rust developers wanted. Native speakers of Go or Rust welcome."},
+	{"js", "React developers, some React Native. Native apps in React."},
+}
+
+func testIndex(t *testing.T) *index.Index {
+	ix := index.New(stackoverflow.Tags)
+	for _, doc := range docs {
+		if err := ix.Add(doc.id, strings.NewReader(doc.text)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ix
+}
+
+func ids(results []index.Result) []string {
+	var result []string
+	for _, r := range results {
+		result = append(result, r.ID)
+	}
+	return result
+}
+
+func TestSearch(t *testing.T) {
+	ix := testIndex(t)
+
+	type test struct {
+		query    string
+		expected []string
+	}
+
+	tests := []test{
+		// Filters apply to queries; RoR and Ruby on Rails are both ruby-on-rails
+		{"RoR", []string{"rails"}},
+		{"ruby on rails", []string{"rails"}},
+		// postgres and postgresql are both postgresql
+		{"postgres", []string{"go", "rails"}},
+		{"golang OR rust", []string{"rust", "go"}},
+		{"developers AND NOT rust", []string{"go", "js", "rails"}},
+		{"(golang OR rust) wanted", []string{"rust", "go"}},
+		{`"native apps"`, []string{"js"}},
+		{`"apps native"`, nil},
+		{"cobol", nil},
+	}
+
+	for _, test := range tests {
+		results, err := ix.Search(test.query)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		got := ids(results)
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("%q: expected %v, got %v", test.query, test.expected, got)
+		}
+	}
+}
+
+func TestSearchRanking(t *testing.T) {
+	ix := testIndex(t)
+
+	// js mentions react more, in a short document
+	results, err := ix.Search("react")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 || results[0].ID != "js" {
+		t.Errorf("expected js to rank first, got %v", results)
+	}
+	for i := 1; i < len(results); i++ {
+		if results[i].Score > results[i-1].Score {
+			t.Errorf("expected results to be sorted by score, got %v", results)
+		}
+	}
+}
+
+func TestStacked(t *testing.T) {
+	// Subwords share the position of their compound
+	ix := index.New(compounds.NewFilter(compounds.Options{Dictionary: []string{"datenbank", "administrator"}}))
+	if err := ix.Add("de", strings.NewReader("Der Datenbankadministrator kommt")); err != nil {
+		t.Fatal(err)
+	}
+
+	type test struct {
+		query    string
+		expected []string
+	}
+
+	tests := []test{
+		{"administrator", []string{"de"}},
+		{`"der administrator kommt"`, []string{"de"}},
+		{`"der datenbank kommt"`, []string{"de"}},
+		{`"datenbank administrator"`, nil},
+	}
+
+	for _, test := range tests {
+		results, err := ix.Search(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(results); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("given %q, expected %v, got %v", test.query, test.expected, got)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	ix := testIndex(t)
+
+	queries := []string{
+		"",
+		`"unterminated`,
+		"(golang OR rust",
+		"golang)",
+		"OR rust",
+		"golang AND",
+	}
+
+	for _, query := range queries {
+		if _, err := ix.Search(query); err == nil {
+			t.Errorf("%q: expected an error", query)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	ix := testIndex(t)
+
+	var b bytes.Buffer
+	if err := ix.Save(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := index.Load(&b, stackoverflow.Tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.Len() != ix.Len() {
+		t.Errorf("expected %d documents, got %d", ix.Len(), loaded.Len())
+	}
+
+	for _, query := range []string{"ruby on rails", `"react native"`, "golang OR rust"} {
+		expected, err := ix.Search(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := loaded.Search(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, got) {
+			t.Errorf("%q: expected %v, got %v", query, expected, got)
+		}
+	}
+
+	if err := loaded.Add("rails", strings.NewReader("duplicate")); err == nil {
+		t.Error("expected an error adding a duplicate ID")
+	}
+}
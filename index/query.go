@@ -0,0 +1,416 @@
+package index
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Query is a parsed query; use Index.Parse to create
+type Query interface {
+	// match returns the matching documents
+	match(ix *Index) map[int]bool
+	// terms appends the terms which contribute to scoring, i.e. those which are not negated
+	terms(result *[]string)
+	String() string
+}
+
+type term string
+
+func (q term) match(ix *Index) map[int]bool {
+	docs := map[int]bool{}
+	for _, p := range ix.Postings[string(q)] {
+		docs[p.Doc] = true
+	}
+	return docs
+}
+
+func (q term) terms(result *[]string) {
+	*result = append(*result, string(q))
+}
+
+func (q term) String() string {
+	return string(q)
+}
+
+// phrase is a sequence of terms, in consecutive positions
+type phrase []string
+
+func (q phrase) match(ix *Index) map[int]bool {
+	docs := map[int]bool{}
+	if len(q) == 0 {
+		return docs
+	}
+
+	for _, first := range ix.Postings[q[0]] {
+		for _, start := range first.Positions {
+			if q.at(ix, first.Doc, start) {
+				docs[first.Doc] = true
+				break
+			}
+		}
+	}
+	return docs
+}
+
+// at determines whether the phrase occurs in doc at position start
+func (q phrase) at(ix *Index, doc, start int) bool {
+	for i, t := range q[1:] {
+		p, found := find(ix.Postings[t], doc)
+		if !found || !contains(p.Positions, start+i+1) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(positions []int, pos int) bool {
+	for _, x := range positions {
+		if x == pos {
+			return true
+		}
+		if x > pos {
+			break
+		}
+	}
+	return false
+}
+
+func (q phrase) terms(result *[]string) {
+	*result = append(*result, q...)
+}
+
+func (q phrase) String() string {
+	return `"` + strings.Join(q, " ") + `"`
+}
+
+type and []Query
+
+func (q and) match(ix *Index) map[int]bool {
+	var docs map[int]bool
+	for _, sub := range q {
+		matches := sub.match(ix)
+		if docs == nil {
+			docs = matches
+			continue
+		}
+		for doc := range docs {
+			if !matches[doc] {
+				delete(docs, doc)
+			}
+		}
+	}
+	return docs
+}
+
+func (q and) terms(result *[]string) {
+	for _, sub := range q {
+		sub.terms(result)
+	}
+}
+
+func (q and) String() string {
+	return "(" + join([]Query(q), " AND ") + ")"
+}
+
+type or []Query
+
+func (q or) match(ix *Index) map[int]bool {
+	docs := map[int]bool{}
+	for _, sub := range q {
+		for doc := range sub.match(ix) {
+			docs[doc] = true
+		}
+	}
+	return docs
+}
+
+func (q or) terms(result *[]string) {
+	for _, sub := range q {
+		sub.terms(result)
+	}
+}
+
+func (q or) String() string {
+	return "(" + join([]Query(q), " OR ") + ")"
+}
+
+type not struct {
+	Query
+}
+
+func (q not) match(ix *Index) map[int]bool {
+	excluded := q.Query.match(ix)
+	docs := map[int]bool{}
+	for doc := range ix.IDs {
+		if !excluded[doc] {
+			docs[doc] = true
+		}
+	}
+	return docs
+}
+
+func (q not) terms(result *[]string) {
+	// Negated terms do not contribute to scoring
+}
+
+func (q not) String() string {
+	return "NOT " + q.Query.String()
+}
+
+// all matches every document; it is the result of a query text which filters to nothing, such as stop words
+type all struct{}
+
+func (q all) match(ix *Index) map[int]bool {
+	docs := map[int]bool{}
+	for doc := range ix.IDs {
+		docs[doc] = true
+	}
+	return docs
+}
+
+func (q all) terms(result *[]string) {}
+
+func (q all) String() string {
+	return "*"
+}
+
+func join(queries []Query, sep string) string {
+	s := make([]string, len(queries))
+	for i, q := range queries {
+		s[i] = q.String()
+	}
+	return strings.Join(s, sep)
+}
+
+// Parse parses a query; see the package documentation for syntax. Text and phrases are normalized by the index's
+// filters, so, for example, the text Ruby on Rails may become the single term ruby-on-rails.
+func (ix *Index) Parse(query string) (Query, error) {
+	items, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{index: ix, items: items}
+	q, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.items) {
+		return nil, fmt.Errorf("unexpected %q in query %q", p.items[p.pos].value, query)
+	}
+	return q, nil
+}
+
+type kind int
+
+const (
+	text kind = iota
+	quoted
+	operator
+	open
+	close
+)
+
+type item struct {
+	kind  kind
+	value string
+}
+
+// lex splits a query into text, quoted phrases, operators and parentheses. Consecutive words are a single text item,
+// so that they can be filtered together.
+func lex(query string) ([]item, error) {
+	var items []item
+	var words []string
+
+	flush := func() {
+		if len(words) > 0 {
+			items = append(items, item{text, strings.Join(words, " ")})
+			words = nil
+		}
+	}
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			flush()
+			items = append(items, item{open, "("})
+			i++
+		case r == ')':
+			flush()
+			items = append(items, item{close, ")"})
+			i++
+		case r == '"':
+			flush()
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated quote in query %q", query)
+			}
+			items = append(items, item{quoted, string(runes[i+1 : end])})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`()"`, runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			switch word {
+			case "AND", "OR", "NOT":
+				flush()
+				items = append(items, item{operator, word})
+			default:
+				words = append(words, word)
+			}
+			i = end
+		}
+	}
+	flush()
+
+	return items, nil
+}
+
+type parser struct {
+	index *Index
+	items []item
+	pos   int
+}
+
+func (p *parser) peek() (item, bool) {
+	if p.pos < len(p.items) {
+		return p.items[p.pos], true
+	}
+	return item{}, false
+}
+
+func (p *parser) or() (Query, error) {
+	var queries or
+	for {
+		q, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+
+		next, ok := p.peek()
+		if !ok || next.kind != operator || next.value != "OR" {
+			break
+		}
+		p.pos++
+	}
+
+	if len(queries) == 1 {
+		return queries[0], nil
+	}
+	return queries, nil
+}
+
+func (p *parser) and() (Query, error) {
+	var queries and
+	for {
+		q, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+
+		next, ok := p.peek()
+		if !ok || next.kind == close || (next.kind == operator && next.value == "OR") {
+			break
+		}
+		if next.kind == operator && next.value == "AND" {
+			p.pos++
+		}
+		// Otherwise, implicit AND
+	}
+
+	if len(queries) == 1 {
+		return queries[0], nil
+	}
+	return queries, nil
+}
+
+func (p *parser) unary() (Query, error) {
+	next, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of query")
+	}
+
+	switch next.kind {
+	case operator:
+		if next.value != "NOT" {
+			return nil, fmt.Errorf("unexpected %s in query", next.value)
+		}
+		p.pos++
+		q, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return not{q}, nil
+	case open:
+		p.pos++
+		q, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if next, ok := p.peek(); !ok || next.kind != close {
+			return nil, fmt.Errorf("expected ) in query")
+		}
+		p.pos++
+		return q, nil
+	case close:
+		return nil, fmt.Errorf("unexpected ) in query")
+	case quoted:
+		p.pos++
+		terms, err := p.index.terms(next.value)
+		if err != nil {
+			return nil, err
+		}
+		if len(terms) == 0 {
+			return all{}, nil
+		}
+		return phrase(terms), nil
+	default:
+		p.pos++
+		terms, err := p.index.terms(next.value)
+		if err != nil {
+			return nil, err
+		}
+		switch len(terms) {
+		case 0:
+			return all{}, nil
+		case 1:
+			return term(terms[0]), nil
+		}
+		var queries and
+		for _, t := range terms {
+			queries = append(queries, term(t))
+		}
+		return queries, nil
+	}
+}
+
+// terms tokenizes and filters query text, as for documents
+func (ix *Index) terms(s string) ([]string, error) {
+	var result []string
+	tokens := jargon.TokenizeString(s).Filter(ix.filters...)
+	for tokens.Scan() {
+		token := tokens.Token()
+		if token.IsSpace() || token.IsPunct() {
+			continue
+		}
+		result = append(result, strings.ToLower(token.String()))
+	}
+	if err := tokens.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,187 @@
+// Package kwic finds occurrences of a term in a token stream, with surrounding context, i.e., a keyword-in-context
+// concordance. It is typically used after filters, to find a canonical term such as reactjs wherever it appears.
+package kwic
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Match is an occurrence of the term
+type Match struct {
+	// File is the name of the input, as passed to Concordance.Add
+	File string
+	// Line is the line number, starting at 1, of the match in the input
+	Line int
+	// Left and Right are the context, with white space collapsed to single spaces
+	Left, Right string
+	// Term is the matched token
+	Term *jargon.Token
+}
+
+// Concordance collects matches of a term, over one or more inputs. Use New to create.
+type Concordance struct {
+	Term string
+	// Width is the number of tokens of context on either side of a match, not counting spaces
+	Width int
+	// Matches are in the order found
+	Matches []Match
+}
+
+// New creates a new Concordance
+func New(term string, width int) *Concordance {
+	return &Concordance{
+		Term:  term,
+		Width: width,
+	}
+}
+
+// Add finds matches of the term in tokens, labeled with file
+func (c *Concordance) Add(file string, tokens *jargon.TokenStream) error {
+	var (
+		line = 1
+		// left is the trailing context, up to Width words
+		left []*jargon.Token
+		// open are matches awaiting their right context
+		open []*pending
+	)
+
+	for tokens.Scan() {
+		token := tokens.Token()
+
+		for _, p := range open {
+			p.right = append(p.right, token)
+			if !token.IsSpace() {
+				p.words++
+			}
+		}
+
+		if token.String() == c.Term {
+			open = append(open, &pending{
+				match: Match{
+					File: file,
+					Line: line,
+					Left: join(left),
+					Term: token,
+				},
+			})
+		}
+		open = c.close(open, false)
+
+		left = append(left, token)
+		left = trim(left, c.Width)
+		line += strings.Count(token.Original(), "\n")
+	}
+	if err := tokens.Err(); err != nil {
+		return err
+	}
+
+	c.close(open, true)
+	return nil
+}
+
+type pending struct {
+	match Match
+	right []*jargon.Token
+	words int
+}
+
+// close completes matches with enough right context, or all if eof; it returns those remaining
+func (c *Concordance) close(open []*pending, eof bool) []*pending {
+	var remaining []*pending
+	for _, p := range open {
+		if p.words < c.Width && !eof {
+			remaining = append(remaining, p)
+			continue
+		}
+		p.match.Right = join(p.right)
+		c.Matches = append(c.Matches, p.match)
+	}
+	return remaining
+}
+
+// trim drops leading tokens, such that there are no more than width words, and no leading space
+func trim(tokens []*jargon.Token, width int) []*jargon.Token {
+	words := 0
+	for i := len(tokens) - 1; i >= 0; i-- {
+		if tokens[i].IsSpace() {
+			continue
+		}
+		words++
+		if words == width {
+			return tokens[i:]
+		}
+	}
+	if width == 0 {
+		return nil
+	}
+	return tokens
+}
+
+// join returns the original text of tokens, with white space collapsed
+func join(tokens []*jargon.Token) string {
+	var b strings.Builder
+	var previous *jargon.Token
+	for _, token := range tokens {
+		// Several tokens may derive from the same original, e.g. an expanded contraction
+		r := root(token)
+		if r == previous && len(token.Origin()) > 0 {
+			continue
+		}
+		previous = r
+		b.WriteString(token.Original())
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// WriteTo writes the matches, one per line, with the terms aligned in a column. Terms are written as they appeared in
+// the original text, to show which variants were matched, e.g.
+//
+//	jobs.txt:12:      experience with [React.js] and redux
+func (c *Concordance) WriteTo(w io.Writer) (int64, error) {
+	var labels []string
+	labelWidth, leftWidth := 0, 0
+	for _, m := range c.Matches {
+		label := fmt.Sprintf("%s:%d:", m.File, m.Line)
+		labels = append(labels, label)
+		if n := utf8.RuneCountInString(label); n > labelWidth {
+			labelWidth = n
+		}
+		if n := utf8.RuneCountInString(m.Left); n > leftWidth {
+			leftWidth = n
+		}
+	}
+
+	var written int64
+	for i, m := range c.Matches {
+		label := labels[i]
+		line := label + pad(label, labelWidth) + " " + pad(m.Left, leftWidth) + m.Left + " [" + m.Term.Original() + "] " + m.Right + "\n"
+		n, err := io.WriteString(w, line)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// pad returns the spaces needed to right-align s in width
+func pad(s string, width int) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat(" ", n)
+}
+
+// root returns the first token of the original input from which the token derives
+func root(token *jargon.Token) *jargon.Token {
+	for len(token.Origin()) > 0 {
+		token = token.Origin()[0]
+	}
+	return token
+}
@@ -0,0 +1,64 @@
+package kwic_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/kwic"
+)
+
+func TestConcordance(t *testing.T) {
+	c := kwic.New("reactjs", 3)
+
+	inputs := []struct {
+		file, text string
+	}{
+		{"a.txt", "We use React and Vue.\nWe don't use React.js much, really not at all"},
+		{"b.txt", "ReactJS"},
+	}
+
+	for _, input := range inputs {
+		tokens := jargon.TokenizeString(input.text).Filter(contractions.Expand, stackoverflow.Tags)
+		if err := c.Add(input.file, tokens); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type match struct {
+		file        string
+		line        int
+		left, right string
+		term        string
+	}
+
+	expected := []match{
+		{"a.txt", 1, "We use", "and Vue.", "React"},
+		{"a.txt", 2, "don't use", "much, really", "React.js"},
+		{"b.txt", 1, "", "", "ReactJS"},
+	}
+
+	var got []match
+	for _, m := range c.Matches {
+		got = append(got, match{m.File, m.Line, m.Left, m.Right, m.Term.Original()})
+	}
+
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	var b bytes.Buffer
+	if _, err := c.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	written := "a.txt:1:    We use [React] and Vue.\n" +
+		"a.txt:2: don't use [React.js] much, really\n" +
+		"b.txt:1:           [ReactJS] \n"
+	if b.String() != written {
+		t.Errorf("expected %q, got %q", written, b.String())
+	}
+}
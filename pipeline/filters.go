@@ -0,0 +1,23 @@
+package pipeline
+
+import (
+	// Register the built-in filters, see jargon.Register
+	_ "github.com/clipperhouse/jargon/filters/acronyms"
+	_ "github.com/clipperhouse/jargon/filters/ascii"
+	_ "github.com/clipperhouse/jargon/filters/clitics"
+	_ "github.com/clipperhouse/jargon/filters/compounds"
+	_ "github.com/clipperhouse/jargon/filters/confusables"
+	_ "github.com/clipperhouse/jargon/filters/contractions"
+	_ "github.com/clipperhouse/jargon/filters/nba"
+	_ "github.com/clipperhouse/jargon/filters/ngram"
+	_ "github.com/clipperhouse/jargon/filters/norm"
+	_ "github.com/clipperhouse/jargon/filters/numbers"
+	_ "github.com/clipperhouse/jargon/filters/redact"
+	_ "github.com/clipperhouse/jargon/filters/stackoverflow"
+	_ "github.com/clipperhouse/jargon/filters/stemmer"
+	_ "github.com/clipperhouse/jargon/filters/stopwords"
+	_ "github.com/clipperhouse/jargon/filters/synonyms"
+	_ "github.com/clipperhouse/jargon/filters/translit"
+	_ "github.com/clipperhouse/jargon/filters/twitter"
+	_ "github.com/clipperhouse/jargon/filters/versions"
+)
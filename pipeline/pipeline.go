@@ -0,0 +1,142 @@
+// Package pipeline describes a jargon pipeline -- a tokenizer, an ordered list of filters, and an output format -- as
+// configuration, such as a JSON file, so that it can be shared by the CLI, the web service and your code.
+//
+// Filters are looked up by name in the jargon registry, see jargon.Register. Importing this package registers the
+// built-in filters; import other filter packages to make them available.
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+)
+
+// Config describes a pipeline. It is typically read from JSON, for example:
+//
+//	{
+//		"tokenizer": "html",
+//		"filters": [
+//			{"name": "ascii"},
+//			{"name": "synonyms", "params": {"file": "mydictionary.txt"}},
+//			{"name": "stem", "params": {"lang": "french"}},
+//			{"name": "stopwords", "params": {"words": ["le", "la", "les"]}}
+//		],
+//		"output": "lines"
+//	}
+type Config struct {
+	// Tokenizer is one of "text" (the default), "html" or "markdown"
+	Tokenizer string `json:"tokenizer,omitempty"`
+	// HTML are the options for the html tokenizer
+	HTML jargon.HTMLOptions `json:"html,omitempty"`
+	// Filters are applied in order
+	Filters []FilterConfig `json:"filters,omitempty"`
+	// Output is one of "text" (the default), "lines" (one token per line) or "count"
+	Output string `json:"output,omitempty"`
+}
+
+// FilterConfig describes a registered filter by name, with optional parameters; see jargon.NewFilter
+type FilterConfig struct {
+	Name   string `json:"name"`
+	Params Params `json:"params,omitempty"`
+}
+
+// Params are named parameters for a filter. Values in JSON may be strings, numbers, booleans or arrays, which become
+// comma-separated strings.
+type Params map[string]string
+
+// UnmarshalJSON allows parameter values to be any JSON scalar, or an array of scalars
+func (p *Params) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	params := Params{}
+	for key, value := range raw {
+		switch v := value.(type) {
+		case []interface{}:
+			values := make([]string, len(v))
+			for i := range v {
+				values[i] = fmt.Sprint(v[i])
+			}
+			params[key] = strings.Join(values, ",")
+		case nil:
+			params[key] = ""
+		default:
+			params[key] = fmt.Sprint(v)
+		}
+	}
+
+	*p = params
+	return nil
+}
+
+// Load reads a Config from JSON
+func Load(r io.Reader) (*Config, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	c := &Config{}
+	if err := dec.Decode(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Pipeline is a built Config, ready to process text. Use Config.Build to create.
+type Pipeline struct {
+	tokenize func(io.Reader) *jargon.TokenStream
+	// Filters are the built filters, in order
+	Filters []jargon.Filter
+	// Output is the output format, see Config
+	Output string
+}
+
+// Build validates the config, and creates its tokenizer and filters
+func (c *Config) Build() (*Pipeline, error) {
+	p := &Pipeline{}
+
+	switch c.Tokenizer {
+	case "", "text":
+		p.tokenize = jargon.Tokenize
+	case "html":
+		options := c.HTML
+		p.tokenize = func(r io.Reader) *jargon.TokenStream {
+			return jargon.TokenizeHTMLOptions(r, options)
+		}
+	case "markdown":
+		p.tokenize = jargon.TokenizeMarkdown
+	default:
+		return nil, fmt.Errorf("unknown tokenizer %q; options are text, html, markdown", c.Tokenizer)
+	}
+
+	for _, fc := range c.Filters {
+		filter, err := jargon.NewFilter(fc.Name, fc.Params)
+		if err != nil {
+			return nil, err
+		}
+		p.Filters = append(p.Filters, filter)
+	}
+
+	switch c.Output {
+	case "", "text", "lines", "count":
+		p.Output = c.Output
+	default:
+		return nil, fmt.Errorf("unknown output %q; options are text, lines, count", c.Output)
+	}
+
+	return p, nil
+}
+
+// Tokenize tokenizes r using the pipeline's tokenizer, without filters
+func (p *Pipeline) Tokenize(r io.Reader) *jargon.TokenStream {
+	return p.tokenize(r)
+}
+
+// Run tokenizes r, and applies the pipeline's filters
+func (p *Pipeline) Run(r io.Reader) *jargon.TokenStream {
+	return p.tokenize(r).Filter(p.Filters...)
+}
@@ -0,0 +1,79 @@
+package pipeline
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	file, err := os.Open("testdata/pipeline.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	c, err := Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Tokenizer != "html" {
+		t.Errorf("expected html tokenizer, got %q", c.Tokenizer)
+	}
+	if len(c.Filters) != 4 {
+		t.Errorf("expected 4 filters, got %d", len(c.Filters))
+	}
+	if got := c.Filters[3].Params["words"]; got != "a,the" {
+		t.Errorf("expected array params to be comma-separated, got %q", got)
+	}
+	if got := c.Filters[3].Params["ignoreCase"]; got != "true" {
+		t.Errorf("expected bool params to be strings, got %q", got)
+	}
+
+	p, err := c.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Output != "lines" {
+		t.Errorf("expected lines output, got %q", p.Output)
+	}
+
+	text := `<p>The Café needs a developer for Ruby on Rails</p>`
+	got, err := p.Run(strings.NewReader(text)).String()
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := `<p> Cafe needs  boffin for ruby-on-rails</p>`
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	invalids := []string{
+		`{"tokenizer": "pdf"}`,
+		`{"output": "xml"}`,
+		`{"filters": [{"name": "foo"}]}`,
+		`{"filters": [{"name": "stem", "params": {"lang": "klingon"}}]}`,
+		`{"filters": [{"name": "stopwords"}]}`,
+		`{"filters": [{"name": "stopwords", "params": {"words": "a", "ignoreCase": "maybe"}}]}`,
+		`{"filters": [{"name": "synonyms", "params": {"file": "testdata/doesntexist.txt"}}]}`,
+	}
+
+	for _, invalid := range invalids {
+		c, err := Load(strings.NewReader(invalid))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if _, err := c.Build(); err == nil {
+			t.Errorf("expected an error building %s", invalid)
+		}
+	}
+
+	if _, err := Load(strings.NewReader(`{"filterz": []}`)); err == nil {
+		t.Errorf("expected an error loading unknown fields")
+	}
+}
@@ -0,0 +1,210 @@
+package jargon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registration describes a named filter, so that callers such as the CLI and the web service can look it up, list it and
+// create it by name. Filter packages typically call Register in an init func, so importing a package makes its filters available.
+type Registration struct {
+	// Name is a short, unique name, such as "stem"
+	Name string
+	// Description is a short, human-readable description, such as "stem words using the snowball stemmer"
+	Description string
+	// Params describes the parameters accepted by New, in order, if any
+	Params []Param
+
+	// Filter is the filter, for filters which take no parameters. Otherwise, use New.
+	Filter Filter
+	// New creates the filter from parameters, keyed by Param.Name. Defaults will have been applied, and Required and Options validated.
+	New func(params map[string]string) (Filter, error)
+}
+
+// Param describes a parameter of a registered filter
+type Param struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Default is the value when the parameter is not given
+	Default string `json:"default,omitempty"`
+	// Required indicates that the parameter must be given
+	Required bool `json:"required,omitempty"`
+	// Options are the allowed values, if the parameter is restricted to them
+	Options []string `json:"options,omitempty"`
+}
+
+var registry = struct {
+	sync.RWMutex
+	registrations map[string]Registration
+}{
+	registrations: map[string]Registration{},
+}
+
+// Register makes a filter available by name. It panics if the name is empty or already registered, or if neither
+// Filter nor New is given.
+func Register(r Registration) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if r.Name == "" {
+		panic("jargon: Register with empty name")
+	}
+	if r.Filter == nil && r.New == nil {
+		panic("jargon: Register " + r.Name + " requires a Filter or New")
+	}
+	if _, dup := registry.registrations[r.Name]; dup {
+		panic("jargon: Register called twice for " + r.Name)
+	}
+
+	registry.registrations[r.Name] = r
+}
+
+// Registered returns all registered filters, sorted by name
+func Registered() []Registration {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	var result []Registration
+	for _, r := range registry.registrations {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// LookupFilter returns the registration of the named filter, if any
+func LookupFilter(name string) (Registration, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	r, found := registry.registrations[name]
+	return r, found
+}
+
+// NewFilter creates a registered filter by name, with parameters
+func NewFilter(name string, params map[string]string) (Filter, error) {
+	r, found := LookupFilter(name)
+	if !found {
+		return nil, unknown(name)
+	}
+
+	if r.New == nil {
+		if len(params) > 0 {
+			return nil, fmt.Errorf("filter %q takes no params", name)
+		}
+		return r.Filter, nil
+	}
+
+	resolved := map[string]string{}
+	for key, value := range params {
+		param, found := r.param(key)
+		if !found {
+			return nil, fmt.Errorf("filter %q has no param %q", name, key)
+		}
+		if len(param.Options) > 0 && !contains(param.Options, value) {
+			return nil, fmt.Errorf("filter %q: param %s %q is not known; options are %s", name, key, value, strings.Join(param.Options, ", "))
+		}
+		resolved[key] = value
+	}
+	for _, param := range r.Params {
+		if _, found := resolved[param.Name]; found {
+			continue
+		}
+		if param.Required {
+			return nil, fmt.Errorf("filter %q requires param %q", name, param.Name)
+		}
+		if param.Default != "" {
+			resolved[param.Name] = param.Default
+		}
+	}
+
+	filter, err := r.New(resolved)
+	if err != nil {
+		return nil, fmt.Errorf("filter %q: %v", name, err)
+	}
+	return filter, nil
+}
+
+// ParseFilter creates a registered filter from a spec, which is a name, optionally followed by colon-separated parameters.
+// Parameters may be named (key=value), or positional, in the order of Registration.Params. Examples:
+//
+//	stem
+//	stem:french
+//	stem:lang=french
+//	stopwords:words=a,an,the:ignoreCase=false
+func ParseFilter(spec string) (Filter, error) {
+	name, params, err := ParseSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	return NewFilter(name, params)
+}
+
+// ParseSpec parses a spec, as ParseFilter, into the name of a registered filter and its named parameters, without
+// creating the filter. Callers may use it to vet specs, for example, to exclude parameters which read files.
+func ParseSpec(spec string) (name string, params map[string]string, err error) {
+	parts := strings.Split(spec, ":")
+	name = parts[0]
+
+	r, found := LookupFilter(name)
+	if !found {
+		return "", nil, unknown(name)
+	}
+
+	params = map[string]string{}
+	for i, part := range parts[1:] {
+		if eq := strings.Index(part, "="); eq >= 0 {
+			params[part[:eq]] = part[eq+1:]
+			continue
+		}
+		if i >= len(r.Params) {
+			return "", nil, fmt.Errorf("too many params in %q", spec)
+		}
+		params[r.Params[i].Name] = part
+	}
+
+	return name, params, nil
+}
+
+func unknown(name string) error {
+	var names []string
+	for _, r := range Registered() {
+		names = append(names, r.Name)
+	}
+	return fmt.Errorf("unknown filter %q; options are %s", name, strings.Join(names, ", "))
+}
+
+func (r Registration) param(name string) (Param, bool) {
+	for _, param := range r.Params {
+		if param.Name == name {
+			return param, true
+		}
+	}
+	return Param{}, false
+}
+
+func contains(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	Register(Registration{
+		Name:        "lemmas",
+		Description: "only return tokens that have been changed by a filter (lemmatized)",
+		Filter:      (*TokenStream).Lemmas,
+	})
+	Register(Registration{
+		Name:        "distinct",
+		Description: "only return unique tokens",
+		Filter:      (*TokenStream).Distinct,
+	})
+}
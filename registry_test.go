@@ -0,0 +1,131 @@
+package jargon_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+)
+
+func init() {
+	jargon.Register(jargon.Registration{
+		Name:        "test-repeat",
+		Description: "repeat each token",
+		Params: []jargon.Param{
+			{Name: "times", Default: "2", Options: []string{"1", "2", "3"}},
+			{Name: "sep"},
+		},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			// Record the params, for inspection by the test
+			result := fmt.Sprintf("%s|%s", params["times"], params["sep"])
+			return func(incoming *jargon.TokenStream) *jargon.TokenStream {
+				done := false
+				return jargon.NewTokenStream(func() (*jargon.Token, error) {
+					if done {
+						return nil, nil
+					}
+					done = true
+					return jargon.NewToken(result, true), nil
+				})
+			}, nil
+		},
+	})
+	jargon.Register(jargon.Registration{
+		Name:   "test-required",
+		Params: []jargon.Param{{Name: "file", Required: true}},
+		New: func(params map[string]string) (jargon.Filter, error) {
+			return nil, fmt.Errorf("could not open %s", params["file"])
+		},
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	r, found := jargon.LookupFilter("stack")
+	if !found {
+		t.Fatal("expected to find the stack filter")
+	}
+	if reflect.ValueOf(r.Filter).Pointer() != reflect.ValueOf(stackoverflow.Tags).Pointer() {
+		t.Error("expected stack filter to be stackoverflow.Tags")
+	}
+
+	if _, found := jargon.LookupFilter("foo"); found {
+		t.Error("expected not to find foo")
+	}
+
+	registered := jargon.Registered()
+	for i := 1; i < len(registered); i++ {
+		if registered[i-1].Name >= registered[i].Name {
+			t.Errorf("expected registrations to be sorted by name, got %q before %q", registered[i-1].Name, registered[i].Name)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Register to panic on a duplicate name")
+		}
+	}()
+	jargon.Register(jargon.Registration{Name: "stack", Filter: stackoverflow.Tags})
+}
+
+func TestParseFilter(t *testing.T) {
+	type test struct {
+		spec     string
+		expected string
+		err      string
+	}
+
+	tests := []test{
+		{"test-repeat", "2|", ""},
+		{"test-repeat:3", "3|", ""},
+		{"test-repeat:3:-", "3|-", ""},
+		{"test-repeat:sep=-", "2|-", ""},
+		{"test-repeat:sep=-:times=1", "1|-", ""},
+		{"test-repeat:4", "", "not known"},
+		{"test-repeat:1:-:x", "", "too many params"},
+		{"test-repeat:foo=bar", "", "has no param"},
+		{"test-required", "", "requires param"},
+		{"test-required:x.txt", "", "could not open x.txt"},
+		{"stack:foo", "", "too many params"},
+		{"stack:foo=bar", "", "takes no params"},
+		{"foo", "", "unknown filter"},
+	}
+
+	for _, test := range tests {
+		filter, err := jargon.ParseFilter(test.spec)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected error containing %q, got %v", test.spec, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.spec, err)
+			continue
+		}
+
+		got, err := jargon.TokenizeString("x").Filter(filter).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.spec, test.expected, got)
+		}
+	}
+}
+
+func TestParseSpec(t *testing.T) {
+	name, params, err := jargon.ParseSpec("test-repeat:3:sep=-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "test-repeat" || params["times"] != "3" || params["sep"] != "-" || len(params) != 2 {
+		t.Errorf("expected test-repeat with times 3 and sep -, got %q %v", name, params)
+	}
+
+	if _, _, err := jargon.ParseSpec("foo:bar"); err == nil || !strings.Contains(err.Error(), "unknown filter") {
+		t.Errorf("expected an unknown filter error, got %v", err)
+	}
+}
@@ -1,13 +1,23 @@
 package jargon
 
 import (
+	"strings"
 	"unicode"
 )
 
 // Token represents a piece of text with metadata.
 type Token struct {
-	value               string
-	punct, space, lemma bool
+	value                     string
+	source                    string
+	punct, space, lemma, code bool
+	element                   *Element
+	origin                    []*Token
+	// sourced indicates that source is set, though it may be empty, see tokenizeEscaped
+	sourced bool
+	// position is the position + 1, so that the zero value indicates no position
+	position int
+	// values are arbitrary data attached by filters, see WithValue
+	values map[interface{}]interface{}
 }
 
 // String is the string value of the token
@@ -15,6 +25,16 @@ func (t *Token) String() string {
 	return t.value
 }
 
+// Source is the token as it appeared in the original text. For most tokens, it is the same as String. It differs when a tokenizer
+// has normalized the token's value, for example, TokenizeHTML decodes entities, so a token whose String is "R&D" may have a
+// Source of "R&amp;D". Filters match on String; writing Source preserves round-tripping.
+func (t *Token) Source() string {
+	if t.source != "" || t.sourced {
+		return t.source
+	}
+	return t.value
+}
+
 // IsPunct indicates that the token should be considered 'breaking' of a run of words. Mostly uses
 // Unicode's definition of punctuation, with some exceptions for our purposes.
 func (t *Token) IsPunct() bool {
@@ -33,6 +53,96 @@ func (t *Token) IsLemma() bool {
 	return t.lemma
 }
 
+// IsCode indicates that the token is source code, such as a fenced or inline code block in Markdown. Code tokens are kept
+// whole and are also IsPunct, so filters which operate on runs of words will pass them through untouched.
+func (t *Token) IsCode() bool {
+	return t.code
+}
+
+// Element returns the innermost HTML element enclosing the token, for tokens from TokenizeHTML; otherwise nil. For tags,
+// it is the element of the tag itself.
+func (t *Token) Element() *Element {
+	if t.element == nil && len(t.origin) > 0 {
+		return t.origin[0].Element()
+	}
+	return t.element
+}
+
+// Origin returns the token(s) which a filter replaced to create this token, if recorded; see NewTokenFrom. For example,
+// the lemma ruby-on-rails might have an origin of Ruby, space, on, space, Rails. Origins may themselves have origins, when
+// several filters are applied.
+func (t *Token) Origin() []*Token {
+	return t.origin
+}
+
+// Original returns the text in the original input which the token replaced: the Source of its origin tokens, recursively.
+// For tokens without an origin, it is the same as Source.
+func (t *Token) Original() string {
+	if len(t.origin) == 0 {
+		return t.Source()
+	}
+
+	var b strings.Builder
+	for _, o := range t.origin {
+		b.WriteString(o.Original())
+	}
+	return b.String()
+}
+
+// Position returns the token's position in a stream, and whether one was assigned; see WithPosition. Filters which
+// emit derived tokens, such as n-grams, assign positions so that the tokens can be indexed or compared.
+func (t *Token) Position() (int, bool) {
+	return t.position - 1, t.position > 0
+}
+
+// WithPosition returns a copy of the token, with the given position
+func (t *Token) WithPosition(pos int) *Token {
+	x := *t
+	x.position = pos + 1
+	return &x
+}
+
+// WithValue returns a copy of the token, with value associated with key, as with context.WithValue. Filters use values to
+// attach structured data to the tokens they create; keys should be of an unexported type, to avoid collisions between
+// packages, with an exported accessor function.
+func (t *Token) WithValue(key, value interface{}) *Token {
+	x := *t
+	x.values = make(map[interface{}]interface{}, len(t.values)+1)
+	for k, v := range t.values {
+		x.values[k] = v
+	}
+	x.values[key] = value
+	return &x
+}
+
+// Value returns the value associated with key, see WithValue; nil if none. If the token has no such value, its origin
+// is searched, so that values survive subsequent filters.
+func (t *Token) Value(key interface{}) interface{} {
+	if v, found := t.values[key]; found {
+		return v
+	}
+	for _, o := range t.origin {
+		if v := o.Value(key); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// NewTokenFrom creates a new token, as NewToken, recording the token(s) it replaces as its origin; see Token.Origin.
+// Filters should prefer it to NewToken when replacing tokens.
+func NewTokenFrom(s string, isLemma bool, origin ...*Token) *Token {
+	token := NewToken(s, isLemma)
+	if token == nil {
+		return nil
+	}
+
+	// Copy, since NewToken may return a shared token
+	t := *token
+	t.origin = origin
+	return &t
+}
+
 // NewToken creates a new token, and calculates whether the token is space or punct.
 func NewToken(s string, isLemma bool) *Token {
 	token, found := common[s][isLemma]
@@ -2,87 +2,532 @@ package jargon
 
 import (
 	"io"
+	"strings"
 
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
 
 // TokenizeHTML tokenizes HTML. Text nodes are tokenized using jargon.Tokenize; everything else (tags, comments) are left verbatim.
+// Entities in text are decoded, so that "R&amp;D" is matched by filters as "R&D"; the original is retained, see Token.Source.
 // It returns a Tokens, intended to be iterated over by calling Next(), until nil.
 // It returns all tokens (including white space), so text can be reconstructed with fidelity. Ignoring (say) whitespace is a decision for the caller.
 func TokenizeHTML(r io.Reader) *TokenStream {
+	return TokenizeHTMLOptions(r, HTMLOptions{})
+}
+
+// HTMLOptions configures TokenizeHTMLOptions. The zero value behaves as TokenizeHTML.
+type HTMLOptions struct {
+	// Hidden treats non-visible content as opaque, in the same way as script and style: noscript, template, the contents of head,
+	// and elements with the hidden attribute, aria-hidden="true" or an inline display:none style.
+	Hidden bool
+	// Attributes tokenizes human-facing attribute values as text: alt, title, aria-label, placeholder, and the content of meta descriptions.
+	Attributes bool
+	// TextOnly drops markup (tags, comments and opaque content), and inserts line breaks at block-level elements, so that words
+	// are not run together across them. The result will not round-trip. Entities remain escaped in the Source of each token,
+	// as they were in the original, so the text is safe to write as HTML; Token.String is the decoded value.
+	TextOnly bool
+}
+
+// TokenizeHTMLOptions tokenizes HTML, as TokenizeHTML, with options for the treatment of non-visible content, attributes and markup.
+func TokenizeHTMLOptions(r io.Reader, options HTMLOptions) *TokenStream {
 	t := &htokenizer{
 		htokenizer: html.NewTokenizer(r),
+		options:    options,
 	}
 	return NewTokenStream(t.next)
 }
 
 type htokenizer struct {
 	htokenizer *html.Tokenizer
-	ttokens    *TokenStream
-	parent     atom.Atom
+	options    HTMLOptions
+
+	// queue of tokens from the current HTML token
+	queue []*Token
+
+	// stack of open elements
+	stack []element
+	// element is the Element of the token(s) being queued
+	element *Element
+	// opaque is the depth in the stack of an element whose contents are opaque, e.g. script; 0 if none
+	opaque int
+	// broken indicates that the most recent token was a line break, see TextOnly
+	broken bool
 }
 
 // next is the implementation of the Tokens interface. To iterate, call until it returns nil
 func (t *htokenizer) next() (*Token, error) {
-	// Are we "inside" a text node?
-	if t.ttokens != nil {
-		ttoken, err := t.ttokens.Next()
-		if err != nil {
+	for len(t.queue) == 0 {
+		htype := t.htokenizer.Next()
+
+		if htype == html.ErrorToken {
+			err := t.htokenizer.Err()
+			if err == io.EOF {
+				// No problem
+				return nil, nil
+			}
 			return nil, err
 		}
-		if ttoken != nil {
-			return ttoken, nil
-		}
 
-		// Done with text node
-		t.ttokens = nil
+		if err := t.handle(htype); err != nil {
+			return nil, err
+		}
 	}
 
-	htype := t.htokenizer.Next()
+	token := t.queue[0]
+	t.queue = t.queue[1:]
+	return token, nil
+}
+
+// handle queues up the token(s) resulting from the current HTML token
+func (t *htokenizer) handle(htype html.TokenType) error {
+	// Raw must precede Token, which may unescape in place
+	raw := string(t.htokenizer.Raw())
+	htoken := t.htokenizer.Token()
 
-	if htype == html.ErrorToken {
-		err := t.htokenizer.Err()
-		if err == io.EOF {
-			// No problem
-			return nil, nil
+	switch htype {
+	case html.StartTagToken, html.SelfClosingTagToken:
+		e := element{
+			atom: htoken.DataAtom,
+			Element: &Element{
+				Tag:    htoken.Data,
+				Parent: t.current(),
+			},
+		}
+		for _, attr := range htoken.Attr {
+			switch attr.Key {
+			case "id":
+				e.ID = attr.Val
+			case "class":
+				e.Class = strings.Fields(attr.Val)
+			}
 		}
-		return nil, err
-	}
 
-	htoken := t.htokenizer.Token()
+		if htype == html.StartTagToken && !voids[htoken.DataAtom] {
+			t.push(e)
+			if t.opaque == 0 && t.isOpaque(htoken) {
+				t.opaque = len(t.stack)
+			}
+		}
+		t.element = e.Element
+
+		// Attributes of hidden elements are not human-facing, with the exception of meta descriptions (which live in head)
+		attributed := t.isAttributed(htoken) && (t.opaque == 0 || isDescription(htoken))
+
+		if t.options.TextOnly {
+			if !attributed {
+				t.breakIf(htoken.DataAtom)
+				return nil
+			}
+			t.breakLine()
+			err := t.attributes(raw, htoken, false)
+			t.breakLine()
+			return err
+		}
+
+		if attributed {
+			return t.attributes(raw, htoken, true)
+		}
+	case html.EndTagToken:
+		t.element = t.pop(htoken.Data)
+		if t.opaque > len(t.stack) {
+			t.opaque = 0
+		}
 
-	switch htoken.Type {
-	case html.StartTagToken:
-		// Record that we are entering script or style blocks; don't tokenize text
-		if htoken.DataAtom == atom.Script || htoken.DataAtom == atom.Style {
-			t.parent = htoken.DataAtom
+		if t.options.TextOnly {
+			t.breakIf(htoken.DataAtom)
+			return nil
 		}
 	case html.TextToken:
-		switch t.parent {
-		case atom.Script, atom.Style:
-			// Don't tokenize script and style blocks, just return as one big string
+		t.element = t.current()
+
+		if t.opaque > 0 {
+			if t.options.TextOnly {
+				return nil
+			}
+			// Don't tokenize opaque content, e.g. script and style blocks, just return as one big string
 			token := &Token{
-				value: htoken.String(),
-				punct: false,
-				space: false,
+				value:   raw,
+				punct:   false,
+				space:   false,
+				element: t.element,
 			}
-			return token, nil
-		default:
-			t.ttokens = TokenizeString(htoken.String())
-			return t.ttokens.Next()
+			t.queue = append(t.queue, token)
+			return nil
 		}
-	case html.EndTagToken:
-		if htoken.DataAtom == t.parent {
-			htoken.DataAtom = 0
+
+		return t.text(raw)
+	default:
+		t.element = t.current()
+
+		if t.options.TextOnly {
+			// Comments, doctype
+			return nil
 		}
 	}
 
 	// Everything else is punct for our purposes
+	t.markup(raw)
+	return nil
+}
+
+// attributes queues up a tag, with human-facing attribute values tokenized as text. If markup is false, the rest of the tag is
+// omitted, and the values are separated by line breaks, so that they don't run together.
+func (t *htokenizer) attributes(raw string, htoken html.Token, markup bool) error {
+	start := 0
+	for _, span := range attributeSpans(raw) {
+		if !isHumanFacing(htoken, span.name) {
+			continue
+		}
+
+		if markup {
+			t.markup(raw[start:span.start])
+		} else {
+			t.breakLine()
+		}
+		if err := t.text(raw[span.start:span.end]); err != nil {
+			return err
+		}
+		start = span.end
+	}
+
+	if markup {
+		t.markup(raw[start:])
+	}
+	return nil
+}
+
+// text queues up tokens from raw (escaped) text
+func (t *htokenizer) text(raw string) error {
+	if raw == "" {
+		return nil
+	}
+	tokens, err := tokenizeEscaped(raw)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if t.element != nil {
+			// Copy, NewToken may return a shared instance
+			elemented := *token
+			elemented.element = t.element
+			token = &elemented
+		}
+		t.queue = append(t.queue, token)
+	}
+	t.broken = false
+	return nil
+}
+
+func (t *htokenizer) markup(s string) {
+	if s == "" {
+		return
+	}
 	token := &Token{
-		value: htoken.String(),
-		punct: true,
-		space: false,
+		value:   s,
+		punct:   true,
+		space:   false,
+		element: t.element,
 	}
-	return token, nil
+	t.queue = append(t.queue, token)
+}
+
+// breakIf inserts a line break if a is a block-level element
+func (t *htokenizer) breakIf(a atom.Atom) {
+	if blocks[a] {
+		t.breakLine()
+	}
+}
+
+// breakLine inserts a line break, unless one was just inserted
+func (t *htokenizer) breakLine() {
+	if t.broken {
+		return
+	}
+	t.queue = append(t.queue, NewToken("\n", false))
+	t.broken = true
+}
+
+type element struct {
+	atom atom.Atom
+	*Element
+}
+
+// current returns the innermost open element, or nil
+func (t *htokenizer) current() *Element {
+	if len(t.stack) == 0 {
+		return nil
+	}
+	return t.stack[len(t.stack)-1].Element
+}
+
+func (t *htokenizer) push(e element) {
+	// Some elements are implicitly closed by their siblings, e.g. <li>one<li>two
+	if n := len(t.stack); n > 0 {
+		top := t.stack[n-1]
+		if (top.Tag == e.Tag && siblings[e.atom]) || (top.atom == atom.P && blocks[e.atom]) {
+			t.stack = t.stack[:n-1]
+			e.Parent = t.current()
+			// A hidden element is closed, along with its opacity
+			if t.opaque > len(t.stack) {
+				t.opaque = 0
+			}
+		}
+	}
+	t.stack = append(t.stack, e)
+}
+
+// pop closes the most recent element with the given tag, and any elements opened inside it, returning the closed element
+func (t *htokenizer) pop(tag string) *Element {
+	for i := len(t.stack) - 1; i >= 0; i-- {
+		if t.stack[i].Tag == tag {
+			e := t.stack[i].Element
+			t.stack = t.stack[:i]
+			return e
+		}
+	}
+	return t.current()
+}
+
+func (t *htokenizer) isOpaque(htoken html.Token) bool {
+	switch htoken.DataAtom {
+	case atom.Script, atom.Style:
+		return true
+	case atom.Noscript, atom.Template, atom.Head:
+		return t.options.Hidden
+	}
+
+	if !t.options.Hidden {
+		return false
+	}
+
+	for _, attr := range htoken.Attr {
+		switch attr.Key {
+		case "hidden":
+			return true
+		case "aria-hidden":
+			if strings.EqualFold(attr.Val, "true") {
+				return true
+			}
+		case "style":
+			style := strings.ToLower(strings.Join(strings.Fields(attr.Val), ""))
+			if strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isAttributed determines whether a tag has attributes which should be tokenized as text
+func (t *htokenizer) isAttributed(htoken html.Token) bool {
+	if !t.options.Attributes {
+		return false
+	}
+	for _, attr := range htoken.Attr {
+		if isHumanFacing(htoken, attr.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+func isHumanFacing(htoken html.Token, key string) bool {
+	switch key {
+	case "alt", "title", "aria-label", "placeholder":
+		return true
+	case "content":
+		return isDescription(htoken)
+	}
+	return false
+}
+
+// isDescription determines whether the tag is a meta description, such as <meta name="description" content="...">
+func isDescription(htoken html.Token) bool {
+	if htoken.DataAtom != atom.Meta {
+		return false
+	}
+	for _, attr := range htoken.Attr {
+		if attr.Key != "name" && attr.Key != "property" {
+			continue
+		}
+		switch strings.ToLower(attr.Val) {
+		case "description", "og:description", "twitter:description":
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizeEscaped tokenizes text containing HTML entities. Tokens take their value from the unescaped text, and their
+// Source from the original.
+func tokenizeEscaped(raw string) ([]*Token, error) {
+	if strings.IndexByte(raw, '&') < 0 {
+		return TokenizeString(raw).ToSlice()
+	}
+
+	// offsets maps each byte of the unescaped text to its position in raw. The first byte of a decoded entity maps to its
+	// '&', and the rest to its end, so that a token holding the first byte takes the whole entity as its source, and a
+	// token entirely within the entity, such as foo of &ampfoo, takes none.
+	offsets := make([]int, 0, len(raw)+1)
+	var b strings.Builder
+	for i := 0; i < len(raw); {
+		if n, s := entity(raw[i:]); n > 0 {
+			offsets = append(offsets, i)
+			for k := 1; k < len(s); k++ {
+				offsets = append(offsets, i+n)
+			}
+			b.WriteString(s)
+			i += n
+			continue
+		}
+		offsets = append(offsets, i)
+		b.WriteByte(raw[i])
+		i++
+	}
+	offsets = append(offsets, len(raw))
+
+	tokens, err := TokenizeString(b.String()).ToSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	pos := 0
+	for i, token := range tokens {
+		end := pos + len(token.value)
+		source := raw[offsets[pos]:offsets[end]]
+		if source != token.value {
+			// Copy, NewToken may return a shared instance
+			sourced := *token
+			sourced.source = source
+			sourced.sourced = true
+			tokens[i] = &sourced
+		}
+		pos = end
+	}
+
+	return tokens, nil
+}
+
+// entity returns the length of an HTML entity (such as &amp; or &#43;) at the beginning of s, and its decoded value.
+// It returns zero if s does not begin with an entity.
+func entity(s string) (int, string) {
+	if len(s) < 2 || s[0] != '&' {
+		return 0, ""
+	}
+
+	n := 1
+	if s[n] == '#' {
+		n++
+		if n < len(s) && (s[n] == 'x' || s[n] == 'X') {
+			n++
+		}
+	}
+	for n < len(s) && n < 32 && isAlnum(s[n]) {
+		n++
+	}
+	if n < len(s) && s[n] == ';' {
+		n++
+	}
+
+	decoded := html.UnescapeString(s[:n])
+	if decoded == s[:n] {
+		return 0, ""
+	}
+	return n, decoded
+}
+
+func isAlnum(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+type attributeSpan struct {
+	name       string
+	start, end int
+}
+
+// attributeSpans finds the positions of attribute values in a raw tag, such as <img alt="foo">
+func attributeSpans(raw string) []attributeSpan {
+	var spans []attributeSpan
+
+	isSpace := func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+	}
+
+	// Skip the tag name
+	i := 1
+	for i < len(raw) && !isSpace(raw[i]) && raw[i] != '>' && raw[i] != '/' {
+		i++
+	}
+
+	for i < len(raw) {
+		for i < len(raw) && (isSpace(raw[i]) || raw[i] == '/') {
+			i++
+		}
+		if i >= len(raw) || raw[i] == '>' {
+			break
+		}
+
+		start := i
+		for i < len(raw) && !isSpace(raw[i]) && raw[i] != '=' && raw[i] != '>' && raw[i] != '/' {
+			i++
+		}
+		name := strings.ToLower(raw[start:i])
+
+		for i < len(raw) && isSpace(raw[i]) {
+			i++
+		}
+		if i >= len(raw) || raw[i] != '=' {
+			// No value
+			continue
+		}
+		i++
+		for i < len(raw) && isSpace(raw[i]) {
+			i++
+		}
+		if i >= len(raw) {
+			break
+		}
+
+		if q := raw[i]; q == '"' || q == '\'' {
+			end := strings.IndexByte(raw[i+1:], q)
+			if end < 0 {
+				break
+			}
+			spans = append(spans, attributeSpan{name, i + 1, i + 1 + end})
+			i += end + 2
+			continue
+		}
+
+		start = i
+		for i < len(raw) && !isSpace(raw[i]) && raw[i] != '>' {
+			i++
+		}
+		spans = append(spans, attributeSpan{name, start, i})
+	}
+
+	return spans
+}
+
+var voids = map[atom.Atom]bool{
+	atom.Area: true, atom.Base: true, atom.Br: true, atom.Col: true, atom.Embed: true, atom.Hr: true, atom.Img: true,
+	atom.Input: true, atom.Keygen: true, atom.Link: true, atom.Meta: true, atom.Param: true, atom.Source: true,
+	atom.Track: true, atom.Wbr: true,
+}
+
+// blocks are elements which break runs of text
+var blocks = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Blockquote: true, atom.Br: true, atom.Caption: true,
+	atom.Dd: true, atom.Div: true, atom.Dl: true, atom.Dt: true, atom.Fieldset: true, atom.Figcaption: true,
+	atom.Figure: true, atom.Footer: true, atom.Form: true, atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true,
+	atom.H5: true, atom.H6: true, atom.Header: true, atom.Hr: true, atom.Li: true, atom.Main: true, atom.Nav: true,
+	atom.Ol: true, atom.Option: true, atom.P: true, atom.Pre: true, atom.Section: true, atom.Table: true, atom.Td: true,
+	atom.Th: true, atom.Title: true, atom.Tr: true, atom.Ul: true,
+}
+
+// siblings are elements which are implicitly closed by an element of the same type
+var siblings = map[atom.Atom]bool{
+	atom.Dd: true, atom.Dt: true, atom.Li: true, atom.Option: true, atom.P: true, atom.Td: true, atom.Th: true,
+	atom.Tr: true,
 }
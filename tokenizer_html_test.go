@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
 )
 
 func TestTokenizeHTML(t *testing.T) {
@@ -55,3 +56,195 @@ Hi! Let's talk Ruby on Rails.
 		}
 	}
 }
+
+func TestTokenizeHTMLOptions(t *testing.T) {
+	h := `<html>
+<head><title>Jobs</title><meta name="description" content="Ruby on Rails jobs"></head>
+<body>
+<noscript>Please enable JavaScript</noscript>
+<div hidden>Hidden ASPNET MVC</div>
+<p style="display: none">Invisible Nodejs</p>
+<img src="logo.png" alt="React Native logo">
+<p>Ruby</p><p>on Rails</p>
+<ul><li>Go<li>Rust</ul>
+</body>
+</html>
+`
+	type test struct {
+		options    jargon.HTMLOptions
+		found      []string
+		notFound   []string
+		roundtrips bool
+	}
+
+	tests := []test{
+		{
+			options:    jargon.HTMLOptions{},
+			found:      []string{"Please", "Hidden", "Invisible", `<img src="logo.png" alt="React Native logo">`},
+			notFound:   []string{"React", "Please enable JavaScript"},
+			roundtrips: true,
+		},
+		{
+			options:    jargon.HTMLOptions{Hidden: true},
+			found:      []string{"Please enable JavaScript", "Hidden ASPNET MVC", "Invisible Nodejs", "Jobs", "Ruby"},
+			notFound:   []string{"Hidden", "Invisible", "Nodejs"},
+			roundtrips: true,
+		},
+		{
+			options:    jargon.HTMLOptions{Attributes: true},
+			found:      []string{`<img src="logo.png" alt="`, "React", "Native", `">`, `<meta name="description" content="`, "jobs"},
+			roundtrips: true,
+		},
+		{
+			options:  jargon.HTMLOptions{Hidden: true, Attributes: true, TextOnly: true},
+			found:    []string{"React", "jobs", "Ruby", "Rails", "Go", "Rust"},
+			notFound: []string{"<p>", "Jobs", "Hidden ASPNET MVC", "Hidden", "Please enable JavaScript"},
+		},
+	}
+
+	for _, test := range tests {
+		tokens, err := jargon.TokenizeHTMLOptions(strings.NewReader(h), test.options).ToSlice()
+		if err != nil {
+			t.Error(err)
+		}
+
+		var b strings.Builder
+		got := map[string]bool{}
+		for _, token := range tokens {
+			got[token.String()] = true
+			b.WriteString(token.String())
+		}
+
+		for _, s := range test.found {
+			if !got[s] {
+				t.Errorf("with options %+v, expected to find token %q, but did not", test.options, s)
+			}
+		}
+		for _, s := range test.notFound {
+			if got[s] {
+				t.Errorf("with options %+v, did not expect to find token %q", test.options, s)
+			}
+		}
+		if test.roundtrips && b.String() != h {
+			t.Errorf("with options %+v, expected roundtrip, got %q", test.options, b.String())
+		}
+	}
+
+	// Text-only should not run words together across paragraphs
+	text, err := jargon.TokenizeHTMLOptions(strings.NewReader(h), jargon.HTMLOptions{TextOnly: true}).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if !strings.Contains(text, "Ruby\non Rails") {
+		t.Errorf("expected paragraphs to be separated by a line break, got %q", text)
+	}
+	if !strings.Contains(text, "Go\nRust") {
+		t.Errorf("expected list items to be separated by a line break, got %q", text)
+	}
+
+	// A hidden element which is implicitly closed by its sibling should not hide the sibling
+	implicit := []struct {
+		given, visible, hidden string
+	}{
+		{`<p hidden>secret<div>visible</div>`, "visible", "secret"},
+		{`<ul><li hidden>a<li>b</ul>`, "b", "a"},
+	}
+	for _, test := range implicit {
+		text, err := jargon.TokenizeHTMLOptions(strings.NewReader(test.given), jargon.HTMLOptions{Hidden: true, TextOnly: true}).String()
+		if err != nil {
+			t.Error(err)
+		}
+		if !strings.Contains(text, test.visible) || strings.Contains(text, test.hidden) {
+			t.Errorf("given %q, expected %q and not %q, got %q", test.given, test.visible, test.hidden, text)
+		}
+	}
+
+	// Values of several attributes should not run together
+	attributed := `<img title="Ruby &amp; Rails" alt="Rails logo">`
+	text, err = jargon.TokenizeHTMLOptions(strings.NewReader(attributed), jargon.HTMLOptions{Attributes: true, TextOnly: true}).String()
+	if err != nil {
+		t.Error(err)
+	}
+	expectedText := "Ruby &amp; Rails\nRails logo"
+	if strings.TrimSpace(text) != expectedText {
+		t.Errorf("given %q, expected %q, got %q", attributed, expectedText, text)
+	}
+}
+
+func TestTokenizeHTMLEntities(t *testing.T) {
+	h := `<p title="R&amp;D">C&#43;&#43; and R&amp;D &lt;3 caf&eacute;</p>`
+
+	roundtrip, err := jargon.TokenizeHTML(strings.NewReader(h)).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if roundtrip != h {
+		t.Errorf("roundtrip should equal the original, got %q", roundtrip)
+	}
+
+	tokens, err := jargon.TokenizeHTMLOptions(strings.NewReader(h), jargon.HTMLOptions{Attributes: true}).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	got := map[string]string{}
+	for _, token := range tokens {
+		got[token.String()] = token.Source()
+	}
+
+	expected := map[string]string{
+		"+":    "&#43;",
+		"&":    "&amp;",
+		"<":    "&lt;",
+		"café": "caf&eacute;",
+		"R":    "R",
+		"3":    "3",
+	}
+	for value, source := range expected {
+		if got[value] != source {
+			t.Errorf("expected token %q to have source %q, got %q", value, source, got[value])
+		}
+	}
+
+	// Filters should match on unescaped values
+	filtered, err := jargon.TokenizeHTML(strings.NewReader(h)).Filter(stackoverflow.Tags).String()
+	if err != nil {
+		t.Error(err)
+	}
+	expectedFiltered := `<p title="R&amp;D">c++ and r&amp;D &lt;3 caf&eacute;</p>`
+	if filtered != expectedFiltered {
+		t.Errorf("expected %q, got %q", expectedFiltered, filtered)
+	}
+	// Entities without a closing semicolon may decode to more than one token, e.g. &ampfoo → &foo
+	unclosed := `<p>a &ampfoo b &copy2020 &lt</p>`
+	roundtrip, err = jargon.TokenizeHTML(strings.NewReader(unclosed)).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if roundtrip != unclosed {
+		t.Errorf("roundtrip should equal the original %q, got %q", unclosed, roundtrip)
+	}
+
+	text, err := jargon.TokenizeHTMLOptions(strings.NewReader(unclosed), jargon.HTMLOptions{TextOnly: true}).String()
+	if err != nil {
+		t.Error(err)
+	}
+	expectedText := "a &ampfoo b &copy2020 &lt"
+	if strings.TrimSpace(text) != expectedText {
+		t.Errorf("expected text %q, got %q", expectedText, text)
+	}
+
+	tokens, err = jargon.TokenizeHTML(strings.NewReader(unclosed)).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+	values := map[string]bool{}
+	for _, token := range tokens {
+		values[token.String()] = true
+	}
+	for _, value := range []string{"&", "foo", "©", "2020", "<"} {
+		if !values[value] {
+			t.Errorf("expected to find token %q in %q", value, unclosed)
+		}
+	}
+}
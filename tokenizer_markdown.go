@@ -0,0 +1,482 @@
+package jargon
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenizeMarkdown tokenizes Markdown. Prose is tokenized using jargon.Tokenize; markup (emphasis, headings, list markers,
+// link syntax and the like) is returned as opaque punct tokens. Fenced, indented and inline code is returned verbatim, as
+// tokens for which IsCode is true. Link URLs are kept whole.
+//
+// It returns all tokens (including white space and markup), so text can be reconstructed with fidelity.
+func TokenizeMarkdown(r io.Reader) *TokenStream {
+	t := &mdtokenizer{
+		reader: bufio.NewReader(r),
+		blank:  true,
+	}
+	return NewTokenStream(t.next)
+}
+
+type mdtokenizer struct {
+	reader *bufio.Reader
+	// queue of tokens from the current line
+	queue []*Token
+	eof   bool
+
+	// fence is the opening fence of the current code block, or empty if not in a fenced block
+	fence string
+	// blank indicates that the previous line was blank, i.e. an indented code block may begin
+	blank bool
+	// indented indicates that the previous line was indented code
+	indented bool
+}
+
+// next returns the next token. Call until it returns nil.
+func (t *mdtokenizer) next() (*Token, error) {
+	for len(t.queue) == 0 {
+		if t.eof {
+			return nil, nil
+		}
+
+		line, err := t.reader.ReadString('\n')
+		if err == io.EOF {
+			t.eof = true
+		} else if err != nil {
+			return nil, err
+		}
+
+		if line != "" {
+			if err := t.line(line); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	token := t.queue[0]
+	t.queue = t.queue[1:]
+	return token, nil
+}
+
+// line tokenizes a single line of Markdown, including its line ending, into the queue
+func (t *mdtokenizer) line(line string) error {
+	content := strings.TrimRight(line, "\r\n")
+	eol := line[len(content):]
+
+	switch {
+	case t.fence != "":
+		if closesFence(content, t.fence) {
+			t.fence = ""
+			t.markup(content)
+		} else {
+			t.code(content)
+		}
+	case openFence(content) != "":
+		t.fence = openFence(content)
+		t.markup(content)
+		t.blank = false
+	case strings.TrimSpace(content) == "":
+		if err := t.text(content); err != nil {
+			return err
+		}
+		t.blank = true
+	case (t.blank || t.indented) && isIndentedCode(content):
+		t.code(content)
+		t.indented = true
+		t.blank = false
+	case isThematicBreak(content):
+		t.markup(content)
+		t.blank, t.indented = false, false
+	default:
+		rest, err := t.block(content)
+		if err != nil {
+			return err
+		}
+		if err := t.inline(rest); err != nil {
+			return err
+		}
+		t.blank, t.indented = false, false
+	}
+
+	t.markup(eol)
+	return nil
+}
+
+// block consumes leading indentation and block markers (block quotes, list items, headings), returning the remainder of the line
+func (t *mdtokenizer) block(content string) (string, error) {
+	i := 0
+	for {
+		j := i
+		for j < len(content) && content[j] == ' ' {
+			j++
+		}
+		if err := t.text(content[i:j]); err != nil {
+			return "", err
+		}
+		i = j
+
+		rest := content[i:]
+		if rest == "" {
+			return rest, nil
+		}
+
+		if rest[0] == '>' {
+			t.markup(">")
+			i++
+			continue
+		}
+
+		if n := listMarker(rest); n > 0 {
+			t.markup(rest[:n])
+			i += n
+			continue
+		}
+
+		if n := headingMarker(rest); n > 0 {
+			t.markup(rest[:n])
+			i += n
+		}
+
+		return content[i:], nil
+	}
+}
+
+// inline tokenizes prose, passing emphasis, code spans, links and URLs along as markup
+func (t *mdtokenizer) inline(s string) error {
+	start := 0
+	flush := func(end int) error {
+		err := t.text(s[start:end])
+		start = end
+		return err
+	}
+
+	i := 0
+	for i < len(s) {
+		n := 1
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) && isASCIIPunct(s[i+1]) {
+				n = 2
+				break
+			}
+			i++
+			continue
+		case '`':
+			n = run(s, i)
+			end := closingCode(s, i+n, n)
+			if end < 0 {
+				// Unmatched backticks are literal
+				i += n
+				continue
+			}
+			if err := flush(i); err != nil {
+				return err
+			}
+			t.markup(s[i : i+n])
+			t.code(s[i+n : end])
+			t.markup(s[end : end+n])
+			i = end + n
+			start = i
+			continue
+		case '*':
+			n = run(s, i)
+		case '~':
+			n = run(s, i)
+			if n < 2 {
+				// Strikethrough is ~~
+				i++
+				continue
+			}
+		case '_':
+			n = run(s, i)
+			if intraword(s, i, n) {
+				// snake_case and the like are prose
+				i += n
+				continue
+			}
+		case '!':
+			if i+1 < len(s) && s[i+1] == '[' {
+				n = 2
+				break
+			}
+			i++
+			continue
+		case '[':
+		case ']':
+			if i+1 < len(s) && s[i+1] == '(' {
+				end := closingParen(s, i+2)
+				if end >= 0 {
+					if err := flush(i); err != nil {
+						return err
+					}
+					t.markup("](")
+					if err := t.destination(s[i+2 : end]); err != nil {
+						return err
+					}
+					t.markup(")")
+					i = end + 1
+					start = i
+					continue
+				}
+			}
+		case '<':
+			n = autolink(s[i:])
+			if n == 0 {
+				i++
+				continue
+			}
+		case 'h':
+			n = bareURL(s, i)
+			if n == 0 {
+				i++
+				continue
+			}
+		default:
+			i++
+			continue
+		}
+
+		if err := flush(i); err != nil {
+			return err
+		}
+		t.markup(s[i : i+n])
+		i += n
+		start = i
+	}
+
+	return flush(len(s))
+}
+
+// destination handles the contents of a link's parentheses: a URL, kept whole, and an optional title, which is prose
+func (t *mdtokenizer) destination(s string) error {
+	i := 0
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	if err := t.text(s[:i]); err != nil {
+		return err
+	}
+
+	j := i
+	if j < len(s) && s[j] == '<' {
+		if k := strings.IndexByte(s[j:], '>'); k >= 0 {
+			j += k + 1
+		}
+	}
+	for j < len(s) && s[j] != ' ' {
+		j++
+	}
+	t.markup(s[i:j])
+
+	return t.text(s[j:])
+}
+
+func (t *mdtokenizer) text(s string) error {
+	if s == "" {
+		return nil
+	}
+	tokens, err := TokenizeString(s).ToSlice()
+	if err != nil {
+		return err
+	}
+	t.queue = append(t.queue, tokens...)
+	return nil
+}
+
+func (t *mdtokenizer) markup(s string) {
+	if s == "" {
+		return
+	}
+	// Copy, NewToken may return a shared instance
+	token := *NewToken(s, false)
+	token.punct = true
+	t.queue = append(t.queue, &token)
+}
+
+func (t *mdtokenizer) code(s string) {
+	if s == "" {
+		return
+	}
+	token := &Token{
+		value: s,
+		punct: true,
+		code:  true,
+	}
+	t.queue = append(t.queue, token)
+}
+
+// openFence returns the fence (``` or ~~~, or longer) that opens a code block, or empty if the line is not a fence
+func openFence(line string) string {
+	s := strings.TrimLeft(line, " ")
+	if len(line)-len(s) > 3 || len(s) < 3 {
+		return ""
+	}
+	c := s[0]
+	if c != '`' && c != '~' {
+		return ""
+	}
+	n := run(s, 0)
+	if n < 3 {
+		return ""
+	}
+	if c == '`' && strings.IndexByte(s[n:], '`') >= 0 {
+		// Backtick fences can't have backticks in the info string
+		return ""
+	}
+	return s[:n]
+}
+
+func closesFence(line, fence string) bool {
+	s := strings.TrimSpace(line)
+	return strings.HasPrefix(s, fence) && strings.Trim(s, fence[:1]) == ""
+}
+
+func isIndentedCode(line string) bool {
+	return strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t")
+}
+
+// isThematicBreak determines whether a line is a horizontal rule (or setext heading underline), e.g. --- or ***
+func isThematicBreak(line string) bool {
+	s := strings.TrimSpace(line)
+	if len(s) < 3 {
+		return false
+	}
+	c := s[0]
+	if c != '-' && c != '*' && c != '_' && c != '=' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] != c && s[i] != ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+// listMarker returns the length of a list item marker (e.g. "-", "*", "1."), if the line begins with one
+func listMarker(s string) int {
+	n := 0
+	switch {
+	case s[0] == '-' || s[0] == '*' || s[0] == '+':
+		n = 1
+	default:
+		for n < len(s) && n < 9 && '0' <= s[n] && s[n] <= '9' {
+			n++
+		}
+		if n == 0 || n == len(s) || (s[n] != '.' && s[n] != ')') {
+			return 0
+		}
+		n++
+	}
+
+	// Must be followed by a space, or end of line
+	if n < len(s) && s[n] != ' ' && s[n] != '\t' {
+		return 0
+	}
+	return n
+}
+
+// headingMarker returns the length of an ATX heading marker (e.g. "##"), if the line begins with one
+func headingMarker(s string) int {
+	n := run(s, 0)
+	if s[0] != '#' || n > 6 {
+		return 0
+	}
+	if n < len(s) && s[n] != ' ' && s[n] != '\t' {
+		return 0
+	}
+	return n
+}
+
+// run returns the number of consecutive bytes equal to s[i], starting at i
+func run(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+// closingCode finds a run of exactly n backticks, starting at i
+func closingCode(s string, i, n int) int {
+	for i < len(s) {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		m := run(s, i)
+		if m == n {
+			return i
+		}
+		i += m
+	}
+	return -1
+}
+
+// closingParen finds the parenthesis which balances an (already consumed) opening parenthesis, starting at i
+func closingParen(s string, i int) int {
+	depth := 0
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// intraword determines whether the run s[i:i+n] is surrounded by word characters, i.e. is not a delimiter
+func intraword(s string, i, n int) bool {
+	before, _ := utf8.DecodeLastRuneInString(s[:i])
+	after, _ := utf8.DecodeRuneInString(s[i+n:])
+	return isWordRune(before) && isWordRune(after)
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// autolink returns the length of an autolink such as <https://example.com>, if s begins with one
+func autolink(s string) int {
+	end := strings.IndexByte(s, '>')
+	if end < 0 {
+		return 0
+	}
+	inner := s[1:end]
+	if inner == "" || strings.ContainsAny(inner, " <") || !strings.ContainsAny(inner, ":@") {
+		return 0
+	}
+	return end + 1
+}
+
+// bareURL returns the length of an http(s) URL starting at i, if there is one
+func bareURL(s string, i int) int {
+	rest := s[i:]
+	if !strings.HasPrefix(rest, "http://") && !strings.HasPrefix(rest, "https://") {
+		return 0
+	}
+	if before, _ := utf8.DecodeLastRuneInString(s[:i]); isWordRune(before) {
+		return 0
+	}
+
+	n := strings.IndexAny(rest, " \t<")
+	if n < 0 {
+		n = len(rest)
+	}
+
+	// Trailing punctuation is more likely prose than URL
+	n = len(strings.TrimRight(rest[:n], ".,:;!?'\")*_"))
+	return n
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
@@ -0,0 +1,110 @@
+package jargon_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+)
+
+func TestTokenizeMarkdown(t *testing.T) {
+	md := "# Getting *started* with Ruby on Rails\n" +
+		"\n" +
+		"> Install it with `gem install rails`, see [the guide](https://guides.rubyonrails.org/getting_started.html \"Rails Guide\").\n" +
+		"\n" +
+		"- a __bold__ snake_case item\n" +
+		"1. visit <https://rubyonrails.org> or https://github.com/rails/rails.\n" +
+		"\n" +
+		"```ruby\n" +
+		"puts \"Ruby on Rails\"\n" +
+		"```\n" +
+		"\n" +
+		"    indented code\r\n" +
+		"***\n" +
+		"Escaped \\*stars\\* and ~~strike~~"
+
+	roundtrip, err := jargon.TokenizeMarkdown(strings.NewReader(md)).String()
+	if err != nil {
+		t.Error(err)
+	}
+	if roundtrip != md {
+		t.Errorf("roundtrip should equal the original, got %q", roundtrip)
+	}
+
+	tokens, err := jargon.TokenizeMarkdown(strings.NewReader(md)).ToSlice()
+	if err != nil {
+		t.Error(err)
+	}
+
+	type kind struct {
+		punct, code bool
+	}
+	got := map[string]kind{}
+	for _, token := range tokens {
+		got[token.String()] = kind{token.IsPunct(), token.IsCode()}
+	}
+
+	markup := kind{punct: true}
+	code := kind{punct: true, code: true}
+	prose := kind{}
+
+	expected := map[string]kind{
+		"#":    markup,
+		"*":    markup,
+		">":    markup,
+		"`":    markup,
+		"[":    markup,
+		"](":   markup,
+		")":    markup,
+		"-":    markup,
+		"__":   markup,
+		"1.":   markup,
+		"***":  markup,
+		"\\*":  markup,
+		"~~":   markup,
+		"```":  markup,
+		"\r\n": markup,
+
+		"```ruby": markup,
+
+		// URLs kept whole
+		"https://guides.rubyonrails.org/getting_started.html": markup,
+		"<https://rubyonrails.org>":                           markup,
+		"https://github.com/rails/rails":                      markup,
+
+		// Code
+		"gem install rails":    code,
+		`puts "Ruby on Rails"`: code,
+		"    indented code":    code,
+
+		// Prose
+		"Getting":    prose,
+		"started":    prose,
+		"Ruby":       prose,
+		"Rails":      prose,
+		"guide":      prose,
+		"Guide":      prose,
+		"bold":       prose,
+		"snake_case": prose,
+		"stars":      prose,
+		"strike":     prose,
+	}
+
+	for value, kind := range expected {
+		k, found := got[value]
+		if !found {
+			t.Errorf("expected to find token %q, but did not", value)
+			continue
+		}
+		if k != kind {
+			t.Errorf("expected token %q to have punct %t and code %t, got %t and %t", value, kind.punct, kind.code, k.punct, k.code)
+		}
+	}
+
+	unexpected := []string{"gem", "puts", "indented", "rubyonrails", "getting_started"}
+	for _, value := range unexpected {
+		if _, found := got[value]; found {
+			t.Errorf("did not expect to find token %q", value)
+		}
+	}
+}
@@ -115,12 +115,13 @@ func (stream *TokenStream) Filter(filters ...Filter) *TokenStream {
 	return outgoing
 }
 
+// String concatenates all tokens, in their Source form. It will consume all tokens.
 func (stream *TokenStream) String() (string, error) {
 	var b strings.Builder
 
 	for stream.Scan() {
 		token := stream.Token()
-		b.WriteString(token.String())
+		b.WriteString(token.Source())
 	}
 
 	if err := stream.Err(); err != nil {
@@ -130,12 +131,12 @@ func (stream *TokenStream) String() (string, error) {
 	return b.String(), nil
 }
 
-// WriteTo writes all token string values to w
+// WriteTo writes all tokens to w, in their Source form
 func (stream *TokenStream) WriteTo(w io.Writer) (int64, error) {
 	var written int64
 	for stream.Scan() {
 		token := stream.Token()
-		n, err := w.Write([]byte(token.String()))
+		n, err := w.Write([]byte(token.Source()))
 		written += int64(n)
 
 		if err != nil {
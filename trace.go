@@ -0,0 +1,124 @@
+package jargon
+
+import (
+	"strings"
+)
+
+// Tracer records which filter created each token, to explain how a pipeline arrived at its output. Wrap each filter
+// using Tracer.Filter, then call Explain on the resulting tokens. A Tracer is not safe for concurrent use.
+//
+// The name of the filter is recorded on a copy of each created token, see Token.WithValue, so the Tracer holds no
+// memory of its own, and shared tokens (see NewToken) are not credited to the wrong filter.
+type Tracer struct {
+	// tracers must have distinct addresses, as they key token values; see traced
+	_ byte
+}
+
+// traced is the key of the name of the filter which created a token, for a Tracer
+type traced struct {
+	tracer *Tracer
+}
+
+// NewTracer creates a new Tracer
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// Filter wraps f, such that tokens which f outputs, but which it did not receive as input, are attributed to name
+func (tr *Tracer) Filter(name string, f Filter) Filter {
+	return func(incoming *TokenStream) *TokenStream {
+		// seen counts tokens which have gone into f and not yet come out; tokens may be shared, see NewToken
+		seen := map[*Token]int{}
+
+		in := NewTokenStream(func() (*Token, error) {
+			token, err := incoming.Next()
+			if token != nil {
+				seen[token]++
+			}
+			return token, err
+		})
+
+		out := f(in)
+
+		return NewTokenStream(func() (*Token, error) {
+			token, err := out.Next()
+			if token == nil {
+				return token, err
+			}
+
+			if seen[token] > 0 {
+				seen[token]--
+				if seen[token] == 0 {
+					delete(seen, token)
+				}
+			} else {
+				token = token.WithValue(traced{tr}, name)
+			}
+			return token, err
+		})
+	}
+}
+
+// Filters wraps each of filters, using the corresponding names
+func (tr *Tracer) Filters(names []string, filters []Filter) []Filter {
+	result := make([]Filter, len(filters))
+	for i, f := range filters {
+		result[i] = tr.Filter(names[i], f)
+	}
+	return result
+}
+
+// Step is one change to a token, by a filter
+type Step struct {
+	// Filter is the name of the filter which made the change
+	Filter string
+	// From is the value of the token(s) which the filter replaced, concatenated; see Token.Origin
+	From string
+	// To is the value of the token which the filter created
+	To string
+}
+
+// String describes the step, e.g. stack: Ruby on Rails → ruby-on-rails
+func (s Step) String() string {
+	return s.Filter + ": " + s.From + " → " + s.To
+}
+
+// Explain returns the steps by which traced filters created the token, in the order they were applied; empty if the
+// token was not created by a traced filter. Intermediate values are only known when filters record origins; see NewTokenFrom.
+func (tr *Tracer) Explain(token *Token) []Step {
+	var steps []Step
+	for _, o := range token.Origin() {
+		steps = append(steps, tr.Explain(o)...)
+	}
+
+	// Not Value, which would find the name on the token's origin
+	if name, found := token.values[traced{tr}].(string); found {
+		var from strings.Builder
+		for _, o := range token.Origin() {
+			from.WriteString(o.String())
+		}
+		steps = append(steps, Step{
+			Filter: name,
+			From:   from.String(),
+			To:     token.String(),
+		})
+	}
+
+	return steps
+}
+
+// Explanation describes the steps by which traced filters created the token, starting from the original text,
+// e.g. Café → Cafe (ascii) → cafe (stem); empty if the token was not created by a traced filter.
+func (tr *Tracer) Explanation(token *Token) string {
+	steps := tr.Explain(token)
+	if len(steps) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(token.Original())
+	for _, step := range steps {
+		b.WriteString(" → " + step.To + " (" + step.Filter + ")")
+	}
+	return b.String()
+}
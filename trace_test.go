@@ -0,0 +1,96 @@
+package jargon_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/filters/ascii"
+	"github.com/clipperhouse/jargon/filters/contractions"
+	"github.com/clipperhouse/jargon/filters/mapper"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/filters/stemmer"
+)
+
+func TestTracer(t *testing.T) {
+	tr := jargon.NewTracer()
+
+	names := []string{"ascii", "contractions", "stack", "stem"}
+	filters := tr.Filters(names, []jargon.Filter{ascii.Fold, contractions.Expand, stackoverflow.Tags, stemmer.English})
+
+	tokens, err := jargon.TokenizeString("Café managers don't use Ruby on Rails").Filter(filters...).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"cafe":         "Café → Cafe (ascii) → cafe (stem)",
+		"manag":        "managers → manag (stem)",
+		"not":          "don't → not (contractions)",
+		"ruby-on-rail": "Ruby on Rails → ruby-on-rails (stack) → ruby-on-rail (stem)",
+		"use":          "",
+	}
+
+	found := map[string]bool{}
+	for _, token := range tokens {
+		key := token.String()
+		explanation, ok := expected[key]
+		if !ok {
+			continue
+		}
+		found[key] = true
+
+		got := tr.Explanation(token)
+		if got != explanation {
+			t.Errorf("expected explanation %q, got %q", explanation, got)
+		}
+	}
+
+	for key := range expected {
+		if !found[key] {
+			t.Errorf("expected to find token %q", key)
+		}
+	}
+}
+
+func TestTracerSteps(t *testing.T) {
+	tr := jargon.NewTracer()
+
+	tokens, err := jargon.TokenizeString("Café").Filter(tr.Filter("ascii", ascii.Fold), tr.Filter("stem", stemmer.English)).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []jargon.Step{
+		{Filter: "ascii", From: "Café", To: "Cafe"},
+		{Filter: "stem", From: "Cafe", To: "cafe"},
+	}
+	got := tr.Explain(tokens[0])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected steps %v, got %v", expected, got)
+	}
+}
+
+func TestTracerShared(t *testing.T) {
+	tr := jargon.NewTracer()
+
+	// NewToken returns a shared instance for common words, such as "and"
+	replace := mapper.NewFilter(func(token *jargon.Token) *jargon.Token {
+		if token.String() == "a" {
+			return jargon.NewToken("and", false)
+		}
+		return token
+	})
+
+	tokens, err := jargon.TokenizeString("a and b").Filter(tr.Filter("replace", replace)).ToSlice()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := tr.Explain(tokens[0]); len(got) != 1 || got[0].Filter != "replace" {
+		t.Errorf("expected the first token to be created by replace, got %v", got)
+	}
+	if got := tr.Explain(tokens[2]); len(got) != 0 {
+		t.Errorf("expected the original token not to be explained, got %v", got)
+	}
+}
@@ -0,0 +1,177 @@
+// Package vector turns token streams into sparse feature vectors, for machine learning. Features are terms, and
+// optionally word n-grams; they are mapped to indices using a fixed vocabulary, or the hashing trick. Values are
+// term counts, or TF-IDF weights relative to a corpus.
+package vector
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/corpus"
+	"github.com/clipperhouse/jargon/filters/ngram"
+)
+
+// Vector is a sparse vector
+type Vector struct {
+	// Indices are in ascending order
+	Indices []int `json:"indices"`
+	// Values correspond to Indices
+	Values []float64 `json:"values"`
+}
+
+// Vectorizer creates vectors from token streams. The zero value uses the hashing trick, with DefaultDimensions,
+// unsigned, with term counts as values.
+type Vectorizer struct {
+	// Vocabulary maps features to indices; features not in the vocabulary are ignored. If nil, the hashing trick is used.
+	Vocabulary map[string]int
+	// Dimensions is the number of dimensions when hashing; if zero, DefaultDimensions
+	Dimensions int
+	// Signed uses the high bit of each feature's hash to determine the sign of its value when hashing, so that
+	// collisions tend to cancel out
+	Signed bool
+	// NGrams is the maximum number of words in a feature; 0 or 1 is single terms, 2 adds bigrams, etc.
+	// Word n-grams do not cross punctuation.
+	NGrams int
+	// Corpus, if not nil, weights features by TF-IDF, relative to its document frequencies; otherwise, values are
+	// feature counts. It should be built from Features of the same Vectorizer, see corpus.Corpus.AddDocument.
+	Corpus *corpus.Corpus
+}
+
+// DefaultDimensions is the number of dimensions when hashing, if not specified
+const DefaultDimensions = 1 << 18
+
+// NewVocabulary creates a vocabulary from terms, in the order given, for use as Vectorizer.Vocabulary
+func NewVocabulary(terms []string) map[string]int {
+	vocabulary := map[string]int{}
+	for _, term := range terms {
+		term = strings.ToLower(term)
+		if _, found := vocabulary[term]; !found {
+			vocabulary[term] = len(vocabulary)
+		}
+	}
+	return vocabulary
+}
+
+// Features counts the features of tokens: lowercase terms, and word n-grams joined by spaces. Spaces and punctuation
+// are skipped.
+func (v *Vectorizer) Features(id string, tokens *jargon.TokenStream) (corpus.Document, error) {
+	if v.NGrams > 1 {
+		tokens = tokens.Filter(ngram.Shingles(1, v.NGrams, " ", false))
+	}
+	return corpus.Count(id, tokens)
+}
+
+// Vectorize creates a vector from tokens
+func (v *Vectorizer) Vectorize(tokens *jargon.TokenStream) (Vector, error) {
+	doc, err := v.Features("", tokens)
+	if err != nil {
+		return Vector{}, err
+	}
+	return v.Vector(doc), nil
+}
+
+// Vector creates a vector from features; see Features
+func (v *Vectorizer) Vector(doc corpus.Document) Vector {
+	values := map[int]float64{}
+
+	var weights []corpus.Weight
+	if v.Corpus != nil {
+		weights = v.Corpus.Weights(doc, corpus.TFIDF)
+	} else {
+		for term, count := range doc.Terms {
+			weights = append(weights, corpus.Weight{Term: term, Weight: float64(count)})
+		}
+	}
+
+	for _, w := range weights {
+		index, sign, ok := v.index(w.Term)
+		if !ok {
+			continue
+		}
+		values[index] += sign * w.Weight
+	}
+
+	vec := Vector{
+		Indices: []int{},
+		Values:  []float64{},
+	}
+	for index := range values {
+		if values[index] == 0 {
+			// e.g. signed collisions which cancelled
+			continue
+		}
+		vec.Indices = append(vec.Indices, index)
+	}
+	sort.Ints(vec.Indices)
+	for _, index := range vec.Indices {
+		vec.Values = append(vec.Values, values[index])
+	}
+
+	return vec
+}
+
+// index returns the index and sign of a feature, and whether it is in the vocabulary
+func (v *Vectorizer) index(feature string) (int, float64, bool) {
+	if v.Vocabulary != nil {
+		index, found := v.Vocabulary[feature]
+		return index, 1, found
+	}
+
+	dims := v.Dimensions
+	if dims <= 0 {
+		dims = DefaultDimensions
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+
+	index := int(sum % uint64(dims))
+	sign := 1.0
+	if v.Signed && sum>>63 == 1 {
+		sign = -1
+	}
+	return index, sign, true
+}
+
+// WriteLibSVM writes the vector in libsvm (svmlight) format, as a single line: a label, index:value pairs with
+// indices starting at 1, and an optional trailing comment, e.g.
+//
+//	1 3:1 17:2 # doc.txt
+func (vec Vector) WriteLibSVM(w io.Writer, label, comment string) error {
+	var b strings.Builder
+	b.WriteString(label)
+	for i, index := range vec.Indices {
+		b.WriteString(" " + strconv.Itoa(index+1) + ":" + strconv.FormatFloat(vec.Values[i], 'g', -1, 64))
+	}
+	if comment != "" {
+		b.WriteString(" # " + comment)
+	}
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteJSONL writes the vector as a single line of JSON, with an id, e.g.
+//
+//	{"id":"doc.txt","indices":[2,16],"values":[1,2]}
+func (vec Vector) WriteJSONL(w io.Writer, id string) error {
+	line := struct {
+		ID string `json:"id"`
+		Vector
+	}{id, vec}
+
+	b, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
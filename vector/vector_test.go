@@ -0,0 +1,115 @@
+package vector_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/clipperhouse/jargon"
+	"github.com/clipperhouse/jargon/corpus"
+	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	"github.com/clipperhouse/jargon/vector"
+)
+
+func TestVocabulary(t *testing.T) {
+	v := &vector.Vectorizer{
+		Vocabulary: vector.NewVocabulary([]string{"ruby-on-rails", "go", "rust", "go rust"}),
+		NGrams:     2,
+	}
+
+	tokens := jargon.TokenizeString("Ruby on Rails, Go Rust and Go. Unknown words").Filter(stackoverflow.Tags)
+	got, err := v.Vectorize(tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := vector.Vector{
+		Indices: []int{0, 1, 2, 3},
+		Values:  []float64{1, 2, 1, 1},
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestHashing(t *testing.T) {
+	text := "we like ruby on rails and go and rust and go"
+
+	for _, signed := range []bool{false, true} {
+		v := &vector.Vectorizer{
+			Dimensions: 16,
+			Signed:     signed,
+		}
+
+		got, err := v.Vectorize(jargon.TokenizeString(text))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got.Indices) != len(got.Values) || len(got.Indices) == 0 {
+			t.Fatalf("expected indices and values, got %v", got)
+		}
+
+		sum := 0.0
+		for i, index := range got.Indices {
+			if index < 0 || index >= 16 {
+				t.Errorf("expected index within dimensions, got %d", index)
+			}
+			if i > 0 && index <= got.Indices[i-1] {
+				t.Errorf("expected ascending indices, got %v", got.Indices)
+			}
+			if !signed && got.Values[i] < 0 {
+				t.Errorf("expected positive values when unsigned, got %v", got.Values)
+			}
+			sum += got.Values[i]
+		}
+
+		// 11 words
+		if !signed && sum != 11 {
+			t.Errorf("expected values to sum to 11, got %v", sum)
+		}
+	}
+}
+
+func TestTFIDF(t *testing.T) {
+	v := &vector.Vectorizer{
+		Vocabulary: vector.NewVocabulary([]string{"we", "rust"}),
+	}
+
+	c := corpus.New()
+	for i, text := range []string{"we like rust", "we like go"} {
+		doc, err := v.Features(string(rune('a'+i)), jargon.TokenizeString(text))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.AddDocument(doc)
+	}
+	v.Corpus = c
+
+	got := v.Vector(c.Docs[0])
+	// rust is in fewer documents, so weighs more
+	if len(got.Values) != 2 || got.Values[1] <= got.Values[0] {
+		t.Errorf("expected rust to weigh more than we, got %v", got)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	vec := vector.Vector{
+		Indices: []int{2, 16},
+		Values:  []float64{1, 2.5},
+	}
+
+	var b bytes.Buffer
+	if err := vec.WriteLibSVM(&b, "1", "doc.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := vec.WriteJSONL(&b, "doc.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "1 3:1 17:2.5 # doc.txt\n" +
+		`{"id":"doc.txt","indices":[2,16],"values":[1,2.5]}` + "\n"
+	if b.String() != expected {
+		t.Errorf("expected %q, got %q", expected, b.String())
+	}
+}
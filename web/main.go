@@ -3,14 +3,17 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"html/template"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/clipperhouse/jargon"
-	"github.com/clipperhouse/jargon/filters/stackoverflow"
+	_ "github.com/clipperhouse/jargon/pipeline" // registers the built-in filters
 )
 
 func main() {
@@ -49,12 +52,35 @@ func jargonHandler(w http.ResponseWriter, r *http.Request) {
 		tokens = jargon.Tokenize(r.Body)
 	case "html":
 		tokens = jargon.TokenizeHTML(r.Body)
+	case "markdown":
+		tokens = jargon.TokenizeMarkdown(r.Body)
+	case "filters":
+		filtersHandler(w, r)
+		return
 	default:
 		http.NotFound(w, r)
 		return
 	}
 
-	lemmatized := tokens.Filter(stackoverflow.Tags)
+	// Filters are specified as query params, e.g. ?filter=stack&filter=stem:french; see jargon.ParseFilter and allowed
+	specs := r.URL.Query()["filter"]
+	if len(specs) == 0 {
+		specs = []string{"stack"}
+	}
+
+	var filters []jargon.Filter
+	for _, spec := range specs {
+		filter, err := parseFilter(spec)
+		if err != nil {
+			// Errors may quote params, so they are logged rather than returned
+			log.Print(err)
+			http.Error(w, "invalid filter; see /filters for the filters and params which are available", http.StatusBadRequest)
+			return
+		}
+		filters = append(filters, filter)
+	}
+
+	lemmatized := tokens.Filter(filters...)
 
 	var b bytes.Buffer
 
@@ -92,6 +118,121 @@ func jargonHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// allowance determines the params with which callers may use a filter
+type allowance struct {
+	// excluded are params which may not be used, because they read files on the server
+	excluded []string
+	// max caps numeric params, so that a request cannot exhaust memory
+	max map[string]int
+}
+
+// allowed are the filters which callers may use. Filters whose params read files, such as synonyms, are omitted.
+var allowed = map[string]allowance{
+	"acronyms":     {excluded: []string{"dictionary"}},
+	"ascii":        {},
+	"chargrams":    {max: map[string]int{"min": 10, "max": 10}},
+	"clitics":      {},
+	"confusables":  {},
+	"contract":     {},
+	"contractions": {},
+	"distinct":     {},
+	"elision":      {},
+	"handles":      {},
+	"hashtags":     {},
+	"lemmas":       {},
+	"nba":          {},
+	"norm":         {},
+	"numbers":      {},
+	"redact":       {},
+	"shingles":     {max: map[string]int{"min": 5, "max": 5}},
+	"stack":        {},
+	"stem":         {},
+	"stopwords":    {excluded: []string{"file"}},
+	"translit":     {},
+	"versions":     {},
+}
+
+// parseFilter creates a filter from a spec, as jargon.ParseFilter, if the filter and its params are allowed
+func parseFilter(spec string) (jargon.Filter, error) {
+	name, params, err := jargon.ParseSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	a, found := allowed[name]
+	if !found {
+		return nil, fmt.Errorf("filter %q is not allowed", name)
+	}
+	for _, key := range a.excluded {
+		if _, found := params[key]; found {
+			return nil, fmt.Errorf("filter %q: param %s is not allowed", name, key)
+		}
+	}
+	for key, max := range a.max {
+		value, found := params[key]
+		if !found {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("filter %q: %s: %v", name, key, err)
+		}
+		if n > max {
+			return nil, fmt.Errorf("filter %q: %s may be at most %d, got %d", name, key, max, n)
+		}
+	}
+
+	return jargon.NewFilter(name, params)
+}
+
+// filter is the JSON representation of a registered filter
+type filter struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Params      []jargon.Param `json:"params,omitempty"`
+}
+
+// filtersHandler lists the allowed filters, as JSON
+func filtersHandler(w http.ResponseWriter, r *http.Request) {
+	var filters []filter
+	for _, reg := range jargon.Registered() {
+		a, found := allowed[reg.Name]
+		if !found {
+			continue
+		}
+
+		var params []jargon.Param
+		for _, param := range reg.Params {
+			if !contains(a.excluded, param.Name) {
+				params = append(params, param)
+			}
+		}
+
+		filters = append(filters, filter{
+			Name:        reg.Name,
+			Description: reg.Description,
+			Params:      params,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(filters)
+	if err != nil {
+		log.Print(err)
+		w.WriteHeader(500)
+		return
+	}
+}
+
+func contains(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
 var lemma = template.Must(template.New("lemma").Parse(`<span class="lemma">{{ . }}</span>`))
 var plain = template.Must(template.New("plain").Parse(`{{ . }}`))
 
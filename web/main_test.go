@@ -38,3 +38,68 @@ func TestHandler(t *testing.T) {
 		t.Errorf(`should have found <span class="lemma">objective-c</span> in result, got %q`, got)
 	}
 }
+
+func TestHandlerFilters(t *testing.T) {
+	type test struct {
+		url      string
+		status   int
+		contains string
+	}
+
+	tests := []test{
+		{"/text?filter=ascii", 200, `<span class="lemma">cafe</span>`},
+		{"/text?filter=contractions&filter=ascii", 200, `<span class="lemma">cafe</span>`},
+		{"/text?filter=foo", 400, "invalid filter"},
+		{"/filters", 200, `"name":"stem"`},
+		{"/text?filter=shingles:max=3", 200, "like a café"},
+		{"/text?filter=stopwords:words=a", 200, "like"},
+		// Params which read files are not allowed, nor are unbounded sizes
+		{"/text?filter=synonyms:file=/etc/passwd", 400, "invalid filter"},
+		{"/text?filter=stopwords:file=/etc/passwd", 400, "invalid filter"},
+		{"/text?filter=compounds:dictionary=/etc/passwd", 400, "invalid filter"},
+		{"/text?filter=acronyms:dictionary=/etc/passwd", 400, "invalid filter"},
+		{"/text?filter=shingles:max=100000", 400, "invalid filter"},
+		{"/text?filter=chargrams:1:100000", 400, "invalid filter"},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest("POST", test.url, strings.NewReader("I'd like a café"))
+		w := httptest.NewRecorder()
+
+		jargonHandler(w, req)
+
+		resp := w.Result()
+		result, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if resp.StatusCode != test.status {
+			t.Errorf("%s: expected status %d, got %d", test.url, test.status, resp.StatusCode)
+		}
+
+		got := string(result)
+		if !strings.Contains(got, test.contains) {
+			t.Errorf("%s: should have found %q in result, got %q", test.url, test.contains, got)
+		}
+		if strings.Contains(got, "root") || strings.Contains(got, "passwd") {
+			t.Errorf("%s: should not have revealed a file or its path, got %q", test.url, got)
+		}
+	}
+
+	// Filters which are not allowed are not listed
+	req := httptest.NewRequest("GET", "/filters", nil)
+	w := httptest.NewRecorder()
+	jargonHandler(w, req)
+
+	result, err := ioutil.ReadAll(w.Result().Body)
+	if err != nil {
+		t.Error(err)
+	}
+	got := string(result)
+	for _, s := range []string{`"name":"synonyms"`, `"name":"compounds"`, `"name":"file"`, `"name":"dictionary"`} {
+		if strings.Contains(got, s) {
+			t.Errorf("/filters: should not have listed %s, got %q", s, got)
+		}
+	}
+}